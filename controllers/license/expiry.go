@@ -0,0 +1,40 @@
+package license
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/samber/mo"
+)
+
+// licenseExpirationDateLayout is the layout of the expiration date in Kong license payloads.
+const licenseExpirationDateLayout = "2006-01-02"
+
+// licenseDocument is the part of the Kong license format needed to determine the license's expiration.
+type licenseDocument struct {
+	License struct {
+		Payload struct {
+			ExpirationDate string `json:"license_expiration_date"`
+		} `json:"payload"`
+	} `json:"license"`
+}
+
+// ParseLicenseExpiration returns the expiration time of the raw Kong license, i.e. the beginning (UTC)
+// of the day in the `license_expiration_date` field of its payload. It returns None when the license
+// does not carry an expiration date and an error when the license can't be parsed.
+func ParseLicenseExpiration(rawLicenseString string) (mo.Option[time.Time], error) {
+	var doc licenseDocument
+	if err := json.Unmarshal([]byte(rawLicenseString), &doc); err != nil {
+		return mo.None[time.Time](), fmt.Errorf("failed to parse license: %w", err)
+	}
+	expirationDate := doc.License.Payload.ExpirationDate
+	if expirationDate == "" {
+		return mo.None[time.Time](), nil
+	}
+	expiresAt, err := time.Parse(licenseExpirationDateLayout, expirationDate)
+	if err != nil {
+		return mo.None[time.Time](), fmt.Errorf("failed to parse license expiration date %q: %w", expirationDate, err)
+	}
+	return mo.Some(expiresAt), nil
+}
@@ -0,0 +1,55 @@
+package license
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLicenseExpiration(t *testing.T) {
+	testCases := []struct {
+		name              string
+		rawLicense        string
+		expectedExpiresAt time.Time
+		expectedNone      bool
+		expectedErr       bool
+	}{
+		{
+			name:              "license with expiration date",
+			rawLicense:        `{"license":{"payload":{"customer":"test","license_expiration_date":"2030-01-02"},"version":"1"}}`,
+			expectedExpiresAt: time.Date(2030, time.January, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:         "license without expiration date",
+			rawLicense:   `{"license":{"payload":{"customer":"test"},"version":"1"}}`,
+			expectedNone: true,
+		},
+		{
+			name:        "invalid JSON",
+			rawLicense:  `not-a-license`,
+			expectedErr: true,
+		},
+		{
+			name:        "invalid expiration date",
+			rawLicense:  `{"license":{"payload":{"license_expiration_date":"01/02/2030"}}}`,
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			expiresAt, err := ParseLicenseExpiration(tc.rawLicense)
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if tc.expectedNone {
+				require.True(t, expiresAt.IsAbsent())
+				return
+			}
+			require.Equal(t, tc.expectedExpiresAt, expiresAt.MustGet())
+		})
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	k8stypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -29,6 +30,7 @@ import (
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/controllers"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/controllers/crds"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/logging"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/clock"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/kubernetes/object/status"
 	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
 )
@@ -40,12 +42,56 @@ import (
 // ValidatorFunc is the function type used to validate the license string by KongV1Alpha1KongLicenseReconciler.
 type ValidatorFunc func(rawLicenseString string) error
 
+// ExpirationRecorder records expiration times of KongLicenses, e.g. as Prometheus metrics.
+type ExpirationRecorder interface {
+	RecordKongLicenseExpiration(name string, expiresAt time.Time)
+	DeleteKongLicenseExpiration(name string)
+}
+
+// Clock is used by KongV1Alpha1KongLicenseReconciler to determine whether KongLicenses expired.
+type Clock interface {
+	Now() time.Time
+}
+
+// ReconcilerOpt is an option of KongV1Alpha1KongLicenseReconciler.
+type ReconcilerOpt func(*KongV1Alpha1KongLicenseReconciler)
+
+// WithEventRecorder sets the recorder of warning events emitted when KongLicenses are about to expire or expired.
+func WithEventRecorder(recorder record.EventRecorder) ReconcilerOpt {
+	return func(r *KongV1Alpha1KongLicenseReconciler) {
+		r.eventRecorder = recorder
+	}
+}
+
+// WithExpirationRecorder sets the recorder of KongLicenses' expiration times.
+func WithExpirationRecorder(recorder ExpirationRecorder) ReconcilerOpt {
+	return func(r *KongV1Alpha1KongLicenseReconciler) {
+		r.expirationRecorder = recorder
+	}
+}
+
+// WithExpirationWarningPeriod sets how long before the expiration of a KongLicense warning events are emitted.
+func WithExpirationWarningPeriod(period time.Duration) ReconcilerOpt {
+	return func(r *KongV1Alpha1KongLicenseReconciler) {
+		r.expirationWarningPeriod = period
+	}
+}
+
+// WithClock sets the clock used to determine whether KongLicenses expired. This is useful for testing.
+func WithClock(c Clock) ReconcilerOpt {
+	return func(r *KongV1Alpha1KongLicenseReconciler) {
+		r.clock = c
+	}
+}
+
 // NewKongV1Alpha1KongLicenseReconciler creates a new KongV1Alpha1KongLicenseReconciler.
 // It can validate the license and set conditions accordingly when licenseValidator is provided.
 // Based on whether it returns an error it sets
 // `status.status.controllers[].controllerName.conditions[].type`: "LicenseValid" to "True" or "False"
 // according with other fields of the condition. Field `message` is set to the returned error message.
 // If not provided, the whole validation step is skipped.
+// Expiration of the licenses is tracked regardless of the validator: expired licenses are not picked
+// as long as there's an enabled license that didn't expire.
 func NewKongV1Alpha1KongLicenseReconciler(
 	client client.Client,
 	log logr.Logger,
@@ -56,18 +102,25 @@ func NewKongV1Alpha1KongLicenseReconciler(
 	licenseControllerType string,
 	electionID mo.Option[string],
 	licenseValidator mo.Option[ValidatorFunc],
+	opts ...ReconcilerOpt,
 ) *KongV1Alpha1KongLicenseReconciler {
-	return &KongV1Alpha1KongLicenseReconciler{
-		Client:                client,
-		Log:                   log,
-		Scheme:                scheme,
-		LicenseCache:          licenseCache,
-		CacheSyncTimeout:      cacheSyncTimeout,
-		StatusQueue:           statusQueue,
-		LicenseControllerType: licenseControllerType,
-		ElectionID:            electionID,
-		licenseValidator:      licenseValidator.OrEmpty(),
+	r := &KongV1Alpha1KongLicenseReconciler{
+		Client:                  client,
+		Log:                     log,
+		Scheme:                  scheme,
+		LicenseCache:            licenseCache,
+		CacheSyncTimeout:        cacheSyncTimeout,
+		StatusQueue:             statusQueue,
+		LicenseControllerType:   licenseControllerType,
+		ElectionID:              electionID,
+		licenseValidator:        licenseValidator.OrEmpty(),
+		expirationWarningPeriod: DefaultExpirationWarningPeriod,
+		clock:                   clock.System{},
+	}
+	for _, opt := range opts {
+		opt(r)
 	}
+	return r
 }
 
 // KongV1Alpha1KongLicenseReconciler reconciles KongLicense resources.
@@ -89,6 +142,11 @@ type KongV1Alpha1KongLicenseReconciler struct {
 	licenseValidator  func(rawLicenseString string) error
 	chosenLicenseLock sync.RWMutex
 	chosenLicense     *kongv1alpha1.KongLicense
+
+	eventRecorder           record.EventRecorder
+	expirationRecorder      ExpirationRecorder
+	expirationWarningPeriod time.Duration
+	clock                   Clock
 }
 
 const (
@@ -102,6 +160,8 @@ const (
 	ConditionReasonPickedAsLatest = "PickedAsLatest"
 	// ConditionReasonReplacedByNewer represents that the KongLicense is replaced by other one that is newer.
 	ConditionReasonReplacedByNewer = "ReplacedByNewer"
+	// ConditionReasonExpired represents that the KongLicense expired and is replaced by other one that did not.
+	ConditionReasonExpired = "Expired"
 
 	// ConditionTypeLicenseValid is the type of condition for the license validation.
 	ConditionTypeLicenseValid = "LicenseValid"
@@ -114,6 +174,20 @@ const (
 	maxConditionNum = 8
 )
 
+const (
+	// DefaultExpirationWarningPeriod is how long before the expiration of a KongLicense
+	// warning events are emitted by default.
+	DefaultExpirationWarningPeriod = 30 * 24 * time.Hour
+
+	// expirationReminderPeriod is the period of repeating warning events about a KongLicense about to expire.
+	expirationReminderPeriod = 24 * time.Hour
+
+	// KongLicenseExpiringSoonEventReason is the reason of events emitted when a KongLicense is about to expire.
+	KongLicenseExpiringSoonEventReason = "KongLicenseExpiringSoon"
+	// KongLicenseExpiredEventReason is the reason of events emitted when a KongLicense expired.
+	KongLicenseExpiredEventReason = "KongLicenseExpired"
+)
+
 var _ controllers.Reconciler = &KongV1Alpha1KongLicenseReconciler{}
 
 func NewLicenseCache() cache.Store {
@@ -187,6 +261,7 @@ func (r *KongV1Alpha1KongLicenseReconciler) Reconcile(ctx context.Context, req c
 				if err := r.LicenseCache.Delete(obj); err != nil {
 					return ctrl.Result{}, err
 				}
+				r.recordExpiration(obj, mo.None[time.Time]())
 
 				// Then pick the effective license in KongLicenses remaining in cache.
 				if err := r.repickLicenseOnDelete(ctx, obj); err != nil {
@@ -227,27 +302,56 @@ func (r *KongV1Alpha1KongLicenseReconciler) Reconcile(ctx context.Context, req c
 		return ctrl.Result{}, err
 	}
 
-	// Trigger a compare on stored KongLicenses in cache and pick the newest.
+	// Track the expiration of the KongLicense.
+	expiresAt, err := ParseLicenseExpiration(obj.RawLicenseString)
+	if err != nil {
+		log.V(logging.DebugLevel).Info("Could not determine expiration of KongLicense", "name", obj.Name, "error", err.Error())
+	}
+	if err := r.ensureExpirationStatus(ctx, obj, expiresAt); err != nil {
+		return ctrl.Result{}, err
+	}
+	r.recordExpiration(obj, expiresAt)
+
+	// Trigger a compare on stored KongLicenses in cache and pick the newest one that did not expire.
 	chosenLicense := r.pickLicenseInCache()
-	if chosenLicense.Name == obj.Name {
+	oldChosenLicense := r.getChosenLicense()
+	switch {
+	case chosenLicense.Name == obj.Name:
 		log.V(logging.DebugLevel).Info("Picked KongLicense being reconciled", "name", obj.Name)
 		err := r.ensureControllerStatusConditions(ctx, obj, metav1.ConditionTrue, ConditionReasonPickedAsLatest, "")
 		if err != nil {
 			return ctrl.Result{}, err
 		}
 
-		oldChosenLicense := r.getChosenLicense()
 		if oldChosenLicense != nil && oldChosenLicense.Name != chosenLicense.Name {
 			r.Log.V(logging.DebugLevel).Info("Originally picked KongLicense replaced", "name", oldChosenLicense.Name)
-			err := r.ensureControllerStatusConditions(ctx, oldChosenLicense, metav1.ConditionFalse, ConditionReasonReplacedByNewer, "Replaced by newer created KongLicense")
+			reason, message := ConditionReasonReplacedByNewer, "Replaced by newer created KongLicense"
+			if r.isLicenseExpired(oldChosenLicense) {
+				reason, message = ConditionReasonExpired, fmt.Sprintf("Expired, replaced by KongLicense %s", chosenLicense.Name)
+			}
+			err := r.ensureControllerStatusConditions(ctx, oldChosenLicense, metav1.ConditionFalse, reason, message)
 			if err != nil {
 				return ctrl.Result{}, err
 			}
 		}
 		r.setChosenLicense(chosenLicense)
+
+	case oldChosenLicense != nil && oldChosenLicense.Name == obj.Name:
+		// The KongLicense being reconciled was picked before, but it expired and there's another one that did not.
+		log.Info("Picked KongLicense expired, failing over to another one", "name", obj.Name, "replacement", chosenLicense.Name)
+		err := r.ensureControllerStatusConditions(ctx, chosenLicense, metav1.ConditionTrue, ConditionReasonPickedAsLatest, "")
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		err = r.ensureControllerStatusConditions(ctx, obj, metav1.ConditionFalse, ConditionReasonExpired,
+			fmt.Sprintf("Expired, replaced by KongLicense %s", chosenLicense.Name))
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		r.setChosenLicense(chosenLicense)
 	}
 
-	return ctrl.Result{}, nil
+	return r.handleExpiration(obj, expiresAt), nil
 }
 
 // License is a wrapper for kong.License that include the information about its validity.
@@ -316,22 +420,111 @@ func compareKongLicense(license1, license2 *kongv1alpha1.KongLicense) bool {
 	return license1.Name < license2.Name
 }
 
-// pickLicenseInCache picks the newest license in the cache.
+// pickLicenseInCache picks the newest license in the cache that did not expire.
+// When all the licenses in the cache expired, it picks the newest one.
 func (r *KongV1Alpha1KongLicenseReconciler) pickLicenseInCache() *kongv1alpha1.KongLicense {
 	licenseList := r.LicenseCache.List()
-	var chosenLicense *kongv1alpha1.KongLicense
+	var chosenLicense, chosenExpiredLicense *kongv1alpha1.KongLicense
 	for _, obj := range licenseList {
 		license, ok := obj.(*kongv1alpha1.KongLicense)
 		if !ok {
 			continue
 		}
+		if r.isLicenseExpired(license) {
+			if chosenExpiredLicense == nil || compareKongLicense(license, chosenExpiredLicense) {
+				chosenExpiredLicense = license
+			}
+			continue
+		}
 		if chosenLicense == nil || compareKongLicense(license, chosenLicense) {
 			chosenLicense = license
 		}
 	}
+	if chosenLicense == nil {
+		return chosenExpiredLicense
+	}
 	return chosenLicense
 }
 
+// now returns the current time according to the reconciler's clock.
+func (r *KongV1Alpha1KongLicenseReconciler) now() time.Time {
+	if r.clock == nil {
+		return time.Now()
+	}
+	return r.clock.Now()
+}
+
+// isLicenseExpired returns true if the KongLicense carries an expiration date that has passed.
+func (r *KongV1Alpha1KongLicenseReconciler) isLicenseExpired(l *kongv1alpha1.KongLicense) bool {
+	expiresAt, err := ParseLicenseExpiration(l.RawLicenseString)
+	if err != nil {
+		return false
+	}
+	exp, ok := expiresAt.Get()
+	return ok && !r.now().Before(exp)
+}
+
+// ensureExpirationStatus sets the expiration time of the KongLicense in its status if it's not set already.
+func (r *KongV1Alpha1KongLicenseReconciler) ensureExpirationStatus(
+	ctx context.Context, license *kongv1alpha1.KongLicense, expiresAt mo.Option[time.Time],
+) error {
+	var wanted *metav1.Time
+	if exp, ok := expiresAt.Get(); ok {
+		wanted = lo.ToPtr(metav1.NewTime(exp))
+	}
+	current := license.Status.ExpiresAt
+	if (current == nil && wanted == nil) || (current != nil && wanted != nil && current.Equal(wanted)) {
+		return nil
+	}
+	license.Status.ExpiresAt = wanted
+	return r.Client.Status().Update(ctx, license)
+}
+
+// recordExpiration records the expiration time of the KongLicense if an expiration recorder is configured.
+func (r *KongV1Alpha1KongLicenseReconciler) recordExpiration(license *kongv1alpha1.KongLicense, expiresAt mo.Option[time.Time]) {
+	if r.expirationRecorder == nil {
+		return
+	}
+	if exp, ok := expiresAt.Get(); ok {
+		r.expirationRecorder.RecordKongLicenseExpiration(license.Name, exp)
+		return
+	}
+	r.expirationRecorder.DeleteKongLicenseExpiration(license.Name)
+}
+
+// handleExpiration emits warning events when the KongLicense expired or is about to expire and returns
+// the result requeueing the KongLicense when the warning period starts or it expires.
+func (r *KongV1Alpha1KongLicenseReconciler) handleExpiration(
+	license *kongv1alpha1.KongLicense, expiresAt mo.Option[time.Time],
+) ctrl.Result {
+	exp, ok := expiresAt.Get()
+	if !ok {
+		return ctrl.Result{}
+	}
+
+	now := r.now()
+	switch {
+	case !now.Before(exp):
+		r.emitWarningEvent(license, KongLicenseExpiredEventReason,
+			fmt.Sprintf("KongLicense expired at %s", exp.Format(time.RFC3339)))
+		return ctrl.Result{}
+	case !now.Before(exp.Add(-r.expirationWarningPeriod)):
+		r.emitWarningEvent(license, KongLicenseExpiringSoonEventReason,
+			fmt.Sprintf("KongLicense expires at %s", exp.Format(time.RFC3339)))
+		return ctrl.Result{RequeueAfter: min(exp.Sub(now), expirationReminderPeriod)}
+	default:
+		return ctrl.Result{RequeueAfter: exp.Add(-r.expirationWarningPeriod).Sub(now)}
+	}
+}
+
+// emitWarningEvent emits a warning event for the KongLicense if an event recorder is configured.
+func (r *KongV1Alpha1KongLicenseReconciler) emitWarningEvent(license *kongv1alpha1.KongLicense, reason, message string) {
+	if r.eventRecorder == nil {
+		return
+	}
+	r.eventRecorder.Event(license, "Warning", reason, message)
+}
+
 // fullControllerName returns the full controllerName used in the controller status item
 // combined with constant type and reconciler's own controller name.
 func (r *KongV1Alpha1KongLicenseReconciler) fullControllerName() string {
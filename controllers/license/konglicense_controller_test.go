@@ -1,11 +1,20 @@
 package license
 
 import (
+	"context"
+	"fmt"
 	"testing"
 	"time"
 
+	"github.com/go-logr/logr"
+	"github.com/samber/mo"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
 )
@@ -100,6 +109,24 @@ func TestKongLicenseController_pickLicense(t *testing.T) {
 			expectedNil:       false,
 			chosenLicenseName: "newest",
 		},
+		{
+			name: "Should skip expired licenses",
+			licenses: []*kongv1alpha1.KongLicense{
+				licenseWithExpiration("older", now.Add(-10*time.Second), now.AddDate(0, 1, 0)),
+				licenseWithExpiration("newer", now.Add(-5*time.Second), now.AddDate(0, 0, -1)),
+			},
+			expectedNil:       false,
+			chosenLicenseName: "older",
+		},
+		{
+			name: "Should choose the newest one when all licenses expired",
+			licenses: []*kongv1alpha1.KongLicense{
+				licenseWithExpiration("older", now.Add(-10*time.Second), now.AddDate(0, 0, -2)),
+				licenseWithExpiration("newer", now.Add(-5*time.Second), now.AddDate(0, 0, -1)),
+			},
+			expectedNil:       false,
+			chosenLicenseName: "newer",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -123,3 +150,91 @@ func TestKongLicenseController_pickLicense(t *testing.T) {
 		})
 	}
 }
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func licenseWithExpiration(name string, createdAt time.Time, expiresAt time.Time) *kongv1alpha1.KongLicense {
+	return &kongv1alpha1.KongLicense{
+		ObjectMeta: metav1.ObjectMeta{
+			CreationTimestamp: metav1.NewTime(createdAt),
+			Name:              name,
+		},
+		RawLicenseString: fmt.Sprintf(`{"license":{"payload":{"license_expiration_date":%q}}}`,
+			expiresAt.UTC().Format(licenseExpirationDateLayout)),
+		Enabled: true,
+	}
+}
+
+func TestKongLicenseController_ExpirationFailover(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)
+	c := &fakeClock{now: now}
+
+	// The newer license expires in 10 days, the older one in a year.
+	expiringLicense := licenseWithExpiration("expiring", now.Add(-time.Hour), now.AddDate(0, 0, 10))
+	validLicense := licenseWithExpiration("valid", now.Add(-2*time.Hour), now.AddDate(1, 0, 0))
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, kongv1alpha1.AddToScheme(scheme))
+	cl := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(expiringLicense, validLicense).
+		WithStatusSubresource(expiringLicense, validLicense).
+		Build()
+	recorder := record.NewFakeRecorder(10)
+	r := NewKongV1Alpha1KongLicenseReconciler(
+		cl, logr.Discard(), scheme, NewLicenseCache(), time.Minute, nil, "test-controller", mo.None[string](), mo.None[ValidatorFunc](),
+		WithClock(c),
+		WithEventRecorder(recorder),
+	)
+
+	reconcile := func(name string) ctrl.Result {
+		t.Helper()
+		res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: k8stypes.NamespacedName{Name: name}})
+		require.NoError(t, err)
+		return res
+	}
+	programmedCondition := func(name string) metav1.Condition {
+		t.Helper()
+		l := &kongv1alpha1.KongLicense{}
+		require.NoError(t, cl.Get(ctx, k8stypes.NamespacedName{Name: name}, l))
+		require.Len(t, l.Status.KongLicenseControllerStatuses, 1)
+		require.Len(t, l.Status.KongLicenseControllerStatuses[0].Conditions, 1)
+		return l.Status.KongLicenseControllerStatuses[0].Conditions[0]
+	}
+
+	t.Log("reconciling the valid license first and then the newer one about to expire")
+	res := reconcile(validLicense.Name)
+	require.Equal(t, now.AddDate(1, 0, 0).Add(-DefaultExpirationWarningPeriod).Sub(now), res.RequeueAfter,
+		"should requeue when the warning period starts")
+	res = reconcile(expiringLicense.Name)
+	require.Equal(t, expirationReminderPeriod, res.RequeueAfter, "should requeue to remind about the expiration")
+	require.Equal(t, expiringLicense.Name, r.getChosenLicense().Name, "newer license should be chosen")
+	require.Equal(t, ConditionReasonReplacedByNewer, programmedCondition(validLicense.Name).Reason)
+	require.Contains(t, <-recorder.Events, KongLicenseExpiringSoonEventReason)
+
+	l := &kongv1alpha1.KongLicense{}
+	require.NoError(t, cl.Get(ctx, k8stypes.NamespacedName{Name: expiringLicense.Name}, l))
+	require.NotNil(t, l.Status.ExpiresAt)
+	require.True(t, now.AddDate(0, 0, 10).Equal(l.Status.ExpiresAt.Time))
+
+	t.Log("reconciling the newer license after it expired")
+	c.now = now.AddDate(0, 0, 11)
+	res = reconcile(expiringLicense.Name)
+	require.Zero(t, res.RequeueAfter)
+	require.Equal(t, validLicense.Name, r.getChosenLicense().Name, "should fail over to the valid license")
+	require.Contains(t, <-recorder.Events, KongLicenseExpiredEventReason)
+
+	expiredCondition := programmedCondition(expiringLicense.Name)
+	require.Equal(t, metav1.ConditionFalse, expiredCondition.Status)
+	require.Equal(t, ConditionReasonExpired, expiredCondition.Reason)
+	validCondition := programmedCondition(validLicense.Name)
+	require.Equal(t, metav1.ConditionTrue, validCondition.Status)
+	require.Equal(t, ConditionReasonPickedAsLatest, validCondition.Reason)
+}
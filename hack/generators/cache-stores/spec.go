@@ -56,6 +56,11 @@ var supportedTypes = []cacheStoreSupportedType{
 		Type:    "Gateway",
 		Package: "gatewayapi",
 	},
+	{
+		Type:    "GatewayClass",
+		Package: "gatewayapi",
+		KeyFunc: clusterWideKeyFunc,
+	},
 	// Kong types
 	{
 		Type:       "KongPlugin",
@@ -112,4 +117,22 @@ var supportedTypes = []cacheStoreSupportedType{
 		Type:    "KongCustomEntity",
 		Package: "kongv1alpha1",
 	},
+	{
+		Type:    "KongPluginPolicy",
+		Package: "kongv1alpha1",
+		KeyFunc: clusterWideKeyFunc,
+	},
+	{
+		Type:    "KongExternalBackend",
+		Package: "kongv1alpha1",
+	},
+	{
+		Type:    "KongHostnamePolicy",
+		Package: "kongv1alpha1",
+		KeyFunc: clusterWideKeyFunc,
+	},
+	{
+		Type:    "GatewayClassParameters",
+		Package: "kongv1alpha1",
+	},
 }
@@ -162,6 +162,7 @@ var inputControllersNeeded = &typesNeeded{
 		ProgrammedCondition: ProgrammedConditionConfiguration{
 			UpdatesEnabled: true,
 		},
+		StatusFieldsUpdatesEnabled: true,
 	},
 	typeNeeded{
 		Group:                            "configuration.konghq.com",
@@ -177,6 +178,7 @@ var inputControllersNeeded = &typesNeeded{
 		ProgrammedCondition: ProgrammedConditionConfiguration{
 			UpdatesEnabled: true,
 		},
+		StatusFieldsUpdatesEnabled:        true,
 		AcceptsIngressClassNameAnnotation: true,
 		AcceptsIngressClassNameSpec:       false,
 		NeedsUpdateReferences:             true,
@@ -281,6 +283,62 @@ var inputControllersNeeded = &typesNeeded{
 		AcceptsIngressClassNameAnnotation: true,
 		RBACVerbs:                         []string{"get", "list", "watch"},
 	},
+	typeNeeded{
+		Group:                             "configuration.konghq.com",
+		Version:                           "v1alpha1",
+		Kind:                              "KongPluginPolicy",
+		PackageImportAlias:                "kongv1alpha1",
+		PackageAlias:                      "KongV1Alpha1",
+		Package:                           kongv1alpha1,
+		Plural:                            "kongpluginpolicies",
+		CacheType:                         "KongPluginPolicy",
+		NeedsStatusPermissions:            false,
+		AcceptsIngressClassNameAnnotation: false,
+		AcceptsIngressClassNameSpec:       false,
+		RBACVerbs:                         []string{"get", "list", "watch"},
+	},
+	typeNeeded{
+		Group:                             "configuration.konghq.com",
+		Version:                           "v1alpha1",
+		Kind:                              "KongExternalBackend",
+		PackageImportAlias:                "kongv1alpha1",
+		PackageAlias:                      "KongV1Alpha1",
+		Package:                           kongv1alpha1,
+		Plural:                            "kongexternalbackends",
+		CacheType:                         "KongExternalBackend",
+		NeedsStatusPermissions:            false,
+		AcceptsIngressClassNameAnnotation: false,
+		AcceptsIngressClassNameSpec:       false,
+		RBACVerbs:                         []string{"get", "list", "watch"},
+	},
+	typeNeeded{
+		Group:                             "configuration.konghq.com",
+		Version:                           "v1alpha1",
+		Kind:                              "KongHostnamePolicy",
+		PackageImportAlias:                "kongv1alpha1",
+		PackageAlias:                      "KongV1Alpha1",
+		Package:                           kongv1alpha1,
+		Plural:                            "konghostnamepolicies",
+		CacheType:                         "KongHostnamePolicy",
+		NeedsStatusPermissions:            false,
+		AcceptsIngressClassNameAnnotation: false,
+		AcceptsIngressClassNameSpec:       false,
+		RBACVerbs:                         []string{"get", "list", "watch"},
+	},
+	typeNeeded{
+		Group:                             "configuration.konghq.com",
+		Version:                           "v1alpha1",
+		Kind:                              "GatewayClassParameters",
+		PackageImportAlias:                "kongv1alpha1",
+		PackageAlias:                      "KongV1Alpha1",
+		Package:                           kongv1alpha1,
+		Plural:                            "gatewayclassparameterses",
+		CacheType:                         "GatewayClassParameters",
+		NeedsStatusPermissions:            false,
+		AcceptsIngressClassNameAnnotation: false,
+		AcceptsIngressClassNameSpec:       false,
+		RBACVerbs:                         []string{"get", "list", "watch"},
+	},
 }
 
 var inputRBACPermissionsNeeded = &rbacsNeeded{
@@ -411,6 +469,10 @@ type typeNeeded struct {
 	// ProgrammedCondition contains the configuration for the Programmed condition for the resource.
 	ProgrammedCondition ProgrammedConditionConfiguration
 
+	// StatusFieldsUpdatesEnabled indicates that the controller should also update status fields of the resource
+	// other than conditions, using the ensure<Kind>StatusFields function implemented in the controllers package.
+	StatusFieldsUpdatesEnabled bool
+
 	// NeedUpdateReferences is true if we need to update the reference relationships
 	// between reconciled object and other objects.
 	NeedsUpdateReferences bool
@@ -737,6 +799,11 @@ func (r *{{.PackageAlias}}{{.Kind}}Reconciler) Reconcile(ctx context.Context, re
 		)
 		obj.Status.Conditions = conditions
 		{{- end }}
+		{{- if .StatusFieldsUpdatesEnabled }}
+		if ensure{{ .Kind }}StatusFields(r.DataplaneClient, obj) {
+			updateNeeded = true
+		}
+		{{- end }}
 		if updateNeeded {
 			return ctrl.Result{}, r.Status().Update(ctx, obj)
 		}
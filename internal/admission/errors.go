@@ -9,12 +9,14 @@ const (
 	ErrTextConsumerGroupUnsupported           = "consumer group support requires Kong Enterprise"
 	ErrTextConsumerGroupUnlicensed            = "consumer group support requires a valid Kong Enterprise license"
 	ErrTextConsumerGroupUnexpected            = "unexpected error during checking support for consumer group"
+	ErrTextConsumerGroupPluginUnsupported     = "plugin %q (%s) can't be scoped to consumer groups"
 	ErrTextCustomEntityFieldsUnmarshalFailed  = "failed to unmarshal fields of custom entity: %v"
 	ErrTextCustomEntityGetSchemaFailed        = "failed to get schema of Kong entity type '%s': %v"
 	ErrTextFailedToRetrieveSecret             = "could not retrieve secrets from the kubernetes API" //nolint:revive,gosec
 	ErrTextPluginConfigInvalid                = "could not parse plugin configuration"
 	ErrTextPluginConfigValidationFailed       = "unable to validate plugin schema"
 	ErrTextPluginConfigViolatesSchema         = "plugin failed schema validation: %s"
+	ErrTextPluginNotAllowedByPolicy           = "plugin violates KongPluginPolicy: %v"
 	ErrTextPluginSecretConfigUnretrievable    = "could not load secret plugin configuration"
 	ErrTextVaultConfigUnmarshalFailed         = "failed to unmarshal vault configuration: %v"
 	ErrTextVaultUnableToValidate              = "unable to validate vault on Kong gateway"
@@ -12,6 +12,7 @@ import (
 	netv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/admission/validation"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
 	ctrlref "github.com/kong/kubernetes-ingress-controller/v3/internal/controllers/reference"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
@@ -130,6 +131,17 @@ func (h RequestHandler) handleValidation(ctx context.Context, request admissionv
 ) {
 	responseBuilder := NewResponseBuilder(request.UID)
 
+	// The fallback policy annotation can be set on objects of any kind, so it's validated before dispatching.
+	if len(request.Object.Raw) > 0 {
+		obj := metav1.PartialObjectMetadata{}
+		if err := json.Unmarshal(request.Object.Raw, &obj); err != nil {
+			return nil, err
+		}
+		if err := validation.ValidateFallbackPolicyAnnotation(&obj); err != nil {
+			return responseBuilder.Allowed(false).WithMessage(err.Error()).Build(), nil
+		}
+	}
+
 	switch request.Resource {
 	case consumerGVResource:
 		return h.handleKongConsumer(ctx, request, responseBuilder)
@@ -256,6 +256,63 @@ func TestHandleService(t *testing.T) {
 	}
 }
 
+func TestHandleValidation_FallbackPolicyAnnotation(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		wantAllowed bool
+		wantMessage string
+	}{
+		{
+			name:        "no fallback policy",
+			wantAllowed: true,
+		},
+		{
+			name: "valid fallback policy",
+			annotations: map[string]string{
+				annotations.AnnotationPrefix + annotations.FallbackPolicyKey: "backfill",
+			},
+			wantAllowed: true,
+		},
+		{
+			name: "invalid fallback policy",
+			annotations: map[string]string{
+				annotations.AnnotationPrefix + annotations.FallbackPolicyKey: "ignore",
+			},
+			wantAllowed: false,
+			wantMessage: `invalid konghq.com/fallback-policy value: unknown fallback policy "ignore", expected one of: "exclude", "backfill", "fail"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "test",
+					Namespace:   "default",
+					Annotations: tt.annotations,
+				},
+			}
+			raw, err := json.Marshal(service)
+			require.NoError(t, err)
+			request := admissionv1.AdmissionRequest{
+				Resource:  serviceGVResource,
+				Operation: admissionv1.Create,
+				Object: runtime.RawExtension{
+					Raw: raw,
+				},
+			}
+			handler := RequestHandler{
+				Logger: logr.Discard(),
+			}
+
+			got, err := handler.handleValidation(context.Background(), request)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantAllowed, got.Allowed)
+			require.Equal(t, tt.wantMessage, got.Result.Message)
+		})
+	}
+}
+
 func TestHandleSecret(t *testing.T) {
 	testCases := []struct {
 		name             string
@@ -0,0 +1,22 @@
+package validation
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/fallback"
+)
+
+// ValidateFallbackPolicyAnnotation validates the fallback policy annotation that can be set on objects of any kind.
+func ValidateFallbackPolicyAnnotation(obj metav1.Object) error {
+	value, ok := annotations.ExtractFallbackPolicy(obj.GetAnnotations())
+	if !ok {
+		return nil
+	}
+	if _, err := fallback.ParsePolicy(value); err != nil {
+		return fmt.Errorf("invalid %s value: %w", annotations.AnnotationPrefix+annotations.FallbackPolicyKey, err)
+	}
+	return nil
+}
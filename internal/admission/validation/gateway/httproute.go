@@ -10,10 +10,14 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/admission/validation"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
 	gatewaycontroller "github.com/kong/kubernetes-ingress-controller/v3/internal/controllers/gateway"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator/subtranslator"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
+	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
+	incubatorv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/incubator/v1alpha1"
 )
 
 type routeValidator interface {
@@ -27,14 +31,15 @@ type routeValidator interface {
 // ValidateHTTPRoute provides a suite of validation for a given HTTPRoute and
 // any number of Gateway resources it's attached to that the caller wants to
 // have it validated against. It checks supported features, linked objects,
-// and uses provided routesValidator to validate the route against Kong Gateway
-// validation endpoint.
+// hostnames against the given KongHostnamePolicies, and uses provided
+// routesValidator to validate the route against Kong Gateway validation endpoint.
 func ValidateHTTPRoute(
 	ctx context.Context,
 	routesValidator routeValidator,
 	translatorFeatures translator.FeatureFlags,
 	httproute *gatewayapi.HTTPRoute,
 	managerClient client.Client,
+	hostnamePolicies []*kongv1alpha1.KongHostnamePolicy,
 ) (bool, string, error) {
 	// Check if route is managed by this controller. If not, we don't need to validate it.
 	routeIsManaged, err := ensureHTTPRouteIsManagedByController(ctx, httproute, managerClient)
@@ -46,10 +51,6 @@ func ValidateHTTPRoute(
 		return true, "", nil
 	}
 
-	if err := validateHTTPRouteTimeoutBackendRequest(httproute); err != nil {
-		return false, fmt.Sprintf("HTTPRoute spec did not pass validation: %s", err), nil
-	}
-
 	// Validate that no unsupported features are in use.
 	if err := validateHTTPRouteFeatures(httproute, translatorFeatures); err != nil {
 		return false, fmt.Sprintf("HTTPRoute spec did not pass validation: %s", err), nil
@@ -60,6 +61,26 @@ func ValidateHTTPRoute(
 		return false, fmt.Sprintf("HTTPRoute has invalid Kong annotations: %s", err), nil
 	}
 
+	// Validate that the priority adjustment doesn't move the route's expression routes out of the priority range of
+	// HTTPRoutes, which would silently clamp their priorities.
+	if translatorFeatures.ExpressionRoutes {
+		if err := subtranslator.CheckHTTPRoutePriorityAdjustment(httproute); err != nil {
+			return false, fmt.Sprintf("HTTPRoute has invalid Kong annotations: invalid %s value: %s",
+				annotations.AnnotationPrefix+annotations.PriorityAdjustKey, err), nil
+		}
+	}
+
+	// Validate that the route uses only hostnames allowed in its namespace. A route without hostnames matches all hosts.
+	hostnames := httproute.Spec.Hostnames
+	if len(hostnames) == 0 {
+		hostnames = []gatewayapi.Hostname{""}
+	}
+	for _, hostname := range hostnames {
+		if err := translator.CheckHostnameAllowedByPolicies(hostnamePolicies, httproute.Namespace, string(hostname)); err != nil {
+			return false, fmt.Sprintf("HTTPRoute violates KongHostnamePolicy: %s", err), nil
+		}
+	}
+
 	// Validate that the route is valid against Kong Gateway.
 	ok, msg := validateWithKongGateway(ctx, routesValidator, translatorFeatures, httproute)
 	return ok, msg, nil
@@ -146,6 +167,12 @@ func validateHTTPRouteFeatures(httproute *gatewayapi.HTTPRoute, translatorFeatur
 				return fmt.Errorf("rules[%d].filters[%d]: filter type %s is unsupported",
 					ruleIndex, filterIndex, filter.Type)
 			}
+			// Filter CORS has to be translatable into a Kong cors plugin.
+			if filter.Type == gatewayapi.HTTPRouteFilterCORS {
+				if err := subtranslator.ValidateHTTPCORSFilter(filter.CORS); err != nil {
+					return fmt.Errorf("rules[%d].filters[%d]: %w", ruleIndex, filterIndex, err)
+				}
+			}
 		}
 
 		for refIndex, ref := range rule.BackendRefs {
@@ -155,14 +182,20 @@ func validateHTTPRouteFeatures(httproute *gatewayapi.HTTPRoute, translatorFeatur
 					ruleIndex, refIndex)
 			}
 
-			// We don't support any backendRef types except Kubernetes Services.
-			if ref.BackendRef.Group != nil && *ref.BackendRef.Group != "core" && *ref.BackendRef.Group != "" {
-				return fmt.Errorf("rules[%d].backendRefs[%d]: %s is not a supported group for httproute backendRefs, only core is supported",
-					ruleIndex, refIndex, *ref.BackendRef.Group)
+			// We don't support any backendRef types except Kubernetes Services, KongServiceFacades and KongExternalBackends.
+			kind := KindService
+			if ref.BackendRef.Kind != nil {
+				kind = *ref.BackendRef.Kind
 			}
-			if ref.BackendRef.Kind != nil && *ref.BackendRef.Kind != KindService {
-				return fmt.Errorf("rules[%d].backendRefs[%d]: %s is not a supported kind for httproute backendRefs, only %s is supported",
-					ruleIndex, refIndex, *ref.BackendRef.Kind, KindService)
+			if !util.IsBackendRefGroupKindSupported(gatewayapi.V1HTTPRouteTypeMeta.Kind, ref.BackendRef.Group, &kind) {
+				group := gatewayapi.Group("core")
+				if ref.BackendRef.Group != nil && *ref.BackendRef.Group != "" {
+					group = *ref.BackendRef.Group
+				}
+				return fmt.Errorf("rules[%d].backendRefs[%d]: %s/%s is not a supported group/kind for httproute backendRefs, only core/%s, %s/%s and %s/%s are supported",
+					ruleIndex, refIndex, group, kind, KindService,
+					incubatorv1alpha1.GroupVersion.Group, incubatorv1alpha1.KongServiceFacadeKind,
+					kongv1alpha1.GroupVersion.Group, kongv1alpha1.KongExternalBackendKind)
 			}
 		}
 
@@ -230,25 +263,3 @@ func validateWithKongGateway(
 func validationMsg(errMsgs []string) string {
 	return fmt.Sprintf("HTTPRoute failed schema validation: %s", strings.Join(errMsgs, ", "))
 }
-
-func validateHTTPRouteTimeoutBackendRequest(httproute *gatewayapi.HTTPRoute) error {
-	// TODO: remove the validate after we figure out how to handle granular timeout settings
-	// (allowing setting timeouts per rule and not enforcing the same timeout for every HTTPRoute's rule).
-	// https://github.com/Kong/kubernetes-ingress-controller/issues/5451
-
-	var firstTimeoutFound *gatewayapi.Duration
-	for _, rule := range httproute.Spec.Rules {
-		if firstTimeoutFound != nil {
-			if rule.Timeouts == nil {
-				return fmt.Errorf("timeout is set for one of the rules, but not set for another")
-			}
-			if rule.Timeouts != nil && *rule.Timeouts.BackendRequest != *firstTimeoutFound {
-				return fmt.Errorf("timeout is set for one of the rules, but a different value is set in another rule")
-			}
-		} else if rule.Timeouts != nil && rule.Timeouts.BackendRequest != nil {
-			firstTimeoutFound = rule.Timeouts.BackendRequest
-		}
-	}
-
-	return nil
-}
@@ -2,14 +2,17 @@ package ingress
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/go-logr/logr"
 	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
 	netv1 "k8s.io/api/networking/v1"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/admission/validation"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/failures"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator/subtranslator"
@@ -38,6 +41,15 @@ func ValidateIngress(
 		return false, fmt.Sprintf("Ingress has invalid Kong annotations: %s", err), nil
 	}
 
+	if err := validateIngressPriorityAdjustment(translatorFeatures, ingress, storer); err != nil {
+		return false, fmt.Sprintf("Ingress has invalid Kong annotations: invalid %s value: %s",
+			annotations.AnnotationPrefix+annotations.PriorityAdjustKey, err), nil
+	}
+
+	if err := validateIngressHostnamesAllowedByPolicies(ingress, storer); err != nil {
+		return false, fmt.Sprintf("Ingress violates KongHostnamePolicy: %s", err), nil
+	}
+
 	for _, kg := range ingressToKongRoutesForValidation(translatorFeatures, ingress, failuresCollector, storer) {
 		kg := kg
 		// Validate by using feature of Kong Gateway.
@@ -87,3 +99,51 @@ func ingressToKongRoutesForValidation(
 	}
 	return kongRoutes
 }
+
+// validateIngressPriorityAdjustment returns an error if the adjustment from the konghq.com/priority-adjustment
+// annotation would move priorities of the Ingress' expression routes out of the priority range of Ingresses, which
+// would silently clamp them. The unadjusted priorities are calculated by translating the Ingress without the annotation.
+func validateIngressPriorityAdjustment(
+	translatorFeatures translator.FeatureFlags,
+	ingress *netv1.Ingress,
+	storer store.Storer,
+) error {
+	value, ok := annotations.ExtractPriorityAdjustment(ingress.Annotations)
+	if !ok || !translatorFeatures.ExpressionRoutes {
+		return nil
+	}
+	unadjusted := ingress.DeepCopy()
+	delete(unadjusted.Annotations, annotations.AnnotationPrefix+annotations.PriorityAdjustKey)
+	routes := ingressToKongRoutesForValidation(
+		// Translation failures are reported by the validation of the Ingress itself.
+		translatorFeatures, unadjusted, failures.NewResourceFailuresCollector(logr.Discard()), storer,
+	)
+	priorities := lo.FilterMap(routes, func(route kong.Route, _ int) (subtranslator.RoutePriorityType, bool) {
+		if route.Priority == nil {
+			return 0, false
+		}
+		return *route.Priority, true
+	})
+	return subtranslator.CheckRoutePriorityAdjustment(value, priorities...)
+}
+
+// validateIngressHostnamesAllowedByPolicies returns an error if any of the hostnames used in Ingress rules
+// or TLS sections is not allowed in the Ingress' namespace by KongHostnamePolicies. Rules without a host and
+// the default backend match all hosts.
+func validateIngressHostnamesAllowedByPolicies(ingress *netv1.Ingress, storer store.Storer) error {
+	policies := storer.ListKongHostnamePolicies()
+	hosts := lo.Map(ingress.Spec.Rules, func(rule netv1.IngressRule, _ int) string { return rule.Host })
+	for _, tls := range ingress.Spec.TLS {
+		hosts = append(hosts, tls.Hosts...)
+	}
+	if ingress.Spec.DefaultBackend != nil {
+		hosts = append(hosts, "")
+	}
+	var errs []error
+	for _, host := range lo.Uniq(hosts) {
+		if err := translator.CheckHostnameAllowedByPolicies(policies, ingress.Namespace, host); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
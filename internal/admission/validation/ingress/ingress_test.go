@@ -6,6 +6,7 @@ import (
 
 	"github.com/go-logr/zapr"
 	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
@@ -16,15 +17,17 @@ import (
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
+	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
 )
 
 func TestValidateIngress(t *testing.T) {
 	for _, tt := range []struct {
-		msg           string
-		ingress       *netv1.Ingress
-		valid         bool
-		validationMsg string
-		err           error
+		msg              string
+		ingress          *netv1.Ingress
+		hostnamePolicies []*kongv1alpha1.KongHostnamePolicy
+		valid            bool
+		validationMsg    string
+		err              error
 	}{
 		{
 			msg: "invalid protocols",
@@ -40,6 +43,84 @@ func TestValidateIngress(t *testing.T) {
 			valid:         false,
 			validationMsg: "Ingress has invalid Kong annotations: invalid konghq.com/protocols value: ohno",
 		},
+		{
+			msg: "priority adjustment out of range",
+			ingress: &netv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: corev1.NamespaceDefault,
+					Name:      "testing",
+					Annotations: map[string]string{
+						annotations.AnnotationPrefix + annotations.PriorityAdjustKey: "17592186044416",
+					},
+				},
+			},
+			valid: false,
+			validationMsg: "Ingress has invalid Kong annotations: invalid konghq.com/priority-adjustment value: " +
+				"17592186044416 is out of the [-17592186044415, 17592186044415] range",
+		},
+		{
+			msg: "hostnames owned by another namespace",
+			ingress: &netv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: corev1.NamespaceDefault,
+					Name:      "testing",
+				},
+				Spec: netv1.IngressSpec{
+					Rules: []netv1.IngressRule{
+						{Host: "api.example.com"},
+						{Host: "www.example.com"},
+					},
+					TLS: []netv1.IngressTLS{
+						{Hosts: []string{"admin.example.com"}},
+					},
+				},
+			},
+			hostnamePolicies: []*kongv1alpha1.KongHostnamePolicy{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "example"},
+					Spec: kongv1alpha1.KongHostnamePolicySpec{
+						Hostnames:  []string{"*.example.com"},
+						Namespaces: []string{"team-a"},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "www"},
+					Spec: kongv1alpha1.KongHostnamePolicySpec{
+						Hostnames:  []string{"www.example.com"},
+						Namespaces: []string{corev1.NamespaceDefault},
+					},
+				},
+			},
+			valid: false,
+			validationMsg: "Ingress violates KongHostnamePolicy: " +
+				`hostname "api.example.com" is not allowed in namespace default by KongHostnamePolicy example` + "\n" +
+				`hostname "admin.example.com" is not allowed in namespace default by KongHostnamePolicy example`,
+		},
+		{
+			msg: "hostnames owned by the namespace",
+			ingress: &netv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: corev1.NamespaceDefault,
+					Name:      "testing",
+				},
+				Spec: netv1.IngressSpec{
+					Rules: []netv1.IngressRule{
+						{Host: "www.example.com"},
+						{Host: "other.test"},
+					},
+				},
+			},
+			hostnamePolicies: []*kongv1alpha1.KongHostnamePolicy{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "www"},
+					Spec: kongv1alpha1.KongHostnamePolicySpec{
+						Hostnames:  []string{"www.example.com"},
+						Namespaces: []string{corev1.NamespaceDefault},
+					},
+				},
+			},
+			valid: true,
+		},
 	} {
 		t.Run(tt.msg, func(t *testing.T) {
 			logger := zapr.NewLogger(zap.NewNop())
@@ -47,6 +128,7 @@ func TestValidateIngress(t *testing.T) {
 				IngressesV1: []*netv1.Ingress{
 					tt.ingress,
 				},
+				KongHostnamePolicies: tt.hostnamePolicies,
 			})
 			require.NoError(t, err)
 			valid, validMsg, err := ValidateIngress(
@@ -64,6 +146,80 @@ func TestValidateIngress(t *testing.T) {
 	}
 }
 
+func TestValidateIngress_PriorityAdjustmentWithExpressionRoutes(t *testing.T) {
+	ingress := func(adjustment string) *netv1.Ingress {
+		return &netv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: corev1.NamespaceDefault,
+				Name:      "testing",
+				Annotations: map[string]string{
+					annotations.AnnotationPrefix + annotations.PriorityAdjustKey: adjustment,
+				},
+			},
+			Spec: netv1.IngressSpec{
+				IngressClassName: lo.ToPtr(annotations.DefaultIngressClass),
+				Rules: []netv1.IngressRule{{
+					Host: "example.com",
+					IngressRuleValue: netv1.IngressRuleValue{
+						HTTP: &netv1.HTTPIngressRuleValue{
+							Paths: []netv1.HTTPIngressPath{{
+								Path:     "/",
+								PathType: lo.ToPtr(netv1.PathTypePrefix),
+								Backend: netv1.IngressBackend{
+									Service: &netv1.IngressServiceBackend{
+										Name: "svc",
+										Port: netv1.ServiceBackendPort{Number: 80},
+									},
+								},
+							}},
+						},
+					},
+				}},
+			},
+		}
+	}
+
+	for _, tt := range []struct {
+		msg           string
+		adjustment    string
+		valid         bool
+		validationMsg string
+	}{
+		{
+			msg:        "adjustment keeping the priority in the range of Ingresses",
+			adjustment: "-100",
+			valid:      true,
+		},
+		{
+			msg:        "adjustment moving the priority out of the range of Ingresses",
+			adjustment: "17592186044415",
+			validationMsg: "Ingress has invalid Kong annotations: invalid konghq.com/priority-adjustment value: " +
+				"17592186044415 would move priorities of some routes out of the priority range of their kind",
+		},
+	} {
+		t.Run(tt.msg, func(t *testing.T) {
+			ingress := ingress(tt.adjustment)
+			fakestore, err := store.NewFakeStore(store.FakeObjects{IngressesV1: []*netv1.Ingress{ingress}})
+			require.NoError(t, err)
+			valid, validMsg, err := ValidateIngress(
+				context.Background(),
+				mockRoutesValidator{},
+				translator.FeatureFlags{ExpressionRoutes: true},
+				ingress,
+				zapr.NewLogger(zap.NewNop()),
+				fakestore,
+			)
+			require.NoError(t, err)
+			require.Equal(t, tt.valid, valid)
+			if tt.valid {
+				require.Empty(t, validMsg)
+				return
+			}
+			require.Contains(t, validMsg, tt.validationMsg)
+		})
+	}
+}
+
 type mockRoutesValidator struct{}
 
 func (mockRoutesValidator) Validate(_ context.Context, _ *kong.Route) (bool, string, error) {
@@ -6,6 +6,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator/subtranslator"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
 )
 
@@ -16,5 +17,10 @@ func ValidateRouteSourceAnnotations(obj client.Object) error {
 			return fmt.Errorf("invalid %s value: %s", annotations.AnnotationPrefix+annotations.ProtocolsKey, protocol)
 		}
 	}
+	if adjustment, ok := annotations.ExtractPriorityAdjustment(obj.GetAnnotations()); ok {
+		if _, err := subtranslator.ParseRoutePriorityAdjustment(adjustment); err != nil {
+			return fmt.Errorf("invalid %s value: %w", annotations.AnnotationPrefix+annotations.PriorityAdjustKey, err)
+		}
+	}
 	return nil
 }
@@ -266,9 +266,84 @@ func (validator KongHTTPValidator) ValidateConsumerGroup(
 			return false, fmt.Sprintf("%s: %s", ErrTextConsumerGroupUnexpected, err), nil
 		}
 	}
+
+	// Plugins attached to the consumer group must support being scoped to consumer groups.
+	for _, pluginRef := range annotations.ExtractNamespacedKongPluginsFromAnnotations(consumerGroup.Annotations) {
+		pluginName, err := validator.getReferencedPluginName(ctx, consumerGroup.Namespace, pluginRef)
+		if err != nil {
+			return false, "", err
+		}
+		// Plugins that don't exist yet can't be checked, they're validated when attached in the translation.
+		if pluginName == "" {
+			continue
+		}
+		if !validator.isPluginConsumerGroupScopingSupported(ctx, pluginName) {
+			return false, fmt.Sprintf(ErrTextConsumerGroupPluginUnsupported, pluginRef.Name, pluginName), nil
+		}
+	}
 	return true, "", nil
 }
 
+// getReferencedPluginName returns the name of the Kong plugin configured by the KongPlugin or KongClusterPlugin
+// referenced by an object in the given namespace, or an empty string when neither exists.
+func (validator KongHTTPValidator) getReferencedPluginName(
+	ctx context.Context,
+	namespace string,
+	pluginRef annotations.NamespacedKongPlugin,
+) (string, error) {
+	if pluginRef.Namespace != "" {
+		namespace = pluginRef.Namespace
+	}
+	var plugin kongv1.KongPlugin
+	err := validator.ManagerClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: pluginRef.Name}, &plugin)
+	if err == nil {
+		return plugin.PluginName, nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return "", fmt.Errorf("failed to get KongPlugin %s/%s: %w", namespace, pluginRef.Name, err)
+	}
+
+	var clusterPlugin kongv1.KongClusterPlugin
+	err = validator.ManagerClient.Get(ctx, client.ObjectKey{Name: pluginRef.Name}, &clusterPlugin)
+	if err == nil {
+		return clusterPlugin.PluginName, nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return "", fmt.Errorf("failed to get KongClusterPlugin %s: %w", pluginRef.Name, err)
+	}
+	return "", nil
+}
+
+// isPluginConsumerGroupScopingSupported checks in the plugin's schema retrieved from Kong whether the plugin can
+// be scoped to consumer groups. Plugins that can't be scoped constrain their consumer_group field to null.
+// When the schema can't be retrieved, the plugin is assumed to support it, so it's up to Kong to reject it.
+func (validator KongHTTPValidator) isPluginConsumerGroupScopingSupported(ctx context.Context, pluginName string) bool {
+	pluginSvc, ok := validator.AdminAPIServicesProvider.GetPluginsService()
+	if !ok {
+		return true
+	}
+	schema, err := pluginSvc.GetFullSchema(ctx, kong.String(pluginName))
+	if err != nil {
+		validator.Logger.V(logging.DebugLevel).Info("Failed to fetch plugin schema", "plugin", pluginName, "error", err)
+		return true
+	}
+	fields, _ := schema["fields"].([]interface{})
+	for _, field := range fields {
+		fieldMap, ok := field.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		consumerGroupField, ok := fieldMap["consumer_group"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if eq, hasEq := consumerGroupField["eq"]; hasEq && eq == nil {
+			return false
+		}
+	}
+	return true
+}
+
 // ValidateCredential checks if the secret contains a credential meant to
 // be installed in Kong. If so, then it verifies if all the required fields
 // are present in it or not. If valid, it returns true with an empty string,
@@ -332,6 +407,12 @@ func (validator KongHTTPValidator) ValidatePlugin(
 	k8sPlugin kongv1.KongPlugin,
 	overrideSecrets []*corev1.Secret,
 ) (bool, string, error) {
+	if err := kongstate.CheckPluginAllowedByPolicies(
+		validator.Storer.ListKongPluginPolicies(), k8sPlugin.Namespace, k8sPlugin.PluginName,
+	); err != nil {
+		return false, fmt.Sprintf(ErrTextPluginNotAllowedByPolicy, err), nil
+	}
+
 	var plugin kong.Plugin
 	plugin.Name = kong.String(k8sPlugin.PluginName)
 	var err error
@@ -379,6 +460,14 @@ func (validator KongHTTPValidator) ValidateClusterPlugin(
 	k8sPlugin kongv1.KongClusterPlugin,
 	overrideSecrets []*corev1.Secret,
 ) (bool, string, error) {
+	// KongClusterPlugins are not namespaced, so only policies applying to all namespaces can be checked here.
+	// Policies of particular namespaces are enforced when the plugin is attached to resources in them.
+	if err := kongstate.CheckPluginAllowedByPolicies(
+		validator.Storer.ListKongPluginPolicies(), "", k8sPlugin.PluginName,
+	); err != nil {
+		return false, fmt.Sprintf(ErrTextPluginNotAllowedByPolicy, err), nil
+	}
+
 	var plugin kong.Plugin
 	plugin.Name = kong.String(k8sPlugin.PluginName)
 	var err error
@@ -449,6 +538,7 @@ func (validator KongHTTPValidator) ValidateHTTPRoute(
 	}
 	return gatewayvalidation.ValidateHTTPRoute(
 		ctx, routeValidator, validator.TranslatorFeatures, &httproute, validator.ManagerClient,
+		validator.Storer.ListKongHostnamePolicies(),
 	)
 }
 
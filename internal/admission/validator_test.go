@@ -38,15 +38,23 @@ import (
 type fakePluginSvc struct {
 	kong.AbstractPluginService
 
-	err   error
-	msg   string
-	valid bool
+	err     error
+	msg     string
+	valid   bool
+	schemas map[string]kong.Schema
 }
 
 func (f *fakePluginSvc) Validate(context.Context, *kong.Plugin) (bool, string, error) {
 	return f.valid, f.msg, f.err
 }
 
+func (f *fakePluginSvc) GetFullSchema(_ context.Context, pluginName *string) (kong.Schema, error) {
+	if schema, ok := f.schemas[*pluginName]; ok {
+		return schema, nil
+	}
+	return nil, kong.NewAPIError(http.StatusNotFound, "plugin not found")
+}
+
 type fakeConsumersSvc struct {
 	kong.AbstractConsumerService
 	consumer *kong.Consumer
@@ -120,6 +128,15 @@ func (f fakeServicesProvider) GetSchemasService() (kong.AbstractSchemaService, b
 
 func TestKongHTTPValidator_ValidatePlugin(t *testing.T) {
 	store, _ := store.NewFakeStore(store.FakeObjects{
+		KongPluginPolicies: []*kongv1alpha1.KongPluginPolicy{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "no-serverless"},
+				Spec: kongv1alpha1.KongPluginPolicySpec{
+					Namespaces:    []string{"team-a"},
+					DeniedPlugins: []string{"pre-function"},
+				},
+			},
+		},
 		Secrets: []*corev1.Secret{
 			{
 				ObjectMeta: metav1.ObjectMeta{
@@ -260,6 +277,30 @@ func TestKongHTTPValidator_ValidatePlugin(t *testing.T) {
 			wantMessage: ErrTextPluginConfigValidationFailed,
 			wantErr:     true,
 		},
+		{
+			name:      "plugin is denied by KongPluginPolicy",
+			PluginSvc: &fakePluginSvc{valid: true},
+			args: args{
+				plugin: kongv1.KongPlugin{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "team-a"},
+					PluginName: "pre-function",
+				},
+			},
+			wantOK:      false,
+			wantMessage: `plugin violates KongPluginPolicy: plugin "pre-function" is not allowed in namespace team-a by KongPluginPolicy no-serverless`,
+			wantErr:     false,
+		},
+		{
+			name:      "plugin is allowed by KongPluginPolicy in another namespace",
+			PluginSvc: &fakePluginSvc{valid: true},
+			args: args{
+				plugin: kongv1.KongPlugin{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "team-b"},
+					PluginName: "pre-function",
+				},
+			},
+			wantOK: true,
+		},
 		{
 			name:      "validate from override secret which generates valid configuration",
 			PluginSvc: &fakePluginSvc{valid: true},
@@ -293,6 +334,7 @@ func TestKongHTTPValidator_ValidatePlugin(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			validator := KongHTTPValidator{
 				SecretGetter: store,
+				Storer:       store,
 				AdminAPIServicesProvider: fakeServicesProvider{
 					pluginSvc: tt.PluginSvc,
 				},
@@ -319,6 +361,21 @@ func TestKongHTTPValidator_ValidatePlugin(t *testing.T) {
 
 func TestKongHTTPValidator_ValidateClusterPlugin(t *testing.T) {
 	store, _ := store.NewFakeStore(store.FakeObjects{
+		KongPluginPolicies: []*kongv1alpha1.KongPluginPolicy{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "team-a-no-serverless"},
+				Spec: kongv1alpha1.KongPluginPolicySpec{
+					Namespaces:    []string{"team-a"},
+					DeniedPlugins: []string{"pre-function"},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "no-serverless"},
+				Spec: kongv1alpha1.KongPluginPolicySpec{
+					DeniedPlugins: []string{"post-function"},
+				},
+			},
+		},
 		Secrets: []*corev1.Secret{
 			{
 				ObjectMeta: metav1.ObjectMeta{
@@ -491,6 +548,24 @@ func TestKongHTTPValidator_ValidateClusterPlugin(t *testing.T) {
 			},
 			wantOK: true,
 		},
+		{
+			name:      "plugin is denied by KongPluginPolicy applying to all namespaces",
+			PluginSvc: &fakePluginSvc{valid: true},
+			args: args{
+				plugin: kongv1.KongClusterPlugin{PluginName: "post-function"},
+			},
+			wantOK:      false,
+			wantMessage: `plugin violates KongPluginPolicy: plugin "post-function" is not allowed by KongPluginPolicy no-serverless`,
+			wantErr:     false,
+		},
+		{
+			name:      "plugin denied by KongPluginPolicy of a particular namespace is accepted",
+			PluginSvc: &fakePluginSvc{valid: true},
+			args: args{
+				plugin: kongv1.KongClusterPlugin{PluginName: "pre-function"},
+			},
+			wantOK: true,
+		},
 		{
 			name:      "no gateway was available at the time of validation",
 			PluginSvc: nil, // no plugin service is available as there's no gateways
@@ -506,6 +581,7 @@ func TestKongHTTPValidator_ValidateClusterPlugin(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			validator := KongHTTPValidator{
 				SecretGetter: store,
+				Storer:       store,
 				AdminAPIServicesProvider: fakeServicesProvider{
 					pluginSvc: tt.PluginSvc,
 				},
@@ -709,6 +785,7 @@ func TestKongHTTPValidator_ValidateConsumerGroup(t *testing.T) {
 		name                 string
 		ConsumerGroupSvc     kong.AbstractConsumerGroupService
 		InfoSvc              kong.AbstractInfoService
+		PluginSvc            kong.AbstractPluginService
 		ManagerClientObjects []client.Object
 		args                 args
 		wantOK               bool
@@ -797,6 +874,71 @@ func TestKongHTTPValidator_ValidateConsumerGroup(t *testing.T) {
 			wantMessage: "",
 			wantErr:     false,
 		},
+		{
+			name:             "Enterprise Kong Gateway and KongConsumerGroup with plugins supporting consumer group scoping passes",
+			ConsumerGroupSvc: &fakeConsumerGroupSvc{err: nil},
+			InfoSvc:          &fakeInfoSvc{version: "3.4.1.0"},
+			PluginSvc:        consumerGroupScopingPluginSvc,
+			ManagerClientObjects: []client.Object{
+				&kongv1.KongPlugin{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "rate-limit",
+						Namespace: "default",
+					},
+					PluginName: "rate-limiting-advanced",
+				},
+				&kongv1.KongClusterPlugin{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "transform",
+					},
+					PluginName: "request-transformer",
+				},
+			},
+			args: args{
+				cg: kongv1beta1.KongConsumerGroup{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "default",
+						Annotations: map[string]string{
+							annotations.AnnotationPrefix + annotations.PluginsKey: "rate-limit,transform",
+						},
+					},
+				},
+			},
+			wantOK: true,
+		},
+		{
+			name:             "Enterprise Kong Gateway and KongConsumerGroup with plugin not supporting consumer group scoping fails",
+			ConsumerGroupSvc: &fakeConsumerGroupSvc{err: nil},
+			InfoSvc:          &fakeInfoSvc{version: "3.4.1.0"},
+			PluginSvc:        consumerGroupScopingPluginSvc,
+			ManagerClientObjects: []client.Object{
+				&kongv1.KongPlugin{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "rate-limit",
+						Namespace: "default",
+					},
+					PluginName: "rate-limiting-advanced",
+				},
+				&kongv1.KongClusterPlugin{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "auth",
+					},
+					PluginName: "key-auth",
+				},
+			},
+			args: args{
+				cg: kongv1beta1.KongConsumerGroup{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "default",
+						Annotations: map[string]string{
+							annotations.AnnotationPrefix + annotations.PluginsKey: "rate-limit,auth",
+						},
+					},
+				},
+			},
+			wantOK:      false,
+			wantMessage: fmt.Sprintf(ErrTextConsumerGroupPluginUnsupported, "auth", "key-auth"),
+		},
 		{
 			name:             "OSS version",
 			ConsumerGroupSvc: &fakeConsumerGroupSvc{err: nil},
@@ -875,6 +1017,7 @@ func TestKongHTTPValidator_ValidateConsumerGroup(t *testing.T) {
 				AdminAPIServicesProvider: fakeServicesProvider{
 					infoSvc:          tt.InfoSvc,
 					consumerGroupSvc: tt.ConsumerGroupSvc,
+					pluginSvc:        tt.PluginSvc,
 				},
 				ingressClassMatcher: fakeClassMatcher,
 				Logger:              zapr.NewLogger(zap.NewNop()),
@@ -894,6 +1037,25 @@ func TestKongHTTPValidator_ValidateConsumerGroup(t *testing.T) {
 	}
 }
 
+// consumerGroupScopingPluginSvc serves schemas of a plugin that can be scoped to consumer groups and of one that can't.
+var consumerGroupScopingPluginSvc = &fakePluginSvc{
+	schemas: map[string]kong.Schema{
+		"rate-limiting-advanced": {
+			"fields": []interface{}{
+				map[string]interface{}{"protocols": map[string]interface{}{"type": "set"}},
+			},
+		},
+		"key-auth": {
+			"fields": []interface{}{
+				map[string]interface{}{"protocols": map[string]interface{}{"type": "set"}},
+				map[string]interface{}{"consumer_group": map[string]interface{}{
+					"type": "foreign", "reference": "consumer_groups", "eq": nil,
+				}},
+			},
+		},
+	},
+}
+
 func fakeClassMatcher(*metav1.ObjectMeta, string, annotations.ClassMatching) bool { return true }
 
 func TestKongHTTPValidator_ValidateCredential(t *testing.T) {
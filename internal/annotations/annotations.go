@@ -18,6 +18,7 @@ package annotations
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/samber/lo"
@@ -67,6 +68,12 @@ const (
 	PathHandlingKey      = "/path-handling"
 	UserTagKey           = "/tags"
 	RewriteURIKey        = "/rewrite"
+	FallbackPolicyKey    = "/fallback-policy"
+	// PriorityAdjustKey is the annotation adding a signed integer to the priorities of expression routes translated
+	// from Ingresses, HTTPRoutes and GRPCRoutes. Adjusted priorities never leave the priority range of the object's
+	// kind: the admission webhook rejects Ingresses and HTTPRoutes with adjustments that would move any of their
+	// routes' priorities out of it, while priorities of GRPCRoutes (not validated by the webhook) are clamped to it.
+	PriorityAdjustKey = "/priority-adjustment"
 
 	// GatewayClassUnmanagedKey is an annotation used on a Gateway resource to
 	// indicate that the GatewayClass should be reconciled according to unmanaged
@@ -388,6 +395,8 @@ func UpdateUnmanagedAnnotation(anns map[string]string, annotationValue string) {
 }
 
 // ExtractGatewayPublishService extracts the value of the gateway publish service annotation.
+// The annotation holds a comma-separated list of "namespace/name" Service references, whitespace
+// around the entries is trimmed and empty or duplicate entries are dropped.
 func ExtractGatewayPublishService(anns map[string]string) []string {
 	if anns == nil {
 		return []string{}
@@ -396,7 +405,15 @@ func ExtractGatewayPublishService(anns map[string]string) []string {
 	if !ok {
 		return []string{}
 	}
-	return strings.Split(publish, ",")
+	services := []string{}
+	for _, svc := range strings.Split(publish, ",") {
+		svc = strings.TrimSpace(svc)
+		if svc == "" || slices.Contains(services, svc) {
+			continue
+		}
+		services = append(services, svc)
+	}
+	return services
 }
 
 // UpdateGatewayPublishService updates the value of the annotation konghq.com/gatewayclass-unmanaged.
@@ -426,3 +443,15 @@ func ExtractUpstreamPolicy(anns map[string]string) (string, bool) {
 	s, ok := anns[kongv1beta1.KongUpstreamPolicyAnnotationKey]
 	return s, ok
 }
+
+// ExtractFallbackPolicy extracts the fallback policy annotation value.
+func ExtractFallbackPolicy(anns map[string]string) (string, bool) {
+	s, ok := anns[AnnotationPrefix+FallbackPolicyKey]
+	return s, ok
+}
+
+// ExtractPriorityAdjustment extracts the priority adjustment annotation value.
+func ExtractPriorityAdjustment(anns map[string]string) (string, bool) {
+	s, ok := anns[AnnotationPrefix+PriorityAdjustKey]
+	return s, ok
+}
@@ -1066,3 +1066,64 @@ func TestExtractRewriteURI(t *testing.T) {
 		})
 	}
 }
+
+func TestExtractFallbackPolicy(t *testing.T) {
+	tests := []struct {
+		name  string
+		anns  map[string]string
+		want  string
+		exist bool
+	}{
+		{
+			name: "empty",
+			want: "",
+		},
+		{
+			name: "non-empty",
+			anns: map[string]string{
+				"konghq.com/fallback-policy": "backfill",
+			},
+			want:  "backfill",
+			exist: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, exist := ExtractFallbackPolicy(tt.anns)
+			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.exist, exist)
+		})
+	}
+}
+
+func TestExtractGatewayPublishService(t *testing.T) {
+	tests := []struct {
+		name string
+		anns map[string]string
+		want []string
+	}{
+		{
+			name: "empty",
+			want: []string{},
+		},
+		{
+			name: "single service",
+			anns: map[string]string{
+				"konghq.com/publish-service": "kong/proxy",
+			},
+			want: []string{"kong/proxy"},
+		},
+		{
+			name: "multiple services with whitespace, empty and duplicate entries",
+			anns: map[string]string{
+				"konghq.com/publish-service": "kong/proxy, kong/proxy-udp,,kong/proxy",
+			},
+			want: []string{"kong/proxy", "kong/proxy-udp"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, ExtractGatewayPublishService(tt.anns))
+		})
+	}
+}
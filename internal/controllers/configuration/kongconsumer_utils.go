@@ -0,0 +1,27 @@
+package configuration
+
+import (
+	"reflect"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/controllers"
+	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
+)
+
+// ensureKongConsumerStatusFields sets the credentials, the consumer groups and the plugins of the KongConsumer
+// computed in the most recent configuration update in its status. It returns true if the status was changed.
+// Status of KongConsumers not included in the most recent configuration update is left as is.
+func ensureKongConsumerStatusFields(dataplaneClient controllers.DataPlane, obj *kongv1.KongConsumer) bool {
+	status, ok := dataplaneClient.KongConsumerStatus(obj)
+	if !ok {
+		return false
+	}
+	if reflect.DeepEqual(obj.Status.Credentials, status.Credentials) &&
+		reflect.DeepEqual(obj.Status.ConsumerGroups, status.ConsumerGroups) &&
+		reflect.DeepEqual(obj.Status.Plugins, status.Plugins) {
+		return false
+	}
+	obj.Status.Credentials = status.Credentials
+	obj.Status.ConsumerGroups = status.ConsumerGroups
+	obj.Status.Plugins = status.Plugins
+	return true
+}
@@ -0,0 +1,24 @@
+package configuration
+
+import (
+	"reflect"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/controllers"
+	kongv1beta1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1beta1"
+)
+
+// ensureKongConsumerGroupStatusFields sets the member consumers count and the plugins of the KongConsumerGroup
+// computed in the most recent configuration update in its status. It returns true if the status was changed.
+// Status of KongConsumerGroups not included in the most recent configuration update is left as is.
+func ensureKongConsumerGroupStatusFields(dataplaneClient controllers.DataPlane, obj *kongv1beta1.KongConsumerGroup) bool {
+	status, ok := dataplaneClient.KongConsumerGroupStatus(obj)
+	if !ok {
+		return false
+	}
+	if obj.Status.Consumers == status.Consumers && reflect.DeepEqual(obj.Status.Plugins, status.Plugins) {
+		return false
+	}
+	obj.Status.Consumers = status.Consumers
+	obj.Status.Plugins = status.Plugins
+	return true
+}
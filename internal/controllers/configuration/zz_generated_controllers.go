@@ -1024,6 +1024,9 @@ func (r *KongV1KongConsumerReconciler) Reconcile(ctx context.Context, req ctrl.R
 			obj.Status.Conditions,
 		)
 		obj.Status.Conditions = conditions
+		if ensureKongConsumerStatusFields(r.DataplaneClient, obj) {
+			updateNeeded = true
+		}
 		if updateNeeded {
 			return ctrl.Result{}, r.Status().Update(ctx, obj)
 		}
@@ -1210,6 +1213,9 @@ func (r *KongV1Beta1KongConsumerGroupReconciler) Reconcile(ctx context.Context,
 			obj.Status.Conditions,
 		)
 		obj.Status.Conditions = conditions
+		if ensureKongConsumerGroupStatusFields(r.DataplaneClient, obj) {
+			updateNeeded = true
+		}
 		if updateNeeded {
 			return ctrl.Result{}, r.Status().Update(ctx, obj)
 		}
@@ -2181,6 +2187,330 @@ func (r *KongV1Alpha1KongCustomEntityReconciler) Reconcile(ctx context.Context,
 	return ctrl.Result{}, nil
 }
 
+// -----------------------------------------------------------------------------
+// KongV1Alpha1 KongPluginPolicy - Reconciler
+// -----------------------------------------------------------------------------
+
+// KongV1Alpha1KongPluginPolicyReconciler reconciles KongPluginPolicy resources
+type KongV1Alpha1KongPluginPolicyReconciler struct {
+	client.Client
+
+	Log              logr.Logger
+	Scheme           *runtime.Scheme
+	DataplaneClient  controllers.DataPlane
+	CacheSyncTimeout time.Duration
+}
+
+var _ controllers.Reconciler = &KongV1Alpha1KongPluginPolicyReconciler{}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *KongV1Alpha1KongPluginPolicyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	blder := ctrl.NewControllerManagedBy(mgr).
+		// set the controller name
+		Named("KongV1Alpha1KongPluginPolicy").
+		WithOptions(controller.Options{
+			LogConstructor: func(_ *reconcile.Request) logr.Logger {
+				return r.Log
+			},
+			CacheSyncTimeout: r.CacheSyncTimeout,
+		})
+	return blder.For(&kongv1alpha1.KongPluginPolicy{}).
+		Complete(r)
+}
+
+// SetLogger sets the logger.
+func (r *KongV1Alpha1KongPluginPolicyReconciler) SetLogger(l logr.Logger) {
+	r.Log = l
+}
+
+//+kubebuilder:rbac:groups=configuration.konghq.com,resources=kongpluginpolicies,verbs=get;list;watch
+
+// Reconcile processes the watched objects
+func (r *KongV1Alpha1KongPluginPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithValues("KongV1Alpha1KongPluginPolicy", req.NamespacedName)
+
+	// get the relevant object
+	obj := new(kongv1alpha1.KongPluginPolicy)
+
+	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			obj.Namespace = req.Namespace
+			obj.Name = req.Name
+
+			return ctrl.Result{}, r.DataplaneClient.DeleteObject(obj)
+		}
+		return ctrl.Result{}, err
+	}
+	log.V(logging.DebugLevel).Info("Reconciling resource", "namespace", req.Namespace, "name", req.Name)
+
+	// clean the object up if it's being deleted
+	if !obj.DeletionTimestamp.IsZero() && time.Now().After(obj.DeletionTimestamp.Time) {
+		log.V(logging.DebugLevel).Info("Resource is being deleted, its configuration will be removed", "type", "KongPluginPolicy", "namespace", req.Namespace, "name", req.Name)
+
+		objectExistsInCache, err := r.DataplaneClient.ObjectExists(obj)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if objectExistsInCache {
+			if err := r.DataplaneClient.DeleteObject(obj); err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{Requeue: true}, nil // wait until the object is no longer present in the cache
+		}
+		return ctrl.Result{}, nil
+	}
+
+	// update the kong Admin API with the changes
+	if err := r.DataplaneClient.UpdateObject(obj); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// -----------------------------------------------------------------------------
+// KongV1Alpha1 KongExternalBackend - Reconciler
+// -----------------------------------------------------------------------------
+
+// KongV1Alpha1KongExternalBackendReconciler reconciles KongExternalBackend resources
+type KongV1Alpha1KongExternalBackendReconciler struct {
+	client.Client
+
+	Log              logr.Logger
+	Scheme           *runtime.Scheme
+	DataplaneClient  controllers.DataPlane
+	CacheSyncTimeout time.Duration
+}
+
+var _ controllers.Reconciler = &KongV1Alpha1KongExternalBackendReconciler{}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *KongV1Alpha1KongExternalBackendReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	blder := ctrl.NewControllerManagedBy(mgr).
+		// set the controller name
+		Named("KongV1Alpha1KongExternalBackend").
+		WithOptions(controller.Options{
+			LogConstructor: func(_ *reconcile.Request) logr.Logger {
+				return r.Log
+			},
+			CacheSyncTimeout: r.CacheSyncTimeout,
+		})
+	return blder.For(&kongv1alpha1.KongExternalBackend{}).
+		Complete(r)
+}
+
+// SetLogger sets the logger.
+func (r *KongV1Alpha1KongExternalBackendReconciler) SetLogger(l logr.Logger) {
+	r.Log = l
+}
+
+//+kubebuilder:rbac:groups=configuration.konghq.com,resources=kongexternalbackends,verbs=get;list;watch
+
+// Reconcile processes the watched objects
+func (r *KongV1Alpha1KongExternalBackendReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithValues("KongV1Alpha1KongExternalBackend", req.NamespacedName)
+
+	// get the relevant object
+	obj := new(kongv1alpha1.KongExternalBackend)
+
+	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			obj.Namespace = req.Namespace
+			obj.Name = req.Name
+
+			return ctrl.Result{}, r.DataplaneClient.DeleteObject(obj)
+		}
+		return ctrl.Result{}, err
+	}
+	log.V(logging.DebugLevel).Info("Reconciling resource", "namespace", req.Namespace, "name", req.Name)
+
+	// clean the object up if it's being deleted
+	if !obj.DeletionTimestamp.IsZero() && time.Now().After(obj.DeletionTimestamp.Time) {
+		log.V(logging.DebugLevel).Info("Resource is being deleted, its configuration will be removed", "type", "KongExternalBackend", "namespace", req.Namespace, "name", req.Name)
+
+		objectExistsInCache, err := r.DataplaneClient.ObjectExists(obj)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if objectExistsInCache {
+			if err := r.DataplaneClient.DeleteObject(obj); err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{Requeue: true}, nil // wait until the object is no longer present in the cache
+		}
+		return ctrl.Result{}, nil
+	}
+
+	// update the kong Admin API with the changes
+	if err := r.DataplaneClient.UpdateObject(obj); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// -----------------------------------------------------------------------------
+// KongV1Alpha1 KongHostnamePolicy - Reconciler
+// -----------------------------------------------------------------------------
+
+// KongV1Alpha1KongHostnamePolicyReconciler reconciles KongHostnamePolicy resources
+type KongV1Alpha1KongHostnamePolicyReconciler struct {
+	client.Client
+
+	Log              logr.Logger
+	Scheme           *runtime.Scheme
+	DataplaneClient  controllers.DataPlane
+	CacheSyncTimeout time.Duration
+}
+
+var _ controllers.Reconciler = &KongV1Alpha1KongHostnamePolicyReconciler{}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *KongV1Alpha1KongHostnamePolicyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	blder := ctrl.NewControllerManagedBy(mgr).
+		// set the controller name
+		Named("KongV1Alpha1KongHostnamePolicy").
+		WithOptions(controller.Options{
+			LogConstructor: func(_ *reconcile.Request) logr.Logger {
+				return r.Log
+			},
+			CacheSyncTimeout: r.CacheSyncTimeout,
+		})
+	return blder.For(&kongv1alpha1.KongHostnamePolicy{}).
+		Complete(r)
+}
+
+// SetLogger sets the logger.
+func (r *KongV1Alpha1KongHostnamePolicyReconciler) SetLogger(l logr.Logger) {
+	r.Log = l
+}
+
+//+kubebuilder:rbac:groups=configuration.konghq.com,resources=konghostnamepolicies,verbs=get;list;watch
+
+// Reconcile processes the watched objects
+func (r *KongV1Alpha1KongHostnamePolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithValues("KongV1Alpha1KongHostnamePolicy", req.NamespacedName)
+
+	// get the relevant object
+	obj := new(kongv1alpha1.KongHostnamePolicy)
+
+	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			obj.Namespace = req.Namespace
+			obj.Name = req.Name
+
+			return ctrl.Result{}, r.DataplaneClient.DeleteObject(obj)
+		}
+		return ctrl.Result{}, err
+	}
+	log.V(logging.DebugLevel).Info("Reconciling resource", "namespace", req.Namespace, "name", req.Name)
+
+	// clean the object up if it's being deleted
+	if !obj.DeletionTimestamp.IsZero() && time.Now().After(obj.DeletionTimestamp.Time) {
+		log.V(logging.DebugLevel).Info("Resource is being deleted, its configuration will be removed", "type", "KongHostnamePolicy", "namespace", req.Namespace, "name", req.Name)
+
+		objectExistsInCache, err := r.DataplaneClient.ObjectExists(obj)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if objectExistsInCache {
+			if err := r.DataplaneClient.DeleteObject(obj); err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{Requeue: true}, nil // wait until the object is no longer present in the cache
+		}
+		return ctrl.Result{}, nil
+	}
+
+	// update the kong Admin API with the changes
+	if err := r.DataplaneClient.UpdateObject(obj); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// -----------------------------------------------------------------------------
+// KongV1Alpha1 GatewayClassParameters - Reconciler
+// -----------------------------------------------------------------------------
+
+// KongV1Alpha1GatewayClassParametersReconciler reconciles GatewayClassParameters resources
+type KongV1Alpha1GatewayClassParametersReconciler struct {
+	client.Client
+
+	Log              logr.Logger
+	Scheme           *runtime.Scheme
+	DataplaneClient  controllers.DataPlane
+	CacheSyncTimeout time.Duration
+}
+
+var _ controllers.Reconciler = &KongV1Alpha1GatewayClassParametersReconciler{}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *KongV1Alpha1GatewayClassParametersReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	blder := ctrl.NewControllerManagedBy(mgr).
+		// set the controller name
+		Named("KongV1Alpha1GatewayClassParameters").
+		WithOptions(controller.Options{
+			LogConstructor: func(_ *reconcile.Request) logr.Logger {
+				return r.Log
+			},
+			CacheSyncTimeout: r.CacheSyncTimeout,
+		})
+	return blder.For(&kongv1alpha1.GatewayClassParameters{}).
+		Complete(r)
+}
+
+// SetLogger sets the logger.
+func (r *KongV1Alpha1GatewayClassParametersReconciler) SetLogger(l logr.Logger) {
+	r.Log = l
+}
+
+//+kubebuilder:rbac:groups=configuration.konghq.com,resources=gatewayclassparameterses,verbs=get;list;watch
+
+// Reconcile processes the watched objects
+func (r *KongV1Alpha1GatewayClassParametersReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithValues("KongV1Alpha1GatewayClassParameters", req.NamespacedName)
+
+	// get the relevant object
+	obj := new(kongv1alpha1.GatewayClassParameters)
+
+	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			obj.Namespace = req.Namespace
+			obj.Name = req.Name
+
+			return ctrl.Result{}, r.DataplaneClient.DeleteObject(obj)
+		}
+		return ctrl.Result{}, err
+	}
+	log.V(logging.DebugLevel).Info("Reconciling resource", "namespace", req.Namespace, "name", req.Name)
+
+	// clean the object up if it's being deleted
+	if !obj.DeletionTimestamp.IsZero() && time.Now().After(obj.DeletionTimestamp.Time) {
+		log.V(logging.DebugLevel).Info("Resource is being deleted, its configuration will be removed", "type", "GatewayClassParameters", "namespace", req.Namespace, "name", req.Name)
+
+		objectExistsInCache, err := r.DataplaneClient.ObjectExists(obj)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if objectExistsInCache {
+			if err := r.DataplaneClient.DeleteObject(obj); err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{Requeue: true}, nil // wait until the object is no longer present in the cache
+		}
+		return ctrl.Result{}, nil
+	}
+
+	// update the kong Admin API with the changes
+	if err := r.DataplaneClient.UpdateObject(obj); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
 // -----------------------------------------------------------------------------
 // API Group "" resource nodes
 // -----------------------------------------------------------------------------
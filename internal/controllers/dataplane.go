@@ -7,6 +7,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	k8sobj "github.com/kong/kubernetes-ingress-controller/v3/internal/util/kubernetes/object"
+	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
+	kongv1beta1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1beta1"
 )
 
 // DataPlane is a common interface that is used by reconcilers to interact
@@ -22,6 +24,8 @@ type DataPlaneStatusClient interface {
 	AreKubernetesObjectReportsEnabled() bool
 	KubernetesObjectConfigurationStatus(obj client.Object) k8sobj.ConfigurationStatus
 	KubernetesObjectIsConfigured(obj client.Object) bool
+	KongConsumerStatus(obj *kongv1.KongConsumer) (kongv1.KongConsumerStatus, bool)
+	KongConsumerGroupStatus(obj *kongv1beta1.KongConsumerGroup) (kongv1beta1.KongConsumerGroupStatus, bool)
 }
 
 // DataPlaneClient is a common client interface that is used by reconcilers to interact
@@ -32,8 +32,10 @@ import (
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/controllers"
 	ctrlref "github.com/kong/kubernetes-ingress-controller/v3/internal/controllers/reference"
 	ctrlutils "github.com/kong/kubernetes-ingress-controller/v3/internal/controllers/utils"
+	dpconf "github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/config"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/logging"
+	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
 )
 
 // -----------------------------------------------------------------------------
@@ -66,9 +68,17 @@ type GatewayReconciler struct { //nolint:revive
 	// It's resolved on SetupWithManager call.
 	enableReferenceGrant bool
 
+	// If enableGatewayClassParameters is true, controller will watch GatewayClassParameters
+	// to apply their defaults to the Gateways of the GatewayClasses referencing them.
+	// It's resolved on SetupWithManager call.
+	enableGatewayClassParameters bool
+
 	// If GatewayNN is set,
 	// only resources managed by the specified Gateway are reconciled.
 	GatewayNN controllers.OptionalNamespacedName
+
+	// RouterFlavor is the router flavor used by Kong.
+	RouterFlavor dpconf.RouterFlavor
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -88,6 +98,21 @@ func (r *GatewayReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Version:  gatewayv1beta1.GroupVersion.Version,
 		Resource: "referencegrants",
 	})
+	r.enableGatewayClassParameters = ctrlutils.CRDExists(mgr.GetRESTMapper(), schema.GroupVersionResource{
+		Group:    kongv1alpha1.GroupVersion.Group,
+		Version:  kongv1alpha1.GroupVersion.Version,
+		Resource: "gatewayclassparameterses",
+	})
+
+	if err := mgr.GetCache().IndexField(
+		context.Background(),
+		&gatewayapi.Gateway{},
+		gatewayPublishServiceIndexKey,
+		indexGatewaysOnPublishServiceAnnotation,
+	); err != nil {
+		return fmt.Errorf("failed to index Gateways on annotation %s: %w",
+			annotations.AnnotationPrefix+annotations.GatewayPublishServiceKey, err)
+	}
 
 	blder := ctrl.NewControllerManagedBy(mgr).
 		// set the controller name
@@ -131,6 +156,13 @@ func (r *GatewayReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		)
 	}
 
+	// watch GatewayClassParameters, which may change publish services and default TLS settings of Gateways
+	if r.enableGatewayClassParameters {
+		blder.Watches(&kongv1alpha1.GatewayClassParameters{},
+			handler.EnqueueRequestsFromMapFunc(r.listGatewaysForGatewayClassParameters),
+		)
+	}
+
 	if err := blder.Complete(r); err != nil {
 		return err
 	}
@@ -140,7 +172,9 @@ func (r *GatewayReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Client:           r.Client,
 		Log:              r.Log.WithName(strings.ToUpper(gatewayapi.V1GroupVersion) + "GatewayClass"),
 		Scheme:           r.Scheme,
+		DataplaneClient:  r.DataplaneClient,
 		CacheSyncTimeout: r.CacheSyncTimeout,
+		RouterFlavor:     r.RouterFlavor,
 	}
 
 	return gwcCTRL.SetupWithManager(mgr)
@@ -223,6 +257,32 @@ func (r *GatewayReconciler) listGatewaysForGatewayClass(ctx context.Context, gat
 	return reconcileGatewaysIfClassMatches(gatewayClass, gateways.Items)
 }
 
+// listGatewaysForGatewayClassParameters is a watch predicate which finds all the gateway objects
+// of the gatewayclasses referencing the given GatewayClassParameters to enqueue them for reconciliation.
+func (r *GatewayReconciler) listGatewaysForGatewayClassParameters(ctx context.Context, obj client.Object) []reconcile.Request {
+	params, ok := obj.(*kongv1alpha1.GatewayClassParameters)
+	if !ok {
+		r.Log.Error(
+			fmt.Errorf("unexpected object type"),
+			"GatewayClassParameters watch predicate received unexpected object type",
+			"expected", "*kongv1alpha1.GatewayClassParameters", "found", reflect.TypeOf(obj),
+		)
+		return nil
+	}
+	gatewayClasses := &gatewayapi.GatewayClassList{}
+	if err := r.Client.List(ctx, gatewayClasses); err != nil {
+		r.Log.Error(err, "Failed to list gatewayclasses in watch", "gatewayclassparameters", client.ObjectKeyFromObject(params))
+		return nil
+	}
+	var recs []reconcile.Request
+	for _, gwc := range gatewayClasses.Items {
+		if gatewayClassReferencesParameters(&gwc, params) && isGatewayClassControlled(&gwc) {
+			recs = append(recs, r.listGatewaysForGatewayClass(ctx, &gwc)...)
+		}
+	}
+	return recs
+}
+
 // listReferenceGrantsForGateway is a watch predicate which finds all Gateways mentioned in a From clause for a
 // ReferenceGrant.
 func (r *GatewayReconciler) listReferenceGrantsForGateway(ctx context.Context, obj client.Object) []reconcile.Request {
@@ -297,7 +357,13 @@ func (r *GatewayReconciler) listGatewaysForService(ctx context.Context, svc clie
 		}
 	}
 
+	svcRef := client.ObjectKeyFromObject(svc).String()
 	for _, gateway := range gateways.Items {
+		// Gateways that don't have their publish services set yet are enqueued too, as they may end up using the service.
+		if serviceRefs := annotations.ExtractGatewayPublishService(gateway.Annotations); len(serviceRefs) > 0 &&
+			!lo.Contains(serviceRefs, svcRef) {
+			continue
+		}
 		gatewayClass := &gatewayapi.GatewayClass{}
 		if err := r.Client.Get(ctx, k8stypes.NamespacedName{Name: string(gateway.Spec.GatewayClassName)}, gatewayClass); err != nil {
 			r.Log.Error(err, "Failed to retrieve gateway class in watch predicates", "gatewayclass", gateway.Spec.GatewayClassName)
@@ -312,7 +378,7 @@ func (r *GatewayReconciler) listGatewaysForService(ctx context.Context, svc clie
 			})
 		}
 	}
-	return nil
+	return recs
 }
 
 // listGatewaysForHTTPRoute retrieves all the gateways referenced as parents by the HTTPRoute.
@@ -341,13 +407,45 @@ func (r *GatewayReconciler) listGatewaysForHTTPRoute(_ context.Context, obj clie
 }
 
 // isGatewayService is a watch predicate that filters out events for objects that aren't
-// the gateway service referenced by --publish-service or --publish-service-udp.
+// the gateway service referenced by --publish-service or --publish-service-udp, or one of
+// the publish services selected by a Gateway's konghq.com/publish-service annotation.
+// The latter are looked up in the Gateways index, so no Gateways are listed for other Services.
 func (r *GatewayReconciler) isGatewayService(obj client.Object) bool {
-	isPublishService := fmt.Sprintf("%s/%s", obj.GetNamespace(), obj.GetName()) == r.PublishServiceRef.String()
+	svcRef := fmt.Sprintf("%s/%s", obj.GetNamespace(), obj.GetName())
+	isPublishService := svcRef == r.PublishServiceRef.String()
 	isUDPPublishService := r.PublishServiceUDPRef.IsPresent() &&
-		fmt.Sprintf("%s/%s", obj.GetNamespace(), obj.GetName()) == r.PublishServiceUDPRef.MustGet().String()
+		svcRef == r.PublishServiceUDPRef.MustGet().String()
+	if isPublishService || isUDPPublishService {
+		return true
+	}
+
+	// Predicates get no context, listing from the cache index doesn't block anyway.
+	var gateways gatewayapi.GatewayList
+	if err := r.Client.List(context.Background(), &gateways,
+		client.MatchingFields{gatewayPublishServiceIndexKey: svcRef},
+	); err != nil {
+		r.Log.Error(err, "Failed to list gateways for service in watch predicates", "service", svcRef)
+		return false
+	}
+	return len(gateways.Items) > 0
+}
+
+// -----------------------------------------------------------------------------
+// Gateway Controller - Indexers
+// -----------------------------------------------------------------------------
+
+// gatewayPublishServiceIndexKey is the key of the index of Gateways by the publish services
+// listed in their konghq.com/publish-service annotation.
+const gatewayPublishServiceIndexKey = "publishService"
 
-	return isPublishService || isUDPPublishService
+// indexGatewaysOnPublishServiceAnnotation indexes the Gateways on the <namespace>/<name> entries
+// of the konghq.com/publish-service annotation.
+func indexGatewaysOnPublishServiceAnnotation(o client.Object) []string {
+	gateway, ok := o.(*gatewayapi.Gateway)
+	if !ok {
+		return []string{}
+	}
+	return annotations.ExtractGatewayPublishService(gateway.Annotations)
 }
 
 func referenceGrantHasGatewayFrom(obj client.Object) bool {
@@ -456,9 +554,11 @@ func (r *GatewayReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		return reconcile.Result{}, nil
 	}
 
+	gwcParams := r.getGatewayClassParameters(ctx, log, gwc)
+
 	if isGatewayClassUnmanaged(gwc.Annotations) {
 		// The Gateway has to be reconciled by KIC only if it is unmanaged.
-		if result, err := r.reconcileUnmanagedGateway(ctx, log, gateway); err != nil {
+		if result, err := r.reconcileUnmanagedGateway(ctx, log, gateway, gwcParams); err != nil {
 			return result, err
 		}
 	}
@@ -470,7 +570,7 @@ func (r *GatewayReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 			return ctrl.Result{}, err
 		}
 
-		referredSecretNames := listSecretNamesReferredByGateway(gateway)
+		referredSecretNames := listSecretNamesReferredByGateway(gateway, gwcParams)
 		if err := ctrlref.UpdateReferencesToSecret(
 			ctx, r.Client, r.ReferenceIndexers, r.DataplaneClient,
 			gateway, referredSecretNames); err != nil {
@@ -482,10 +582,42 @@ func (r *GatewayReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	return ctrl.Result{}, nil
 }
 
+// getGatewayClassParameters returns the GatewayClassParameters referenced by the GatewayClass, or nil when
+// the GatewayClass doesn't reference any, they can't be retrieved, or the GatewayClass controller reported them
+// as invalid in the GatewayClass status.
+func (r *GatewayReconciler) getGatewayClassParameters(
+	ctx context.Context,
+	log logr.Logger,
+	gwc *gatewayapi.GatewayClass,
+) *kongv1alpha1.GatewayClassParameters {
+	ref := gwc.Spec.ParametersRef
+	if !r.enableGatewayClassParameters || ref == nil || ref.Namespace == nil ||
+		string(ref.Group) != kongv1alpha1.GroupVersion.Group || string(ref.Kind) != kongv1alpha1.GatewayClassParametersKind {
+		return nil
+	}
+	if gatewayapi.GatewayClassHasInvalidParameters(gwc) {
+		log.V(logging.DebugLevel).Info("GatewayClass has invalid parameters, ignoring them", "gatewayclass", gwc.Name)
+		return nil
+	}
+	params := &kongv1alpha1.GatewayClassParameters{}
+	nn := k8stypes.NamespacedName{Namespace: string(*ref.Namespace), Name: ref.Name}
+	if err := r.Client.Get(ctx, nn, params); err != nil {
+		log.V(logging.DebugLevel).Info("Could not retrieve gatewayclassparameters, ignoring them",
+			"gatewayclass", gwc.Name, "gatewayclassparameters", nn.String(), "error", err.Error())
+		return nil
+	}
+	return params
+}
+
 // reconcileUnmanagedGateway reconciles a Gateway that is configured for unmanaged mode,
 // this mode will extract the Addresses and Listeners for the Gateway from the Kubernetes Service
 // used for the Kong Gateway in the pre-existing deployment.
-func (r *GatewayReconciler) reconcileUnmanagedGateway(ctx context.Context, log logr.Logger, gateway *gatewayapi.Gateway) (ctrl.Result, error) {
+func (r *GatewayReconciler) reconcileUnmanagedGateway(
+	ctx context.Context,
+	log logr.Logger,
+	gateway *gatewayapi.Gateway,
+	gwcParams *kongv1alpha1.GatewayClassParameters,
+) (ctrl.Result, error) {
 	// currently this controller supports only unmanaged gateway mode, we need to verify
 	// any Gateway object that comes to us is configured appropriately, and if not reject it
 	// with a clear status condition and message.
@@ -501,6 +633,11 @@ func (r *GatewayReconciler) reconcileUnmanagedGateway(ctx context.Context, log l
 			services = append(services, udpRef.String())
 		}
 
+		// publish services of the GatewayClass take precedence over the controller manager's ones.
+		if gwcParams != nil && len(gwcParams.Spec.PublishServices) > 0 {
+			services = gwcParams.Spec.PublishServices
+		}
+
 		debug(log, gateway, fmt.Sprintf("No publish service annotation, setting it to proxy services %s", services))
 		if gateway.Annotations == nil {
 			gateway.Annotations = map[string]string{}
@@ -516,7 +653,7 @@ func (r *GatewayReconciler) reconcileUnmanagedGateway(ctx context.Context, log l
 	var gatewayServices []*corev1.Service
 	for _, ref := range serviceRefs {
 		r.Log.V(logging.DebugLevel).Info("Determining service for ref", "ref", ref)
-		svc, err := r.determineServiceForGateway(ctx, ref)
+		svc, err := r.determineServiceForGateway(ctx, ref, gwcParams)
 		if err != nil {
 			const annotation = annotations.AnnotationPrefix + annotations.GatewayPublishServiceKey
 			log.Error(
@@ -533,6 +670,34 @@ func (r *GatewayReconciler) reconcileUnmanagedGateway(ctx context.Context, log l
 		}
 	}
 
+	// Gateways requesting addresses of types the controller can't assign are not accepted.
+	if unsupportedAddresses := getUnsupportedGatewayAddresses(gateway); len(unsupportedAddresses) > 0 {
+		unsupportedTypes := lo.Uniq(lo.Map(unsupportedAddresses, func(addr gatewayapi.GatewayAddress, _ int) string {
+			return string(*addr.Type)
+		}))
+		acceptedCondition := metav1.Condition{
+			Type:               string(gatewayapi.GatewayConditionAccepted),
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: gateway.Generation,
+			LastTransitionTime: metav1.Now(),
+			Reason:             string(gatewayapi.GatewayReasonUnsupportedAddress),
+			Message:            fmt.Sprintf("address types %s are not supported, only IPAddress and Hostname are", strings.Join(unsupportedTypes, ", ")),
+		}
+		if isGatewayConditionSet(gateway, acceptedCondition) {
+			return ctrl.Result{}, nil
+		}
+		info(log, gateway, "Marking gateway as not accepted due to unsupported address types")
+		setGatewayCondition(gateway, acceptedCondition)
+		setGatewayCondition(gateway, metav1.Condition{
+			Type:               string(gatewayapi.GatewayConditionProgrammed),
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: gateway.Generation,
+			LastTransitionTime: metav1.Now(),
+			Reason:             string(gatewayapi.GatewayReasonInvalid),
+		})
+		return ctrl.Result{}, r.Status().Update(ctx, pruneGatewayStatusConds(gateway))
+	}
+
 	// set the Gateway as scheduled to indicate that validation is complete and reconciliation work
 	// on the object is ready to begin.
 	if !isGatewayAccepted(gateway) {
@@ -566,6 +731,7 @@ func (r *GatewayReconciler) reconcileUnmanagedGateway(ctx context.Context, log l
 	debug(log, gateway, "Determining listener configurations from publish services")
 	var combinedAddresses []gatewayapi.GatewayStatusAddress
 	var combinedListeners []gatewayapi.Listener
+	var pendingLoadBalancer bool
 	for _, svc := range gatewayServices {
 		kongAddresses, kongListeners, err := r.determineL4ListenersFromService(log, svc)
 		if err != nil {
@@ -578,12 +744,19 @@ func (r *GatewayReconciler) reconcileUnmanagedGateway(ctx context.Context, log l
 		}
 		combinedAddresses = append(combinedAddresses, kongAddresses...)
 		combinedListeners = append(combinedListeners, kongListeners...)
+		if svc.Spec.Type == corev1.ServiceTypeLoadBalancer && len(svc.Status.LoadBalancer.Ingress) == 0 {
+			pendingLoadBalancer = true
+		}
 	}
 
+	// Addresses requested in the Gateway's spec are validated against the publish services' addresses
+	// and take precedence over the PublishStatusAddress(UDP) overrides below.
+	combinedAddresses, notProgrammedCondition := resolveRequestedGatewayAddresses(gateway, combinedAddresses, pendingLoadBalancer)
+
 	// This handles PublishStatusAddress(UDP) override config support, which allows users to set an arbitrary string to
 	// use in place of the proxy Service addresses, usually because there's another proxy in front of Kong and the
 	// addresses associated with the proxy Service aren't actually where you want to direct external clients.
-	if len(r.AddressOverrides)+len(r.AddressOverridesUDP) > 0 {
+	if len(gateway.Spec.Addresses) == 0 && len(r.AddressOverrides)+len(r.AddressOverridesUDP) > 0 {
 		combinedOverrideAddresses := slices.Concat(r.AddressOverrides, r.AddressOverridesUDP)
 		overrides := make([]gatewayapi.GatewayStatusAddress, len(combinedOverrideAddresses))
 		for i, stringAddr := range combinedOverrideAddresses {
@@ -608,7 +781,7 @@ func (r *GatewayReconciler) reconcileUnmanagedGateway(ctx context.Context, log l
 		}
 	}
 
-	listenerStatuses, err := getListenerStatus(ctx, gateway, combinedListeners, referenceGrantList.Items, r.Client)
+	listenerStatuses, err := getListenerStatus(ctx, gateway, combinedListeners, gwcParams, referenceGrantList.Items, r.Client)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
@@ -617,7 +790,7 @@ func (r *GatewayReconciler) reconcileUnmanagedGateway(ctx context.Context, log l
 	// Gateway status reflects the spec. As the status is simply a mirror of the Service, this is
 	// a given and we can simply update spec to status.
 	debug(log, gateway, "Updating the gateway status if necessary")
-	isChanged, err := r.updateAddressesAndListenersStatus(ctx, gateway, listenerStatuses, combinedAddresses)
+	isChanged, err := r.updateAddressesAndListenersStatus(ctx, gateway, listenerStatuses, combinedAddresses, notProgrammedCondition)
 	if err != nil {
 		if apierrors.IsConflict(err) {
 			// if there's a conflict that's normal just requeue to retry, no need to make noise.
@@ -664,10 +837,14 @@ func init() {
 
 // determineServiceForGateway provides the "publish service" (aka the proxy Service) object which
 // will be used to populate unmanaged gateways.
-func (r *GatewayReconciler) determineServiceForGateway(ctx context.Context, ref string) (*corev1.Service, error) {
+func (r *GatewayReconciler) determineServiceForGateway(
+	ctx context.Context,
+	ref string,
+	gwcParams *kongv1alpha1.GatewayClassParameters,
+) (*corev1.Service, error) {
 	// currently the gateway controller ONLY supports service references that correspond with the --publish-service
-	// provided to the controller manager via flags when operating on unmanaged gateways. This constraint may
-	// be loosened in later iterations if there is need.
+	// provided to the controller manager via flags or the publish services of the GatewayClassParameters
+	// when operating on unmanaged gateways. This constraint may be loosened in later iterations if there is need.
 
 	var name k8stypes.NamespacedName
 	switch {
@@ -675,11 +852,19 @@ func (r *GatewayReconciler) determineServiceForGateway(ctx context.Context, ref
 		name = r.PublishServiceRef
 	case r.PublishServiceUDPRef.IsPresent() && ref == r.PublishServiceUDPRef.MustGet().String():
 		name = r.PublishServiceUDPRef.MustGet()
+	case gwcParams != nil && lo.Contains(gwcParams.Spec.PublishServices, ref):
+		namespace, serviceName, _ := strings.Cut(ref, "/")
+		name = k8stypes.NamespacedName{Namespace: namespace, Name: serviceName}
 	default:
 		configuredServiceRefs := []string{fmt.Sprintf("%q", r.PublishServiceRef)}
 		if udpRef, ok := r.PublishServiceUDPRef.Get(); ok {
 			configuredServiceRefs = append(configuredServiceRefs, fmt.Sprintf("%q [udp]", udpRef))
 		}
+		if gwcParams != nil {
+			for _, svcRef := range gwcParams.Spec.PublishServices {
+				configuredServiceRefs = append(configuredServiceRefs, fmt.Sprintf("%q [gatewayclass]", svcRef))
+			}
+		}
 		return nil, fmt.Errorf("publish service reference %q from Gateway's annotations did not match configured controller manager's publish services (%s)",
 			ref, strings.Join(configuredServiceRefs, ", "))
 	}
@@ -853,12 +1038,25 @@ func (r *GatewayReconciler) determineListenersFromDataPlane(
 
 // updateAddressesAndListenersStatus updates a unmanaged gateway's status with new addresses and listeners.
 // If the addresses and listeners provided are the same as what exists, it is assumed that reconciliation is complete and a Programmed condition is posted.
+// When notProgrammedCondition is set (e.g. because the requested addresses can't be assigned), it's posted instead.
 func (r *GatewayReconciler) updateAddressesAndListenersStatus(
 	ctx context.Context,
 	gateway *gatewayapi.Gateway,
 	listenerStatuses []gatewayapi.ListenerStatus,
 	addresses []gatewayapi.GatewayStatusAddress,
+	notProgrammedCondition mo.Option[metav1.Condition],
 ) (bool, error) {
+	if condition, ok := notProgrammedCondition.Get(); ok {
+		if isGatewayConditionSet(gateway, condition) &&
+			reflect.DeepEqual(gateway.Status.Addresses, addresses) &&
+			reflect.DeepEqual(gateway.Status.Listeners, listenerStatuses) {
+			return false, nil
+		}
+		gateway.Status.Listeners = listenerStatuses
+		gateway.Status.Addresses = addresses
+		setGatewayCondition(gateway, condition)
+		return true, r.Status().Update(ctx, pruneGatewayStatusConds(gateway))
+	}
 	if !isGatewayProgrammed(gateway) {
 		gateway.Status.Listeners = listenerStatuses
 		gateway.Status.Addresses = addresses
@@ -872,8 +1070,10 @@ func (r *GatewayReconciler) updateAddressesAndListenersStatus(
 		setGatewayCondition(gateway, programmedCondition)
 		return true, r.Status().Update(ctx, pruneGatewayStatusConds(gateway))
 	}
-	if !reflect.DeepEqual(gateway.Status.Listeners, listenerStatuses) {
+	if !reflect.DeepEqual(gateway.Status.Listeners, listenerStatuses) ||
+		!reflect.DeepEqual(gateway.Status.Addresses, addresses) {
 		gateway.Status.Listeners = listenerStatuses
+		gateway.Status.Addresses = addresses
 		return true, r.Status().Update(ctx, gateway)
 	}
 	return false, nil
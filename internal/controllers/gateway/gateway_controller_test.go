@@ -4,9 +4,14 @@ import (
 	"testing"
 
 	"github.com/samber/lo"
+	"github.com/samber/mo"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
@@ -526,3 +531,36 @@ func TestGetReferenceGrantConditionReason(t *testing.T) {
 		))
 	}
 }
+
+func TestIsGatewayService(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, gatewayapi.InstallV1(scheme))
+	gateway := &gatewayapi.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "gateway",
+			Annotations: map[string]string{
+				annotations.AnnotationPrefix + annotations.GatewayPublishServiceKey: "kong/proxy-a, kong/proxy-b",
+			},
+		},
+	}
+	r := &GatewayReconciler{
+		Client: fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(gateway).
+			WithIndex(&gatewayapi.Gateway{}, gatewayPublishServiceIndexKey, indexGatewaysOnPublishServiceAnnotation).
+			Build(),
+		PublishServiceRef:    k8stypes.NamespacedName{Namespace: "kong", Name: "proxy"},
+		PublishServiceUDPRef: mo.Some(k8stypes.NamespacedName{Namespace: "kong", Name: "proxy-udp"}),
+	}
+
+	service := func(namespace, name string) *corev1.Service {
+		return &corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+	}
+	assert.True(t, r.isGatewayService(service("kong", "proxy")), "--publish-service")
+	assert.True(t, r.isGatewayService(service("kong", "proxy-udp")), "--publish-service-udp")
+	assert.True(t, r.isGatewayService(service("kong", "proxy-a")), "first entry of the Gateway annotation")
+	assert.True(t, r.isGatewayService(service("kong", "proxy-b")), "second entry of the Gateway annotation")
+	assert.False(t, r.isGatewayService(service("kong", "other")))
+	assert.False(t, r.isGatewayService(service("default", "proxy-a")))
+}
@@ -4,11 +4,14 @@ import (
 	"context"
 	"encoding/pem"
 	"fmt"
+	"net"
 	"reflect"
 	"sort"
+	"strings"
 
 	"github.com/go-logr/logr"
 	"github.com/samber/lo"
+	"github.com/samber/mo"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -22,6 +25,7 @@ import (
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/builder"
+	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
 )
 
 // -----------------------------------------------------------------------------
@@ -72,6 +76,90 @@ func isGatewayProgrammed(gateway *gatewayapi.Gateway) bool {
 	)
 }
 
+// isGatewayConditionSet returns boolean whether the Gateway already has the given condition
+// (ignoring its last transition time) for its current generation.
+func isGatewayConditionSet(gateway *gatewayapi.Gateway, condition metav1.Condition) bool {
+	return lo.ContainsBy(gateway.Status.Conditions, func(c metav1.Condition) bool {
+		return c.Type == condition.Type &&
+			c.Status == condition.Status &&
+			c.Reason == condition.Reason &&
+			c.Message == condition.Message &&
+			c.ObservedGeneration == gateway.Generation
+	})
+}
+
+// getUnsupportedGatewayAddresses returns the addresses requested in the Gateway's spec
+// of types the controller can't assign, i.e. other than IPAddress and Hostname.
+func getUnsupportedGatewayAddresses(gateway *gatewayapi.Gateway) []gatewayapi.GatewayAddress {
+	return lo.Filter(gateway.Spec.Addresses, func(addr gatewayapi.GatewayAddress, _ int) bool {
+		addrType := lo.FromPtrOr(addr.Type, gatewayapi.IPAddressType)
+		return addrType != gatewayapi.IPAddressType && addrType != gatewayapi.HostnameAddressType
+	})
+}
+
+// resolveRequestedGatewayAddresses returns the addresses to publish in the Gateway's status given the addresses
+// of its publish services. When the Gateway doesn't request any addresses in its spec, all the publish services'
+// addresses are returned. Otherwise, only the requested addresses that are among the publish services' addresses
+// are returned, along with a Programmed condition with status False when any of the requested addresses can't be used.
+// pendingLoadBalancer tells whether any of the publish services is a LoadBalancer with addresses not provisioned yet,
+// in which case the requested addresses that aren't found are reported as not assigned (yet) instead of not usable.
+func resolveRequestedGatewayAddresses(
+	gateway *gatewayapi.Gateway,
+	available []gatewayapi.GatewayStatusAddress,
+	pendingLoadBalancer bool,
+) ([]gatewayapi.GatewayStatusAddress, mo.Option[metav1.Condition]) {
+	if len(gateway.Spec.Addresses) == 0 {
+		return available, mo.None[metav1.Condition]()
+	}
+
+	var (
+		assigned                  []gatewayapi.GatewayStatusAddress
+		notUsable, notAssigned    []string
+		availableAddressesStrings = lo.Map(available, func(addr gatewayapi.GatewayStatusAddress, _ int) string {
+			return addr.Value
+		})
+	)
+	for _, requested := range gateway.Spec.Addresses {
+		addrType := lo.FromPtrOr(requested.Type, gatewayapi.IPAddressType)
+		if addrType == gatewayapi.IPAddressType && net.ParseIP(requested.Value) == nil {
+			notUsable = append(notUsable, fmt.Sprintf("%q is not a valid IP address", requested.Value))
+			continue
+		}
+		_, found := lo.Find(available, func(addr gatewayapi.GatewayStatusAddress) bool {
+			return lo.FromPtrOr(addr.Type, gatewayapi.IPAddressType) == addrType && addr.Value == requested.Value
+		})
+		switch {
+		case found:
+			assigned = append(assigned, gatewayapi.GatewayStatusAddress{
+				Type:  lo.ToPtr(addrType),
+				Value: requested.Value,
+			})
+		case pendingLoadBalancer:
+			notAssigned = append(notAssigned, fmt.Sprintf("%q is not assigned to the publish services yet", requested.Value))
+		default:
+			notUsable = append(notUsable, fmt.Sprintf("%q is not an address of the publish services (%s)",
+				requested.Value, strings.Join(availableAddressesStrings, ", ")))
+		}
+	}
+
+	if len(notUsable)+len(notAssigned) == 0 {
+		return assigned, mo.None[metav1.Condition]()
+	}
+	// Addresses that can't be used at all take precedence over the ones that may be assigned later.
+	reason := gatewayapi.GatewayReasonAddressNotAssigned
+	if len(notUsable) > 0 {
+		reason = gatewayapi.GatewayReasonAddressNotUsable
+	}
+	return assigned, mo.Some(metav1.Condition{
+		Type:               string(gatewayapi.GatewayConditionProgrammed),
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: gateway.Generation,
+		LastTransitionTime: metav1.Now(),
+		Reason:             string(reason),
+		Message:            "requested addresses can't be used: " + strings.Join(append(notUsable, notAssigned...), "; "),
+	})
+}
+
 // Warning: this function is used for both GatewayClasses and Gateways.
 // The former uses "true" as the value, whereas the latter uses "namespace/service" CSVs for the proxy services.
 
@@ -113,16 +201,16 @@ func reconcileGatewaysIfClassMatches(gatewayClass client.Object, gateways []gate
 	return
 }
 
-// list namespaced names of secrets referred by the gateway.
-func listSecretNamesReferredByGateway(gateway *gatewayapi.Gateway) map[k8stypes.NamespacedName]struct{} {
+// list namespaced names of secrets referred by the gateway, including the default certificate
+// of its GatewayClassParameters used by TLS-terminating listeners without certificates of their own.
+func listSecretNamesReferredByGateway(
+	gateway *gatewayapi.Gateway,
+	gwcParams *kongv1alpha1.GatewayClassParameters,
+) map[k8stypes.NamespacedName]struct{} {
 	nsNames := make(map[k8stypes.NamespacedName]struct{})
 
 	for _, listener := range gateway.Spec.Listeners {
-		if listener.TLS == nil {
-			continue
-		}
-
-		for _, certRef := range listener.TLS.CertificateRefs {
+		for _, certRef := range listenerCertificateRefs(listener, gwcParams) {
 			if certRef.Group != nil && *certRef.Group != corev1.GroupName {
 				continue
 			}
@@ -145,6 +233,29 @@ func listSecretNamesReferredByGateway(gateway *gatewayapi.Gateway) map[k8stypes.
 	return nsNames
 }
 
+// listenerCertificateRefs returns the certificate references of the listener. TLS-terminating listeners
+// without certificates of their own use the default certificate of the GatewayClassParameters, if any.
+func listenerCertificateRefs(
+	listener gatewayapi.Listener,
+	gwcParams *kongv1alpha1.GatewayClassParameters,
+) []gatewayapi.SecretObjectReference {
+	if listener.TLS == nil {
+		return nil
+	}
+	if len(listener.TLS.CertificateRefs) > 0 ||
+		lo.FromPtrOr(listener.TLS.Mode, gatewayapi.TLSModeTerminate) != gatewayapi.TLSModeTerminate ||
+		gwcParams == nil || gwcParams.Spec.ListenerTLS == nil || gwcParams.Spec.ListenerTLS.CertificateRef == nil {
+		return listener.TLS.CertificateRefs
+	}
+	certRef := gwcParams.Spec.ListenerTLS.CertificateRef
+	return []gatewayapi.SecretObjectReference{{
+		Group:     lo.ToPtr(gatewayapi.Group(corev1.GroupName)),
+		Kind:      lo.ToPtr(gatewayapi.Kind("Secret")),
+		Name:      gatewayapi.ObjectName(certRef.Name),
+		Namespace: lo.ToPtr(gatewayapi.Namespace(certRef.Namespace)),
+	}}
+}
+
 // extractListenerSpecFromGateway returns the spec of the listener with the given name.
 // returns nil if the listener with given name is not found.
 func extractListenerSpecFromGateway(gateway *gatewayapi.Gateway, listenerName gatewayapi.SectionName) *gatewayapi.Listener {
@@ -231,6 +342,7 @@ func getListenerStatus(
 	ctx context.Context,
 	gateway *gatewayapi.Gateway,
 	kongListens []gatewayapi.Listener,
+	gwcParams *kongv1alpha1.GatewayClassParameters,
 	referenceGrants []gatewayapi.ReferenceGrant,
 	client client.Client,
 ) ([]gatewayapi.ListenerStatus, error) {
@@ -250,10 +362,10 @@ func getListenerStatus(
 		supportedkinds, ResolvedRefsReason := getListenerSupportedRouteKinds(listener)
 
 		// If the listener uses TLS, we need to ensure that the gateway is granted to reference
-		// all the secrets it references
+		// all the secrets it references, including the default certificate of its GatewayClassParameters
 		if listener.TLS != nil {
 			tlsResolvedRefReason := string(gatewayapi.ListenerReasonResolvedRefs)
-			for _, certRef := range listener.TLS.CertificateRefs {
+			for _, certRef := range listenerCertificateRefs(listener, gwcParams) {
 				// if the certificate is in the same namespace of the gateway, no ReferenceGrant is needed
 				if certRef.Namespace != nil && *certRef.Namespace != (gatewayapi.Namespace)(gateway.Namespace) {
 					// get the result of the certificate reference. If the returned reason is not successful, the loop
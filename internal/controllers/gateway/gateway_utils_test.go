@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/samber/lo"
+	"github.com/samber/mo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/builder"
+	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
 )
 
 func TestGetListenerSupportedRouteKinds(t *testing.T) {
@@ -128,6 +130,7 @@ func TestGetListenerStatus(t *testing.T) {
 	testCases := []struct {
 		name                     string
 		gateway                  *gatewayapi.Gateway
+		gwcParams                *kongv1alpha1.GatewayClassParameters
 		kongListens              []gatewayapi.Listener
 		expectedListenerStatuses []gatewayapi.ListenerStatus
 	}{
@@ -253,12 +256,65 @@ func TestGetListenerStatus(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "listener using a default certificate from another namespace without a ReferenceGrant",
+			gateway: &gatewayapi.Gateway{
+				TypeMeta: gatewayapi.V1GatewayTypeMeta,
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "default-certificate",
+					Namespace: "default",
+				},
+				Spec: gatewayapi.GatewaySpec{
+					GatewayClassName: "kong",
+					Listeners: []gatewayapi.Listener{
+						{
+							Name:     "https-443",
+							Port:     443,
+							Protocol: gatewayapi.HTTPSProtocolType,
+							TLS:      &gatewayapi.GatewayTLSConfig{},
+						},
+					},
+				},
+			},
+			gwcParams: &kongv1alpha1.GatewayClassParameters{
+				Spec: kongv1alpha1.GatewayClassParametersSpec{
+					ListenerTLS: &kongv1alpha1.GatewayClassListenerTLS{
+						CertificateRef: &kongv1alpha1.GatewayClassSecretReference{
+							Name:      "default-cert",
+							Namespace: "certs",
+						},
+					},
+				},
+			},
+			kongListens: []gatewayapi.Listener{
+				{
+					Port:     443,
+					Protocol: gatewayapi.HTTPSProtocolType,
+				},
+			},
+			expectedListenerStatuses: []gatewayapi.ListenerStatus{
+				{
+					Name: gatewayapi.SectionName("https-443"),
+					Conditions: []metav1.Condition{
+						{
+							Type:   string(gatewayapi.ListenerConditionAccepted),
+							Status: metav1.ConditionTrue,
+						},
+						{
+							Type:   string(gatewayapi.ListenerConditionResolvedRefs),
+							Status: metav1.ConditionFalse,
+							Reason: string(gatewayapi.ListenerReasonRefNotPermitted),
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			statuses, err := getListenerStatus(ctx, tc.gateway, tc.kongListens, nil, client)
+			statuses, err := getListenerStatus(ctx, tc.gateway, tc.kongListens, tc.gwcParams, nil, client)
 			require.NoError(t, err)
 			require.Len(t, statuses, len(tc.expectedListenerStatuses), "should return expected number of listener statused")
 			for _, expectedListenerStatus := range tc.expectedListenerStatuses {
@@ -528,3 +584,101 @@ func TestRouteAcceptedByGateways(t *testing.T) {
 		})
 	}
 }
+
+func TestGetUnsupportedGatewayAddresses(t *testing.T) {
+	gateway := &gatewayapi.Gateway{
+		Spec: gatewayapi.GatewaySpec{
+			Addresses: []gatewayapi.GatewayAddress{
+				{Value: "10.0.0.1"},
+				{Type: lo.ToPtr(gatewayapi.HostnameAddressType), Value: "kong.example.com"},
+				{Type: lo.ToPtr(gatewayapi.AddressType("example.com/custom")), Value: "custom"},
+			},
+		},
+	}
+	require.Equal(t, []gatewayapi.GatewayAddress{
+		{Type: lo.ToPtr(gatewayapi.AddressType("example.com/custom")), Value: "custom"},
+	}, getUnsupportedGatewayAddresses(gateway))
+}
+
+func TestResolveRequestedGatewayAddresses(t *testing.T) {
+	available := []gatewayapi.GatewayStatusAddress{
+		{Type: lo.ToPtr(gatewayapi.HostnameAddressType), Value: "lb.example.com"},
+		{Type: lo.ToPtr(gatewayapi.IPAddressType), Value: "10.0.0.1"},
+		{Type: lo.ToPtr(gatewayapi.IPAddressType), Value: "10.0.0.2"},
+	}
+
+	testCases := []struct {
+		name                string
+		requested           []gatewayapi.GatewayAddress
+		available           []gatewayapi.GatewayStatusAddress
+		pendingLoadBalancer bool
+		expectedAddresses   []gatewayapi.GatewayStatusAddress
+		expectedReason      mo.Option[gatewayapi.GatewayConditionReason]
+	}{
+		{
+			name:              "no requested addresses returns all available addresses",
+			available:         available,
+			expectedAddresses: available,
+		},
+		{
+			name: "requested addresses among the available ones are assigned",
+			requested: []gatewayapi.GatewayAddress{
+				{Value: "10.0.0.2"},
+				{Type: lo.ToPtr(gatewayapi.HostnameAddressType), Value: "lb.example.com"},
+			},
+			available: available,
+			expectedAddresses: []gatewayapi.GatewayStatusAddress{
+				{Type: lo.ToPtr(gatewayapi.IPAddressType), Value: "10.0.0.2"},
+				{Type: lo.ToPtr(gatewayapi.HostnameAddressType), Value: "lb.example.com"},
+			},
+		},
+		{
+			name: "requested address not among the available ones is not usable",
+			requested: []gatewayapi.GatewayAddress{
+				{Value: "10.0.0.1"},
+				{Value: "10.0.0.3"},
+			},
+			available: available,
+			expectedAddresses: []gatewayapi.GatewayStatusAddress{
+				{Type: lo.ToPtr(gatewayapi.IPAddressType), Value: "10.0.0.1"},
+			},
+			expectedReason: mo.Some(gatewayapi.GatewayReasonAddressNotUsable),
+		},
+		{
+			name: "invalid IP address is not usable",
+			requested: []gatewayapi.GatewayAddress{
+				{Value: "lb.example.com"},
+			},
+			available:      available,
+			expectedReason: mo.Some(gatewayapi.GatewayReasonAddressNotUsable),
+		},
+		{
+			name: "requested address is not assigned while load balancer is pending",
+			requested: []gatewayapi.GatewayAddress{
+				{Value: "10.0.0.3"},
+			},
+			pendingLoadBalancer: true,
+			expectedReason:      mo.Some(gatewayapi.GatewayReasonAddressNotAssigned),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gateway := &gatewayapi.Gateway{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Spec:       gatewayapi.GatewaySpec{Addresses: tc.requested},
+			}
+			addresses, condition := resolveRequestedGatewayAddresses(gateway, tc.available, tc.pendingLoadBalancer)
+			require.Equal(t, tc.expectedAddresses, addresses)
+			expectedReason, ok := tc.expectedReason.Get()
+			require.Equal(t, ok, condition.IsPresent())
+			if ok {
+				c := condition.MustGet()
+				require.Equal(t, string(gatewayapi.GatewayConditionProgrammed), c.Type)
+				require.Equal(t, metav1.ConditionFalse, c.Status)
+				require.Equal(t, string(expectedReason), c.Reason)
+				require.Equal(t, int64(2), c.ObservedGeneration)
+			}
+		})
+	}
+}
@@ -10,17 +10,25 @@ import (
 
 	"github.com/go-logr/logr"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/controllers"
+	ctrlutils "github.com/kong/kubernetes-ingress-controller/v3/internal/controllers/utils"
+	dpconf "github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/config"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/logging"
-	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
+	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
 )
 
 // -----------------------------------------------------------------------------
@@ -58,14 +66,19 @@ type GatewayClassReconciler struct { //nolint:revive
 	client.Client
 	Log              logr.Logger
 	Scheme           *runtime.Scheme
+	DataplaneClient  controllers.DataPlane
 	CacheSyncTimeout time.Duration
+
+	// RouterFlavor is the router flavor used by Kong. GatewayClasses whose parameters require
+	// a different router flavor are not accepted.
+	RouterFlavor dpconf.RouterFlavor
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *GatewayClassReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	blder := ctrl.NewControllerManagedBy(mgr).
 		// set the controller name
-		Named(strings.ToUpper(gatewayapi.V1GroupVersion) + "GatewayClass").
+		Named(strings.ToUpper(gatewayapi.V1GroupVersion)+"GatewayClass").
 		// set the controller options
 		WithOptions(controller.Options{
 			LogConstructor: func(_ *reconcile.Request) logr.Logger {
@@ -74,10 +87,50 @@ func (r *GatewayClassReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			CacheSyncTimeout: r.CacheSyncTimeout,
 		}).
 		// watch GatewayClass objects
-		For(&gatewayapi.GatewayClass{}).
-		// set the event filters
-		WithEventFilter(predicate.NewPredicateFuncs(r.GatewayClassIsUnmanaged)).
-		Complete(r)
+		For(&gatewayapi.GatewayClass{},
+			builder.WithPredicates(predicate.NewPredicateFuncs(r.GatewayClassIsUnmanaged)),
+		)
+
+	// watch GatewayClassParameters, as they determine whether the GatewayClasses referencing them are accepted
+	if ctrlutils.CRDExists(mgr.GetRESTMapper(), schema.GroupVersionResource{
+		Group:    kongv1alpha1.GroupVersion.Group,
+		Version:  kongv1alpha1.GroupVersion.Version,
+		Resource: "gatewayclassparameterses",
+	}) {
+		blder.Watches(&kongv1alpha1.GatewayClassParameters{},
+			handler.EnqueueRequestsFromMapFunc(r.listGatewayClassesForParameters),
+		)
+	}
+
+	return blder.Complete(r)
+}
+
+// listGatewayClassesForParameters is a watch predicate which finds all the GatewayClasses
+// referencing the given GatewayClassParameters.
+func (r *GatewayClassReconciler) listGatewayClassesForParameters(ctx context.Context, obj client.Object) []reconcile.Request {
+	params, ok := obj.(*kongv1alpha1.GatewayClassParameters)
+	if !ok {
+		r.Log.Error(
+			fmt.Errorf("unexpected object type"),
+			"GatewayClassParameters watch predicate received unexpected object type",
+			"expected", "*kongv1alpha1.GatewayClassParameters", "found", reflect.TypeOf(obj),
+		)
+		return nil
+	}
+	gatewayClasses := &gatewayapi.GatewayClassList{}
+	if err := r.Client.List(ctx, gatewayClasses); err != nil {
+		r.Log.Error(err, "Failed to list gatewayclasses in watch", "gatewayclassparameters", client.ObjectKeyFromObject(params))
+		return nil
+	}
+	var recs []reconcile.Request
+	for _, gwc := range gatewayClasses.Items {
+		if gatewayClassReferencesParameters(&gwc, params) && isGatewayClassControlled(&gwc) {
+			recs = append(recs, reconcile.Request{
+				NamespacedName: k8stypes.NamespacedName{Name: gwc.Name},
+			})
+		}
+	}
+	return recs
 }
 
 // -----------------------------------------------------------------------------
@@ -115,8 +168,9 @@ func (r *GatewayClassReconciler) Reconcile(ctx context.Context, req ctrl.Request
 	gwc := new(gatewayapi.GatewayClass)
 	if err := r.Client.Get(ctx, req.NamespacedName, gwc); err != nil {
 		if apierrors.IsNotFound(err) {
-			log.V(logging.DebugLevel).Info("Object enqueued no longer exists, skipping", "name", req.Name)
-			return ctrl.Result{}, nil
+			log.V(logging.DebugLevel).Info("Object enqueued no longer exists, deleting it in dataplane", "name", req.Name)
+			gwc.Name = req.Name
+			return ctrl.Result{}, r.DataplaneClient.DeleteObject(gwc)
 		}
 		return ctrl.Result{}, err
 	}
@@ -124,24 +178,38 @@ func (r *GatewayClassReconciler) Reconcile(ctx context.Context, req ctrl.Request
 	log.V(logging.DebugLevel).Info("Processing gatewayclass", "name", req.Name)
 
 	if isGatewayClassControlled(gwc) {
-		alreadyAccepted := util.CheckCondition(
-			gwc.Status.Conditions,
-			util.ConditionType(gatewayapi.GatewayClassConditionStatusAccepted),
-			util.ConditionReason(gatewayapi.GatewayClassReasonAccepted),
-			metav1.ConditionTrue,
-			gwc.Generation,
-		)
+		acceptedCondition := metav1.Condition{
+			Type:               string(gatewayapi.GatewayClassConditionStatusAccepted),
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: gwc.Generation,
+			LastTransitionTime: metav1.Now(),
+			Reason:             string(gatewayapi.GatewayClassReasonAccepted),
+			Message:            "the gatewayclass has been accepted by the controller",
+		}
+		invalidParamsMsg, err := r.validateParametersRef(ctx, gwc)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if invalidParamsMsg != "" {
+			acceptedCondition.Status = metav1.ConditionFalse
+			acceptedCondition.Reason = string(gatewayapi.GatewayClassReasonInvalidParameters)
+			acceptedCondition.Message = invalidParamsMsg
+		}
+
+		conditionChanged := !gatewayClassHasCondition(gwc, acceptedCondition)
+		if conditionChanged {
+			setGatewayClassCondition(gwc, acceptedCondition)
+		}
 
-		if !alreadyAccepted {
-			acceptedCondtion := metav1.Condition{
-				Type:               string(gatewayapi.GatewayClassConditionStatusAccepted),
-				Status:             metav1.ConditionTrue,
-				ObservedGeneration: gwc.Generation,
-				LastTransitionTime: metav1.Now(),
-				Reason:             string(gatewayapi.GatewayClassReasonAccepted),
-				Message:            "the gatewayclass has been accepted by the controller",
-			}
-			setGatewayClassCondition(gwc, acceptedCondtion)
+		// the GatewayClass is stored in the dataplane cache so that the translator can
+		// apply the defaults from the GatewayClassParameters it references. It's stored with
+		// the Accepted condition set above, so defaults of invalid parameters are never applied.
+		if err := r.DataplaneClient.UpdateObject(gwc); err != nil {
+			log.V(logging.DebugLevel).Info("Failed to update object in data-plane, requeueing", "name", req.Name)
+			return ctrl.Result{}, err
+		}
+
+		if conditionChanged {
 			return ctrl.Result{}, r.Status().Update(ctx, pruneGatewayClassStatusConds(gwc))
 		}
 	}
@@ -149,6 +217,42 @@ func (r *GatewayClassReconciler) Reconcile(ctx context.Context, req ctrl.Request
 	return ctrl.Result{}, nil
 }
 
+// +kubebuilder:rbac:groups=configuration.konghq.com,resources=gatewayclassparameterses,verbs=get;list;watch
+
+// validateParametersRef verifies that the parametersRef of the GatewayClass (if any) points to an existing
+// GatewayClassParameters object compatible with the controller's configuration. It returns a message describing
+// the problem when the reference is invalid and an empty string otherwise.
+func (r *GatewayClassReconciler) validateParametersRef(ctx context.Context, gwc *gatewayapi.GatewayClass) (string, error) {
+	ref := gwc.Spec.ParametersRef
+	if ref == nil {
+		return "", nil
+	}
+
+	if string(ref.Group) != kongv1alpha1.GroupVersion.Group || string(ref.Kind) != kongv1alpha1.GatewayClassParametersKind {
+		return fmt.Sprintf("parametersRef must point to a %s object in the %s group, got %s in the %s group",
+			kongv1alpha1.GatewayClassParametersKind, kongv1alpha1.GroupVersion.Group, ref.Kind, ref.Group), nil
+	}
+	if ref.Namespace == nil {
+		return fmt.Sprintf("parametersRef must specify the namespace of the %s object", kongv1alpha1.GatewayClassParametersKind), nil
+	}
+
+	nn := k8stypes.NamespacedName{Namespace: string(*ref.Namespace), Name: ref.Name}
+	params := &kongv1alpha1.GatewayClassParameters{}
+	if err := r.Client.Get(ctx, nn, params); err != nil {
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return fmt.Sprintf("%s %s not found", kongv1alpha1.GatewayClassParametersKind, nn), nil
+		}
+		return "", err
+	}
+
+	if params.Spec.RouterFlavor != nil && r.RouterFlavor != "" && *params.Spec.RouterFlavor != string(r.RouterFlavor) {
+		return fmt.Sprintf("%s %s require the %q router flavor, but Kong uses the %q router flavor",
+			kongv1alpha1.GatewayClassParametersKind, nn, *params.Spec.RouterFlavor, r.RouterFlavor), nil
+	}
+
+	return "", nil
+}
+
 // SetLogger sets the logger.
 func (r *GatewayClassReconciler) SetLogger(l logr.Logger) {
 	r.Log = l
@@ -158,6 +262,32 @@ func (r *GatewayClassReconciler) SetLogger(l logr.Logger) {
 // GatewayClass Controller - Private
 // -----------------------------------------------------------------------------
 
+// gatewayClassReferencesParameters returns true if the parametersRef of the GatewayClass points to the given
+// GatewayClassParameters.
+func gatewayClassReferencesParameters(gwc *gatewayapi.GatewayClass, params *kongv1alpha1.GatewayClassParameters) bool {
+	ref := gwc.Spec.ParametersRef
+	return ref != nil &&
+		string(ref.Group) == kongv1alpha1.GroupVersion.Group &&
+		string(ref.Kind) == kongv1alpha1.GatewayClassParametersKind &&
+		ref.Namespace != nil && string(*ref.Namespace) == params.Namespace &&
+		ref.Name == params.Name
+}
+
+// gatewayClassHasCondition returns true if the gatewayclass status already contains the condition
+// with the same status, reason, message and observed generation.
+func gatewayClassHasCondition(gwc *gatewayapi.GatewayClass, condition metav1.Condition) bool {
+	for _, c := range gwc.Status.Conditions {
+		if c.Type == condition.Type &&
+			c.Status == condition.Status &&
+			c.Reason == condition.Reason &&
+			c.Message == condition.Message &&
+			c.ObservedGeneration == condition.ObservedGeneration {
+			return true
+		}
+	}
+	return false
+}
+
 // pruneGatewayClassStatusConds cleans out old status conditions if the
 // Gatewayclass currently has more status conditions set than the 8 maximum
 // allowed by the Kubernetes API.
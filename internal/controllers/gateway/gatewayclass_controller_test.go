@@ -1,12 +1,24 @@
 package gateway
 
 import (
+	"context"
 	"testing"
 
+	"github.com/go-logr/logr"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	dpconf "github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/config"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
+	"github.com/kong/kubernetes-ingress-controller/v3/test/mocks"
 )
 
 func TestSetGatewayClassCondtion(t *testing.T) {
@@ -112,3 +124,135 @@ func TestSetGatewayClassCondtion(t *testing.T) {
 		})
 	}
 }
+
+func TestGatewayClassReconciler_ParametersRef(t *testing.T) {
+	params := &kongv1alpha1.GatewayClassParameters{
+		ObjectMeta: metav1.ObjectMeta{Name: "params", Namespace: "kong"},
+	}
+	expressionsParams := &kongv1alpha1.GatewayClassParameters{
+		ObjectMeta: metav1.ObjectMeta{Name: "expressions-params", Namespace: "kong"},
+		Spec: kongv1alpha1.GatewayClassParametersSpec{
+			RouterFlavor: lo.ToPtr(string(dpconf.RouterFlavorExpressions)),
+		},
+	}
+	paramsRef := func(group, kind, namespace, name string) *gatewayapi.ParametersReference {
+		ref := &gatewayapi.ParametersReference{
+			Group: gatewayapi.Group(group),
+			Kind:  gatewayapi.Kind(kind),
+			Name:  name,
+		}
+		if namespace != "" {
+			ref.Namespace = lo.ToPtr(gatewayapi.Namespace(namespace))
+		}
+		return ref
+	}
+
+	testCases := []struct {
+		name            string
+		parametersRef   *gatewayapi.ParametersReference
+		expectedStatus  metav1.ConditionStatus
+		expectedReason  string
+		expectedMessage string
+	}{
+		{
+			name:           "no parametersRef",
+			expectedStatus: metav1.ConditionTrue,
+			expectedReason: string(gatewayapi.GatewayClassReasonAccepted),
+		},
+		{
+			name:           "valid parametersRef",
+			parametersRef:  paramsRef("configuration.konghq.com", "GatewayClassParameters", "kong", "params"),
+			expectedStatus: metav1.ConditionTrue,
+			expectedReason: string(gatewayapi.GatewayClassReasonAccepted),
+		},
+		{
+			name:            "parametersRef of unsupported kind",
+			parametersRef:   paramsRef("", "ConfigMap", "kong", "params"),
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  string(gatewayapi.GatewayClassReasonInvalidParameters),
+			expectedMessage: "parametersRef must point to a GatewayClassParameters object in the configuration.konghq.com group, got ConfigMap in the  group",
+		},
+		{
+			name:            "parametersRef without namespace",
+			parametersRef:   paramsRef("configuration.konghq.com", "GatewayClassParameters", "", "params"),
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  string(gatewayapi.GatewayClassReasonInvalidParameters),
+			expectedMessage: "parametersRef must specify the namespace of the GatewayClassParameters object",
+		},
+		{
+			name:            "parametersRef to missing parameters",
+			parametersRef:   paramsRef("configuration.konghq.com", "GatewayClassParameters", "kong", "missing"),
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  string(gatewayapi.GatewayClassReasonInvalidParameters),
+			expectedMessage: "GatewayClassParameters kong/missing not found",
+		},
+		{
+			name:            "parametersRef to parameters requiring another router flavor",
+			parametersRef:   paramsRef("configuration.konghq.com", "GatewayClassParameters", "kong", "expressions-params"),
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  string(gatewayapi.GatewayClassReasonInvalidParameters),
+			expectedMessage: `GatewayClassParameters kong/expressions-params require the "expressions" router flavor, but Kong uses the "traditional_compatible" router flavor`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			require.NoError(t, gatewayapi.InstallV1(scheme))
+			require.NoError(t, kongv1alpha1.AddToScheme(scheme))
+
+			gwc := &gatewayapi.GatewayClass{
+				ObjectMeta: metav1.ObjectMeta{Name: "kong", Generation: 1},
+				Spec: gatewayapi.GatewayClassSpec{
+					ControllerName: GetControllerName(),
+					ParametersRef:  tc.parametersRef,
+				},
+			}
+			cl := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(gwc, params, expressionsParams).
+				WithStatusSubresource(gwc).
+				Build()
+
+			dataplane := &gatewayClassRecordingDataplane{}
+			r := &GatewayClassReconciler{
+				Client:          cl,
+				Log:             logr.Discard(),
+				Scheme:          scheme,
+				DataplaneClient: dataplane,
+				RouterFlavor:    dpconf.RouterFlavorTraditionalCompatible,
+			}
+			_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: k8stypes.NamespacedName{Name: gwc.Name}})
+			require.NoError(t, err)
+
+			require.NoError(t, cl.Get(context.Background(), client.ObjectKeyFromObject(gwc), gwc))
+			require.Len(t, gwc.Status.Conditions, 1)
+			accepted := gwc.Status.Conditions[0]
+			assert.Equal(t, string(gatewayapi.GatewayClassConditionStatusAccepted), accepted.Type)
+			assert.Equal(t, tc.expectedStatus, accepted.Status)
+			assert.Equal(t, tc.expectedReason, accepted.Reason)
+			assert.Equal(t, int64(1), accepted.ObservedGeneration)
+			if tc.expectedMessage != "" {
+				assert.Equal(t, tc.expectedMessage, accepted.Message)
+			}
+
+			require.Len(t, dataplane.updated, 1)
+			assert.Equal(t, tc.expectedReason == string(gatewayapi.GatewayClassReasonInvalidParameters),
+				gatewayapi.GatewayClassHasInvalidParameters(dataplane.updated[0]),
+				"GatewayClass should be stored in the dataplane cache with its Accepted condition")
+		})
+	}
+}
+
+// gatewayClassRecordingDataplane records copies of the GatewayClasses stored in the dataplane cache.
+type gatewayClassRecordingDataplane struct {
+	mocks.Dataplane
+	updated []*gatewayapi.GatewayClass
+}
+
+func (d *gatewayClassRecordingDataplane) UpdateObject(obj client.Object) error {
+	if gwc, ok := obj.(*gatewayapi.GatewayClass); ok {
+		d.updated = append(d.updated, gwc.DeepCopy())
+	}
+	return nil
+}
@@ -394,12 +394,10 @@ func (r *GRPCRouteReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 			return ctrl.Result{Requeue: true}, nil
 		}
 
-		if configurationStatus == k8sobj.ConfigurationStatusFailed {
+		if isRouteConfigurationFailed(configurationStatus) {
 			debug(log, grpcroute, "GRPCRoute configuration failed")
-			statusUpdated, err := ensureParentsProgrammedCondition(ctx, r.Status(), grpcroute, grpcroute.Status.Parents, gateways, metav1.Condition{
-				Status: metav1.ConditionFalse,
-				Reason: string(ConditionReasonTranslationError),
-			})
+			statusUpdated, err := ensureParentsProgrammedCondition(ctx, r.Status(), grpcroute, grpcroute.Status.Parents, gateways,
+				failedRouteProgrammedCondition(configurationStatus))
 			if err != nil {
 				// don't proceed until the statuses can be updated appropriately
 				debug(log, grpcroute, "Failed to update programmed condition")
@@ -408,10 +406,8 @@ func (r *GRPCRouteReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 			return ctrl.Result{Requeue: !statusUpdated}, nil
 		}
 
-		statusUpdated, err := ensureParentsProgrammedCondition(ctx, r.Status(), grpcroute, grpcroute.Status.Parents, gateways, metav1.Condition{
-			Status: metav1.ConditionTrue,
-			Reason: string(ConditionReasonConfiguredInGateway),
-		})
+		statusUpdated, err := ensureParentsProgrammedCondition(ctx, r.Status(), grpcroute, grpcroute.Status.Parents, gateways,
+			configuredRouteProgrammedCondition(configurationStatus))
 		if err != nil {
 			// don't proceed until the statuses can be updated appropriately
 			debug(log, grpcroute, "Failed to update programmed condition")
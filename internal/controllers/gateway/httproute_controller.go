@@ -13,6 +13,7 @@ import (
 	"github.com/samber/lo"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -35,6 +36,8 @@ import (
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
 	k8sobj "github.com/kong/kubernetes-ingress-controller/v3/internal/util/kubernetes/object"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/kubernetes/object/status"
+	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
+	incubatorv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/incubator/v1alpha1"
 )
 
 // -----------------------------------------------------------------------------
@@ -484,12 +487,10 @@ func (r *HTTPRouteReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 			return ctrl.Result{Requeue: true}, nil
 		}
 
-		if configurationStatus == k8sobj.ConfigurationStatusFailed {
+		if isRouteConfigurationFailed(configurationStatus) {
 			debug(log, httproute, "HTTPRoute configuration failed")
-			statusUpdated, err := ensureParentsProgrammedCondition(ctx, r.Status(), httproute, httproute.Status.Parents, gateways, metav1.Condition{
-				Status: metav1.ConditionFalse,
-				Reason: string(ConditionReasonTranslationError),
-			})
+			statusUpdated, err := ensureParentsProgrammedCondition(ctx, r.Status(), httproute, httproute.Status.Parents, gateways,
+				failedRouteProgrammedCondition(configurationStatus))
 			if err != nil {
 				// don't proceed until the statuses can be updated appropriately
 				debug(log, httproute, "Failed to update programmed condition")
@@ -498,10 +499,8 @@ func (r *HTTPRouteReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 			return ctrl.Result{Requeue: !statusUpdated}, nil
 		}
 
-		statusUpdated, err := ensureParentsProgrammedCondition(ctx, r.Status(), httproute, httproute.Status.Parents, gateways, metav1.Condition{
-			Status: metav1.ConditionTrue,
-			Reason: string(ConditionReasonConfiguredInGateway),
-		})
+		statusUpdated, err := ensureParentsProgrammedCondition(ctx, r.Status(), httproute, httproute.Status.Parents, gateways,
+			configuredRouteProgrammedCondition(configurationStatus))
 		if err != nil {
 			// don't proceed until the statuses can be updated appropriately
 			debug(log, httproute, "Failed to update programmed condition")
@@ -679,16 +678,25 @@ func (r *HTTPRouteReconciler) getHTTPRouteRuleReason(ctx context.Context, httpRo
 			}
 
 			// Check if the BackendRef GroupKind is supported
-			if !util.IsBackendRefGroupKindSupported(backendRef.Group, backendRef.Kind) {
+			if !util.IsBackendRefGroupKindSupported(gatewayapi.V1HTTPRouteTypeMeta.Kind, backendRef.Group, backendRef.Kind) {
 				return gatewayapi.RouteReasonInvalidKind, nil
 			}
 
 			// Check if all the objects referenced actually exist
-			// Only services are currently supported as BackendRef objects
-			service := &corev1.Service{}
-			err := r.Client.Get(ctx, k8stypes.NamespacedName{Namespace: backendNamespace, Name: string(backendRef.Name)}, service)
+			// Services, KongServiceFacades and KongExternalBackends are currently supported as BackendRef objects
+			var backend client.Object = &corev1.Service{}
+			if backendRef.Kind != nil {
+				switch *backendRef.Kind {
+				case incubatorv1alpha1.KongServiceFacadeKind:
+					backend = &incubatorv1alpha1.KongServiceFacade{}
+				case kongv1alpha1.KongExternalBackendKind:
+					backend = &kongv1alpha1.KongExternalBackend{}
+				}
+			}
+			err := r.Client.Get(ctx, k8stypes.NamespacedName{Namespace: backendNamespace, Name: string(backendRef.Name)}, backend)
 			if err != nil {
-				if !apierrors.IsNotFound(err) {
+				// KongServiceFacade or KongExternalBackend CRDs may not be installed in the cluster, so we treat it as a missing backend.
+				if !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
 					return "", err
 				}
 				return gatewayapi.RouteReasonBackendNotFound, nil
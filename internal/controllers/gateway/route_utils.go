@@ -21,6 +21,7 @@ import (
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/logging"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
+	k8sobj "github.com/kong/kubernetes-ingress-controller/v3/internal/util/kubernetes/object"
 )
 
 // -----------------------------------------------------------------------------
@@ -32,6 +33,15 @@ const (
 	ConditionReasonProgrammedUnknown   gatewayapi.RouteConditionReason = "Unknown"
 	ConditionReasonConfiguredInGateway gatewayapi.RouteConditionReason = "ConfiguredInGateway"
 	ConditionReasonTranslationError    gatewayapi.RouteConditionReason = "TranslationError"
+	ConditionReasonRouteConflict       gatewayapi.RouteConditionReason = "RouteConflict"
+	ConditionReasonHostnameNotAllowed  gatewayapi.RouteConditionReason = "HostnameNotAllowed"
+
+	// ConditionTypeTLSTerminated tells whether Kong terminates TLS of the connections matched by a TLSRoute.
+	ConditionTypeTLSTerminated                                                  = "TLSTerminated"
+	ConditionReasonListenerTLSModeTerminate     gatewayapi.RouteConditionReason = "ListenerTLSModeTerminate"
+	ConditionReasonListenerTLSModePassthrough   gatewayapi.RouteConditionReason = "ListenerTLSModePassthrough"
+	ConditionReasonListenerCertificateMissing   gatewayapi.RouteConditionReason = "ListenerCertificateMissing"
+	ConditionReasonListenerTLSModesInconsistent gatewayapi.RouteConditionReason = "ListenerTLSModesInconsistent"
 )
 
 var (
@@ -410,10 +420,10 @@ func routeTypeMatchesListenerType[T gatewayapi.RouteT](route T, listener gateway
 		if listener.Protocol != gatewayapi.TLSProtocolType {
 			return false
 		}
-		// TLSRoutes currently support Passthrough only
-		// Note: this is a guess we are doing as the upstream documentation is unclear at the moment.
-		// see https://github.com/kubernetes-sigs/gateway-api/issues/1474
-		if listener.TLS != nil && *listener.TLS.Mode != gatewayapi.TLSModePassthrough {
+		// TLSRoutes support both Passthrough and Terminate. In Terminate mode Kong
+		// terminates TLS with the listener certificate and proxies plaintext TCP.
+		if listener.TLS != nil && listener.TLS.Mode != nil &&
+			*listener.TLS.Mode != gatewayapi.TLSModePassthrough && *listener.TLS.Mode != gatewayapi.TLSModeTerminate {
 			return false
 		}
 	case *gatewayapi.GRPCRoute:
@@ -753,6 +763,46 @@ func setRouteParentStatusCondition(parentStatus *gatewayapi.RouteParentStatus, n
 	return changed
 }
 
+// configuredRouteProgrammedCondition returns the Programmed condition of a route successfully configured in the
+// gateway. A route whose Kong routes have the same or shadowing matches as routes of other objects is programmed, but
+// the condition's reason tells it may not receive all the traffic it matches.
+func configuredRouteProgrammedCondition(configurationStatus k8sobj.ConfigurationStatus) metav1.Condition {
+	if configurationStatus == k8sobj.ConfigurationStatusConflicted {
+		return metav1.Condition{
+			Status:  metav1.ConditionTrue,
+			Reason:  string(ConditionReasonRouteConflict),
+			Message: "Some of the route's matches are the same as or shadow matches of routes of other objects, see the KongRouteConflict events for details",
+		}
+	}
+	return metav1.Condition{
+		Status: metav1.ConditionTrue,
+		Reason: string(ConditionReasonConfiguredInGateway),
+	}
+}
+
+// isRouteConfigurationFailed tells whether the configuration status is one of a route that failed to be
+// configured in the gateway.
+func isRouteConfigurationFailed(configurationStatus k8sobj.ConfigurationStatus) bool {
+	return configurationStatus == k8sobj.ConfigurationStatusFailed ||
+		configurationStatus == k8sobj.ConfigurationStatusHostnameNotAllowed
+}
+
+// failedRouteProgrammedCondition returns the Programmed condition of a route that failed to be configured in the
+// gateway. The condition's reason tells whether it's because of hostnames rejected by KongHostnamePolicies.
+func failedRouteProgrammedCondition(configurationStatus k8sobj.ConfigurationStatus) metav1.Condition {
+	if configurationStatus == k8sobj.ConfigurationStatusHostnameNotAllowed {
+		return metav1.Condition{
+			Status:  metav1.ConditionFalse,
+			Reason:  string(ConditionReasonHostnameNotAllowed),
+			Message: "Some of the route's hostnames are not allowed in its namespace by KongHostnamePolicies, see the KongConfigurationTranslationFailed events for details",
+		}
+	}
+	return metav1.Condition{
+		Status: metav1.ConditionFalse,
+		Reason: string(ConditionReasonTranslationError),
+	}
+}
+
 func parentStatusHasProgrammedCondition(parentStatus *gatewayapi.RouteParentStatus) bool {
 	for _, condition := range parentStatus.Conditions {
 		if condition.Type == ConditionTypeProgrammed {
@@ -1182,7 +1182,7 @@ func TestGetSupportedGatewayForRoute(t *testing.T) {
 				},
 			},
 			{
-				name:  "basic TLSRoute does not get accepted because there is no listener with TLS in passthrough mode",
+				name:  "basic TLSRoute does get accepted because there is a listener with TLS in terminate mode",
 				route: basicTLSRoute(),
 				objects: []client.Object{
 					func() *gatewayapi.Gateway {
@@ -1198,6 +1198,29 @@ func TestGetSupportedGatewayForRoute(t *testing.T) {
 					gatewayClass,
 					namespace,
 				},
+				expected: []expected{
+					{
+						condition: routeConditionAccepted(metav1.ConditionTrue, gatewayapi.RouteReasonAccepted),
+					},
+				},
+			},
+			{
+				name:  "basic TLSRoute does not get accepted because there is no listener with TLS protocol",
+				route: basicTLSRoute(),
+				objects: []client.Object{
+					func() *gatewayapi.Gateway {
+						gw := gatewayWithTLS443PassthroughReady()
+						gw.Spec.Listeners = builder.NewListener("tls").
+							WithPort(443).
+							HTTPS().
+							WithTLSConfig(&gatewayapi.GatewayTLSConfig{
+								Mode: lo.ToPtr(gatewayapi.TLSModeTerminate),
+							}).IntoSlice()
+						return gw
+					}(),
+					gatewayClass,
+					namespace,
+				},
 				expected: []expected{
 					{
 						condition: routeConditionAccepted(metav1.ConditionFalse, gatewayapi.RouteReasonNoMatchingParent),
@@ -385,12 +385,10 @@ func (r *TLSRouteReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 			return ctrl.Result{Requeue: true}, nil
 		}
 
-		if configurationStatus == k8sobj.ConfigurationStatusFailed {
+		if isRouteConfigurationFailed(configurationStatus) {
 			debug(log, tlsroute, "TLSRoute configuration failed")
-			statusUpdated, err := ensureParentsProgrammedCondition(ctx, r.Status(), tlsroute, tlsroute.Status.Parents, gateways, metav1.Condition{
-				Status: metav1.ConditionFalse,
-				Reason: string(ConditionReasonTranslationError),
-			})
+			statusUpdated, err := ensureParentsProgrammedCondition(ctx, r.Status(), tlsroute, tlsroute.Status.Parents, gateways,
+				failedRouteProgrammedCondition(configurationStatus))
 			if err != nil {
 				// don't proceed until the statuses can be updated appropriately
 				debug(log, tlsroute, "Failed to update programmed condition")
@@ -429,6 +427,51 @@ func (r *TLSRouteReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 // implementation supports for route object parent references.
 var tlsrouteParentKind = "Gateway"
 
+// tlsTerminatedCondition returns the TLSTerminated condition of a TLSRoute attached to the given Gateway
+// listener (or to all the Gateway's TLS listeners when listenerName is empty). Kong terminates TLS
+// only for listeners in Terminate mode that have a certificate, otherwise the TLS stream is passed through
+// or the TLSRoute can't be configured. Like the translator, it treats an unset TLS mode as Terminate.
+func tlsTerminatedCondition(gateway *gatewayapi.Gateway, listenerName string, generation int64) metav1.Condition {
+	condition := metav1.Condition{
+		Type:               ConditionTypeTLSTerminated,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: generation,
+		LastTransitionTime: metav1.Now(),
+		Reason:             string(ConditionReasonListenerTLSModeTerminate),
+		Message:            "Kong terminates TLS with the listener certificate and forwards plaintext TCP to the backends",
+	}
+
+	var modes []gatewayapi.TLSModeType
+	for _, listener := range gateway.Spec.Listeners {
+		if listenerName != "" && string(listener.Name) != listenerName {
+			continue
+		}
+		if listener.Protocol != gatewayapi.TLSProtocolType || listener.TLS == nil {
+			continue
+		}
+		mode := lo.FromPtrOr(listener.TLS.Mode, gatewayapi.TLSModeTerminate)
+		if mode == gatewayapi.TLSModeTerminate && len(listener.TLS.CertificateRefs) == 0 {
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = string(ConditionReasonListenerCertificateMissing)
+			condition.Message = fmt.Sprintf("Listener %s is in Terminate mode but has no certificateRefs", listener.Name)
+			return condition
+		}
+		modes = append(modes, mode)
+	}
+
+	switch modes = lo.Uniq(modes); {
+	case len(modes) > 1:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = string(ConditionReasonListenerTLSModesInconsistent)
+		condition.Message = "TLSRoute is attached to listeners with different TLS modes"
+	case len(modes) == 1 && modes[0] == gatewayapi.TLSModePassthrough:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = string(ConditionReasonListenerTLSModePassthrough)
+		condition.Message = "Kong passes the TLS stream through to the backends"
+	}
+	return condition
+}
+
 // ensureGatewayReferenceStatus takes any number of Gateways that should be
 // considered "attached" to a given TLSRoute and ensures that the status
 // for the TLSRoute is updated appropriately.
@@ -449,13 +492,16 @@ func (r *TLSRouteReconciler) ensureGatewayReferenceStatusAdded(ctx context.Conte
 				Name:      gatewayapi.ObjectName(gateway.gateway.Name),
 			},
 			ControllerName: GetControllerName(),
-			Conditions: []metav1.Condition{{
-				Type:               string(gatewayapi.RouteConditionAccepted),
-				Status:             metav1.ConditionTrue,
-				ObservedGeneration: tlsroute.Generation,
-				LastTransitionTime: metav1.Now(),
-				Reason:             string(gatewayapi.RouteReasonAccepted),
-			}},
+			Conditions: []metav1.Condition{
+				{
+					Type:               string(gatewayapi.RouteConditionAccepted),
+					Status:             metav1.ConditionTrue,
+					ObservedGeneration: tlsroute.Generation,
+					LastTransitionTime: metav1.Now(),
+					Reason:             string(gatewayapi.RouteReasonAccepted),
+				},
+				tlsTerminatedCondition(gateway.gateway, gateway.listenerName, tlsroute.Generation),
+			},
 		}
 
 		if gateway.listenerName != "" {
@@ -470,8 +516,10 @@ func (r *TLSRouteReconciler) ensureGatewayReferenceStatusAdded(ctx context.Conte
 			//  check if the parentRef and controllerName are equal, and whether the new condition is present in existing conditions
 			if reflect.DeepEqual(existingGatewayParentStatus.ParentRef, gatewayParentStatus.ParentRef) &&
 				existingGatewayParentStatus.ControllerName == gatewayParentStatus.ControllerName &&
-				lo.ContainsBy(existingGatewayParentStatus.Conditions, func(condition metav1.Condition) bool {
-					return sameCondition(gatewayParentStatus.Conditions[0], condition)
+				lo.EveryBy(gatewayParentStatus.Conditions, func(newCondition metav1.Condition) bool {
+					return lo.ContainsBy(existingGatewayParentStatus.Conditions, func(condition metav1.Condition) bool {
+						return sameCondition(newCondition, condition)
+					})
 				}) {
 				continue
 			}
@@ -0,0 +1,92 @@
+package gateway
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/builder"
+)
+
+func TestTLSTerminatedCondition(t *testing.T) {
+	tlsListener := func(name string, mode gatewayapi.TLSModeType, certificates ...string) gatewayapi.Listener {
+		tls := &gatewayapi.GatewayTLSConfig{}
+		if mode != "" {
+			tls.Mode = lo.ToPtr(mode)
+		}
+		for _, certificate := range certificates {
+			tls.CertificateRefs = append(tls.CertificateRefs, gatewayapi.SecretObjectReference{
+				Name: gatewayapi.ObjectName(certificate),
+			})
+		}
+		return builder.NewListener(name).WithPort(443).TLS().WithTLSConfig(tls).Build()
+	}
+
+	testCases := []struct {
+		name           string
+		listeners      []gatewayapi.Listener
+		listenerName   string
+		expectedStatus metav1.ConditionStatus
+		expectedReason gatewayapi.RouteConditionReason
+	}{
+		{
+			name:           "terminate listener with certificate",
+			listeners:      []gatewayapi.Listener{tlsListener("tls", gatewayapi.TLSModeTerminate, "cert")},
+			expectedStatus: metav1.ConditionTrue,
+			expectedReason: ConditionReasonListenerTLSModeTerminate,
+		},
+		{
+			name:           "listener without mode is in terminate mode",
+			listeners:      []gatewayapi.Listener{tlsListener("tls", "", "cert")},
+			expectedStatus: metav1.ConditionTrue,
+			expectedReason: ConditionReasonListenerTLSModeTerminate,
+		},
+		{
+			name:           "passthrough listener",
+			listeners:      []gatewayapi.Listener{tlsListener("tls", gatewayapi.TLSModePassthrough)},
+			expectedStatus: metav1.ConditionFalse,
+			expectedReason: ConditionReasonListenerTLSModePassthrough,
+		},
+		{
+			name:           "terminate listener without certificate",
+			listeners:      []gatewayapi.Listener{tlsListener("tls", gatewayapi.TLSModeTerminate)},
+			expectedStatus: metav1.ConditionFalse,
+			expectedReason: ConditionReasonListenerCertificateMissing,
+		},
+		{
+			name: "listeners with different modes",
+			listeners: []gatewayapi.Listener{
+				tlsListener("terminate", gatewayapi.TLSModeTerminate, "cert"),
+				tlsListener("passthrough", gatewayapi.TLSModePassthrough),
+			},
+			expectedStatus: metav1.ConditionFalse,
+			expectedReason: ConditionReasonListenerTLSModesInconsistent,
+		},
+		{
+			name: "only the listener referenced by section name is considered",
+			listeners: []gatewayapi.Listener{
+				tlsListener("terminate", gatewayapi.TLSModeTerminate, "cert"),
+				tlsListener("passthrough", gatewayapi.TLSModePassthrough),
+			},
+			listenerName:   "terminate",
+			expectedStatus: metav1.ConditionTrue,
+			expectedReason: ConditionReasonListenerTLSModeTerminate,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gateway := &gatewayapi.Gateway{
+				Spec: gatewayapi.GatewaySpec{Listeners: tc.listeners},
+			}
+			condition := tlsTerminatedCondition(gateway, tc.listenerName, 1)
+			require.Equal(t, ConditionTypeTLSTerminated, condition.Type)
+			require.Equal(t, tc.expectedStatus, condition.Status)
+			require.Equal(t, string(tc.expectedReason), condition.Reason)
+			require.Equal(t, int64(1), condition.ObservedGeneration)
+		})
+	}
+}
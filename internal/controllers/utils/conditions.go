@@ -52,11 +52,11 @@ func EnsureProgrammedCondition(
 		message string
 	)
 	switch configurationStatus {
-	case object.ConfigurationStatusSucceeded:
+	case object.ConfigurationStatusSucceeded, object.ConfigurationStatusConflicted:
 		status = metav1.ConditionTrue
 		reason = kongv1.ReasonProgrammed
 		message = ProgrammedConditionTrueMessage
-	case object.ConfigurationStatusFailed:
+	case object.ConfigurationStatusFailed, object.ConfigurationStatusHostnameNotAllowed:
 		status = metav1.ConditionFalse
 		reason = kongv1.ReasonInvalid
 		message = ProgrammedConditionFalseInvalidMessage
@@ -32,3 +32,11 @@ type Client interface {
 	// it to the backend API.
 	Update(ctx context.Context) error
 }
+
+// ConfigChangesNotifier is an optional interface of Clients which can notify about changes
+// of the configuration that haven't been applied to the data-plane yet.
+type ConfigChangesNotifier interface {
+	// ConfigChanges returns a channel receiving a notification whenever the configuration changes.
+	// Notifications may be coalesced, so a single notification can stand for multiple changes.
+	ConfigChanges() <-chan struct{}
+}
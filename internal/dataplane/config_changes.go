@@ -0,0 +1,66 @@
+package dataplane
+
+import (
+	"sync"
+	"time"
+)
+
+// configChangesTracker tracks changes of the Kubernetes objects cache that haven't been pushed to the data-plane yet.
+// It notifies its subscriber about every change and remembers when the oldest change not pushed yet happened,
+// so that the time it takes for a change to reach the data-plane can be measured.
+type configChangesTracker struct {
+	lock sync.Mutex
+
+	// pendingSince is the time of the oldest change that hasn't been pushed yet. It's zero if there's none.
+	pendingSince time.Time
+
+	// changes is notified about every change. It's buffered with the size of 1 so that notifications are
+	// coalesced when the subscriber is busy.
+	changes chan struct{}
+}
+
+func newConfigChangesTracker() *configChangesTracker {
+	return &configChangesTracker{
+		changes: make(chan struct{}, 1),
+	}
+}
+
+// notify records a change and notifies the subscriber about it without blocking.
+func (t *configChangesTracker) notify() {
+	t.lock.Lock()
+	if t.pendingSince.IsZero() {
+		t.pendingSince = time.Now()
+	}
+	t.lock.Unlock()
+
+	t.requestUpdate()
+}
+
+// takePending returns the time of the oldest pending change and clears it. The returned bool is false
+// when there are no pending changes.
+func (t *configChangesTracker) takePending() (time.Time, bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	since := t.pendingSince
+	t.pendingSince = time.Time{}
+	return since, !since.IsZero()
+}
+
+// restorePending brings back changes taken with takePending that failed to be pushed. If there were changes
+// recorded in the meantime, the older time is kept.
+func (t *configChangesTracker) restorePending(since time.Time) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if t.pendingSince.IsZero() || since.Before(t.pendingSince) {
+		t.pendingSince = since
+	}
+}
+
+// requestUpdate notifies the subscriber that an update is needed without recording a change, e.g. to record
+// the result of a staged rollout completed in the background.
+func (t *configChangesTracker) requestUpdate() {
+	select {
+	case t.changes <- struct{}{}:
+	default:
+	}
+}
@@ -28,42 +28,35 @@ func NewGenerator(cacheGraphProvider CacheGraphProvider, logger logr.Logger) *Ge
 }
 
 // GenerateExcludingBrokenObjects generates a new cache snapshot that excludes all objects that depend on the broken objects.
+// Affected objects annotated with the backfill fallback policy are backfilled from the last valid cache snapshot
+// instead (if it's available).
 func (g *Generator) GenerateExcludingBrokenObjects(
 	cache store.CacheStores,
+	lastValidCacheSnapshot *store.CacheStores,
 	brokenObjects []ObjectHash,
 ) (store.CacheStores, GeneratedCacheMetadata, error) {
-	metadataCollector := NewGenerateCacheMetadataCollector(brokenObjects...)
-
-	graph, err := g.cacheGraphProvider.CacheToGraph(cache)
-	if err != nil {
-		return store.CacheStores{}, GeneratedCacheMetadata{}, fmt.Errorf("failed to build cache graph: %w", err)
-	}
-
-	fallbackCache, err := cache.TakeSnapshot()
-	if err != nil {
-		return store.CacheStores{}, GeneratedCacheMetadata{}, fmt.Errorf("failed to take cache snapshot: %w", err)
-	}
-
-	for _, brokenObject := range brokenObjects {
-		subgraphObjects, err := graph.SubgraphObjects(brokenObject)
-		if err != nil {
-			return store.CacheStores{}, GeneratedCacheMetadata{}, fmt.Errorf("failed to find dependants for %s: %w", brokenObject, err)
-		}
-		for _, obj := range subgraphObjects {
-			if err := fallbackCache.Delete(obj); err != nil {
-				return store.CacheStores{}, GeneratedCacheMetadata{}, fmt.Errorf("failed to delete %s from the cache: %w", GetObjectHash(obj), err)
-			}
-			metadataCollector.CollectExcluded(obj, brokenObject)
-		}
-	}
-
-	return fallbackCache, metadataCollector.Metadata(), nil
+	return g.generate(cache, lastValidCacheSnapshot, brokenObjects, PolicyExclude)
 }
 
+// GenerateBackfillingBrokenObjects generates a new cache snapshot that excludes all objects that depend on the broken
+// objects and backfills them from the last valid cache snapshot. Affected objects annotated with the exclude fallback
+// policy are not backfilled.
 func (g *Generator) GenerateBackfillingBrokenObjects(
 	currentCache store.CacheStores,
 	lastValidCacheSnapshot *store.CacheStores,
 	brokenObjects []ObjectHash,
+) (store.CacheStores, GeneratedCacheMetadata, error) {
+	return g.generate(currentCache, lastValidCacheSnapshot, brokenObjects, PolicyBackfill)
+}
+
+// generate generates a new cache snapshot handling the objects affected by the broken objects according to their
+// fallback policies. Objects with no fallback policy annotation are handled according to defaultPolicy.
+// If any of the affected objects has PolicyFail, PolicyFailError is returned along with the metadata listing them.
+func (g *Generator) generate(
+	currentCache store.CacheStores,
+	lastValidCacheSnapshot *store.CacheStores,
+	brokenObjects []ObjectHash,
+	defaultPolicy Policy,
 ) (store.CacheStores, GeneratedCacheMetadata, error) {
 	metadataCollector := NewGenerateCacheMetadataCollector(brokenObjects...)
 
@@ -79,23 +72,46 @@ func (g *Generator) GenerateBackfillingBrokenObjects(
 		return store.CacheStores{}, GeneratedCacheMetadata{}, fmt.Errorf("failed to take current cache snapshot: %w", err)
 	}
 
-	// Exclude the affected objects from the fallback cache. Also, collect all the affected objects as they will be
-	// subjects of backfilling.
-	var affectedObjects []ObjectHash
+	// Exclude the affected objects from the fallback cache. Also, collect the affected objects that have the backfill
+	// policy as they will be subjects of backfilling.
+	var (
+		objectsToBackfillFrom []ObjectHash
+		policyFailErr         *PolicyFailError
+	)
+	affectedObjectsPolicies := make(map[ObjectHash]Policy)
 	for _, brokenObject := range brokenObjects {
 		subgraphObjects, err := currentGraph.SubgraphObjects(brokenObject)
 		if err != nil {
 			return store.CacheStores{}, GeneratedCacheMetadata{}, fmt.Errorf("failed to find dependants for %s: %w", brokenObject, err)
 		}
 		for _, obj := range subgraphObjects {
+			objHash := GetObjectHash(obj)
+			policy := g.policyFor(obj, defaultPolicy)
+			if policy == PolicyFail {
+				// Keep collecting, so all the objects preventing the fallback configuration are reported.
+				metadataCollector.CollectFailed(obj, brokenObject)
+				if policyFailErr == nil {
+					policyFailErr = &PolicyFailError{Object: objHash, CausingObject: brokenObject}
+				}
+				continue
+			}
 			if err := fallbackCache.Delete(obj); err != nil {
-				return store.CacheStores{}, GeneratedCacheMetadata{}, fmt.Errorf("failed to delete %s from the fallback cache: %w", GetObjectHash(obj), err)
+				return store.CacheStores{}, GeneratedCacheMetadata{}, fmt.Errorf("failed to delete %s from the fallback cache: %w", objHash, err)
 			}
-			affectedObjects = append(affectedObjects, GetObjectHash(obj))
-			metadataCollector.CollectExcluded(obj, brokenObject)
+			if _, seen := affectedObjectsPolicies[objHash]; !seen && policy == PolicyBackfill {
+				objectsToBackfillFrom = append(objectsToBackfillFrom, objHash)
+			}
+			affectedObjectsPolicies[objHash] = policy
+			metadataCollector.CollectExcluded(obj, brokenObject, policy)
 		}
 	}
 
+	if policyFailErr != nil {
+		return store.CacheStores{}, metadataCollector.Metadata(), *policyFailErr
+	}
+	if len(objectsToBackfillFrom) == 0 {
+		return fallbackCache, metadataCollector.Metadata(), nil
+	}
 	if lastValidCacheSnapshot == nil {
 		g.logger.V(logging.DebugLevel).Info("No previous valid cache snapshot found, skipping backfilling")
 		return fallbackCache, metadataCollector.Metadata(), nil
@@ -108,17 +124,26 @@ func (g *Generator) GenerateBackfillingBrokenObjects(
 	}
 
 	// Backfill the affected objects from the last valid cache snapshot.
-	for _, affectedObject := range affectedObjects {
+	for _, affectedObject := range objectsToBackfillFrom {
 		objectsToBackfill, err := lastValidGraph.SubgraphObjects(affectedObject)
 		if err != nil {
 			return store.CacheStores{}, GeneratedCacheMetadata{}, fmt.Errorf("failed to find dependants for %s: %w", affectedObject, err)
 		}
 
 		for _, obj := range objectsToBackfill {
+			// Objects affected in the current cache are handled according to their current policy. The rest of them
+			// (e.g. objects that were deleted since) are handled according to their last valid version's policy.
+			policy, ok := affectedObjectsPolicies[GetObjectHash(obj)]
+			if !ok {
+				policy = g.policyFor(obj, defaultPolicy)
+			}
+			if policy != PolicyBackfill {
+				continue
+			}
 			if err := fallbackCache.Add(obj); err != nil {
 				return store.CacheStores{}, GeneratedCacheMetadata{}, fmt.Errorf("failed to add %s to the cache: %w", GetObjectHash(obj), err)
 			}
-			metadataCollector.CollectBackfilled(obj, affectedObject)
+			metadataCollector.CollectBackfilled(obj, affectedObject, policy)
 		}
 	}
 	return fallbackCache, metadataCollector.Metadata(), nil
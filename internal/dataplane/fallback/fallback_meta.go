@@ -15,6 +15,9 @@ type GeneratedCacheMetadata struct {
 	// BackfilledObjects are objects that were backfilled from the last valid cache state as they were broken or either of
 	// their dependencies was broken.
 	BackfilledObjects []AffectedCacheObjectMetadata
+	// FailedObjects are objects with the fail fallback policy that were broken or either of their dependencies was
+	// broken. When there are any, the fallback configuration is not generated.
+	FailedObjects []AffectedCacheObjectMetadata
 }
 
 // GeneratedCacheMetadataCollector is a collector for cache metadata generated during the fallback process.
@@ -23,6 +26,7 @@ type GeneratedCacheMetadataCollector struct {
 	brokenObjects     []ObjectHash
 	excludedObjects   map[ObjectHash]AffectedCacheObjectMetadata
 	backfilledObjects map[ObjectHash]AffectedCacheObjectMetadata
+	failedObjects     map[ObjectHash]AffectedCacheObjectMetadata
 }
 
 // AffectedCacheObjectMetadata contains an object and a list of objects that caused it to be excluded or backfilled
@@ -30,6 +34,8 @@ type GeneratedCacheMetadataCollector struct {
 type AffectedCacheObjectMetadata struct {
 	Object         client.Object
 	CausingObjects []ObjectHash
+	// Policy is the fallback policy the object was handled with.
+	Policy Policy
 }
 
 // NewGenerateCacheMetadataCollector creates a new GeneratedCacheMetadataCollector instance.
@@ -38,30 +44,36 @@ func NewGenerateCacheMetadataCollector(brokenObjects ...ObjectHash) *GeneratedCa
 		brokenObjects:     brokenObjects,
 		excludedObjects:   make(map[ObjectHash]AffectedCacheObjectMetadata),
 		backfilledObjects: make(map[ObjectHash]AffectedCacheObjectMetadata),
+		failedObjects:     make(map[ObjectHash]AffectedCacheObjectMetadata),
 	}
 }
 
 // CollectExcluded collects an excluded object (an object that was excluded from the fallback configuration as it was
-// broken or one of its dependencies was broken).
-func (m *GeneratedCacheMetadataCollector) CollectExcluded(excluded client.Object, causing ObjectHash) {
-	objHash := GetObjectHash(excluded)
-	if existingEntry, ok := m.excludedObjects[objHash]; ok {
-		existingEntry.CausingObjects = append(existingEntry.CausingObjects, causing)
-		m.excludedObjects[objHash] = existingEntry
-	} else {
-		m.excludedObjects[objHash] = AffectedCacheObjectMetadata{Object: excluded, CausingObjects: []ObjectHash{causing}}
-	}
+// broken or one of its dependencies was broken) along with the fallback policy it was handled with.
+func (m *GeneratedCacheMetadataCollector) CollectExcluded(excluded client.Object, causing ObjectHash, policy Policy) {
+	collectAffected(m.excludedObjects, excluded, causing, policy)
 }
 
 // CollectBackfilled collects a backfilled object (an object that was backfilled from the last valid cache state as that or
-// one of its dependencies was broken).
-func (m *GeneratedCacheMetadataCollector) CollectBackfilled(backfilled client.Object, causing ObjectHash) {
-	objHash := GetObjectHash(backfilled)
-	if existingEntry, ok := m.backfilledObjects[objHash]; ok {
+// one of its dependencies was broken) along with the fallback policy it was handled with.
+func (m *GeneratedCacheMetadataCollector) CollectBackfilled(backfilled client.Object, causing ObjectHash, policy Policy) {
+	collectAffected(m.backfilledObjects, backfilled, causing, policy)
+}
+
+// CollectFailed collects an object with the fail fallback policy that prevented generating the fallback configuration
+// as it was broken or one of its dependencies was broken.
+func (m *GeneratedCacheMetadataCollector) CollectFailed(failed client.Object, causing ObjectHash) {
+	collectAffected(m.failedObjects, failed, causing, PolicyFail)
+}
+
+// collectAffected adds the causing object to the entry of the affected object, creating the entry if it doesn't exist.
+func collectAffected(entries map[ObjectHash]AffectedCacheObjectMetadata, affected client.Object, causing ObjectHash, policy Policy) {
+	objHash := GetObjectHash(affected)
+	if existingEntry, ok := entries[objHash]; ok {
 		existingEntry.CausingObjects = append(existingEntry.CausingObjects, causing)
-		m.backfilledObjects[objHash] = existingEntry
+		entries[objHash] = existingEntry
 	} else {
-		m.backfilledObjects[objHash] = AffectedCacheObjectMetadata{Object: backfilled, CausingObjects: []ObjectHash{causing}}
+		entries[objHash] = AffectedCacheObjectMetadata{Object: affected, CausingObjects: []ObjectHash{causing}, Policy: policy}
 	}
 }
 
@@ -71,5 +83,6 @@ func (m *GeneratedCacheMetadataCollector) Metadata() GeneratedCacheMetadata {
 		BrokenObjects:     m.brokenObjects,
 		ExcludedObjects:   lo.Values(m.excludedObjects),
 		BackfilledObjects: lo.Values(m.backfilledObjects),
+		FailedObjects:     lo.Values(m.failedObjects),
 	}
 }
@@ -21,9 +21,9 @@ func TestGeneratedCacheMetadataCollector(t *testing.T) {
 			fallback.GetObjectHash(causing1),
 			fallback.GetObjectHash(causing2),
 		)
-		c.CollectExcluded(excluded1, fallback.GetObjectHash(causing1))
-		c.CollectExcluded(excluded2, fallback.GetObjectHash(causing1))
-		c.CollectExcluded(excluded2, fallback.GetObjectHash(causing2)) // Duplicate with another causing object.
+		c.CollectExcluded(excluded1, fallback.GetObjectHash(causing1), fallback.PolicyExclude)
+		c.CollectExcluded(excluded2, fallback.GetObjectHash(causing1), fallback.PolicyExclude)
+		c.CollectExcluded(excluded2, fallback.GetObjectHash(causing2), fallback.PolicyExclude) // Duplicate with another causing object.
 
 		meta := c.Metadata()
 		require.ElementsMatch(t, []fallback.ObjectHash{
@@ -36,10 +36,12 @@ func TestGeneratedCacheMetadataCollector(t *testing.T) {
 			{
 				Object:         excluded1,
 				CausingObjects: []fallback.ObjectHash{fallback.GetObjectHash(causing1)},
+				Policy:         fallback.PolicyExclude,
 			},
 			{
 				Object:         excluded2,
 				CausingObjects: []fallback.ObjectHash{fallback.GetObjectHash(causing1), fallback.GetObjectHash(causing2)},
+				Policy:         fallback.PolicyExclude,
 			},
 		},
 			meta.ExcludedObjects,
@@ -51,13 +53,13 @@ func TestGeneratedCacheMetadataCollector(t *testing.T) {
 			fallback.GetObjectHash(causing1),
 			fallback.GetObjectHash(causing2),
 		)
-		c.CollectExcluded(excluded1, fallback.GetObjectHash(causing1))
-		c.CollectExcluded(excluded2, fallback.GetObjectHash(causing1))
-		c.CollectExcluded(excluded2, fallback.GetObjectHash(causing2)) // Duplicate with another causing object.
+		c.CollectExcluded(excluded1, fallback.GetObjectHash(causing1), fallback.PolicyExclude)
+		c.CollectExcluded(excluded2, fallback.GetObjectHash(causing1), fallback.PolicyExclude)
+		c.CollectExcluded(excluded2, fallback.GetObjectHash(causing2), fallback.PolicyExclude) // Duplicate with another causing object.
 
-		c.CollectBackfilled(backfilled1, fallback.GetObjectHash(causing1))
-		c.CollectBackfilled(backfilled2, fallback.GetObjectHash(causing1))
-		c.CollectBackfilled(backfilled2, fallback.GetObjectHash(causing2)) // Duplicate with another causing object.
+		c.CollectBackfilled(backfilled1, fallback.GetObjectHash(causing1), fallback.PolicyBackfill)
+		c.CollectBackfilled(backfilled2, fallback.GetObjectHash(causing1), fallback.PolicyBackfill)
+		c.CollectBackfilled(backfilled2, fallback.GetObjectHash(causing2), fallback.PolicyBackfill) // Duplicate with another causing object.
 
 		meta := c.Metadata()
 		require.ElementsMatch(t, []fallback.ObjectHash{
@@ -70,10 +72,12 @@ func TestGeneratedCacheMetadataCollector(t *testing.T) {
 			{
 				Object:         excluded1,
 				CausingObjects: []fallback.ObjectHash{fallback.GetObjectHash(causing1)},
+				Policy:         fallback.PolicyExclude,
 			},
 			{
 				Object:         excluded2,
 				CausingObjects: []fallback.ObjectHash{fallback.GetObjectHash(causing1), fallback.GetObjectHash(causing2)},
+				Policy:         fallback.PolicyExclude,
 			},
 		},
 			meta.ExcludedObjects,
@@ -82,10 +86,12 @@ func TestGeneratedCacheMetadataCollector(t *testing.T) {
 			{
 				Object:         backfilled1,
 				CausingObjects: []fallback.ObjectHash{fallback.GetObjectHash(causing1)},
+				Policy:         fallback.PolicyBackfill,
 			},
 			{
 				Object:         backfilled2,
 				CausingObjects: []fallback.ObjectHash{fallback.GetObjectHash(causing1), fallback.GetObjectHash(causing2)},
+				Policy:         fallback.PolicyBackfill,
 			},
 		},
 			meta.BackfilledObjects,
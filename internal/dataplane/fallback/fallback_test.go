@@ -90,10 +90,10 @@ func TestGenerator_GenerateExcludingBrokenObjects(t *testing.T) {
 	g := fallback.NewGenerator(graphProvider, logr.Discard())
 
 	t.Run("ingressClass is broken", func(t *testing.T) {
-		fallbackCache, _, err := g.GenerateExcludingBrokenObjects(inputCacheStores, []fallback.ObjectHash{fallback.GetObjectHash(ingressClass)})
+		fallbackCache, _, err := g.GenerateExcludingBrokenObjects(inputCacheStores, nil, []fallback.ObjectHash{fallback.GetObjectHash(ingressClass)})
 		require.NoError(t, err)
 		require.Equal(t, inputCacheStores, graphProvider.CacheToGraphLastCalledWith(), "expected the generator to call CacheToGraph with the input cache stores")
-		require.NotSame(t, inputCacheStores, fallbackCache)
+		require.NotSame(t, inputCacheStores.Service, fallbackCache.Service)
 		require.Empty(t, fallbackCache.IngressClassV1.List(), "ingressClass should be excluded as it's broken")
 		require.Empty(t, fallbackCache.Service.List(), "service should be excluded as it depends on ingressClass")
 		require.Empty(t, fallbackCache.KongServiceFacade.List(), "serviceFacade should be excluded as it depends on service")
@@ -101,10 +101,10 @@ func TestGenerator_GenerateExcludingBrokenObjects(t *testing.T) {
 	})
 
 	t.Run("service is broken", func(t *testing.T) {
-		fallbackCache, _, err := g.GenerateExcludingBrokenObjects(inputCacheStores, []fallback.ObjectHash{fallback.GetObjectHash(service)})
+		fallbackCache, _, err := g.GenerateExcludingBrokenObjects(inputCacheStores, nil, []fallback.ObjectHash{fallback.GetObjectHash(service)})
 		require.NoError(t, err)
 		require.Equal(t, inputCacheStores, graphProvider.CacheToGraphLastCalledWith(), "expected the generator to call CacheToGraph with the input cache stores")
-		require.NotSame(t, inputCacheStores, fallbackCache)
+		require.NotSame(t, inputCacheStores.Service, fallbackCache.Service)
 		require.Empty(t, fallbackCache.Service.List(), "service should be excluded as it's broken")
 		require.Empty(t, fallbackCache.KongServiceFacade.List(), "serviceFacade should be excluded as it depends on service")
 		require.ElementsMatch(t, fallbackCache.IngressClassV1.List(), []any{ingressClass}, "ingressClass shouldn't be excluded as it doesn't depend on service")
@@ -112,10 +112,10 @@ func TestGenerator_GenerateExcludingBrokenObjects(t *testing.T) {
 	})
 
 	t.Run("serviceFacade is broken", func(t *testing.T) {
-		fallbackCache, _, err := g.GenerateExcludingBrokenObjects(inputCacheStores, []fallback.ObjectHash{fallback.GetObjectHash(serviceFacade)})
+		fallbackCache, _, err := g.GenerateExcludingBrokenObjects(inputCacheStores, nil, []fallback.ObjectHash{fallback.GetObjectHash(serviceFacade)})
 		require.NoError(t, err)
 		require.Equal(t, inputCacheStores, graphProvider.CacheToGraphLastCalledWith(), "expected the generator to call CacheToGraph with the input cache stores")
-		require.NotSame(t, inputCacheStores, fallbackCache)
+		require.NotSame(t, inputCacheStores.Service, fallbackCache.Service)
 		require.Empty(t, fallbackCache.KongServiceFacade.List(), "serviceFacade should be excluded as it's broken")
 		require.ElementsMatch(t, fallbackCache.IngressClassV1.List(), []any{ingressClass}, "ingressClass shouldn't be excluded as it doesn't depend on service")
 		require.ElementsMatch(t, fallbackCache.Service.List(), []any{service}, "service shouldn't be excluded as it doesn't depend on serviceFacade")
@@ -123,10 +123,10 @@ func TestGenerator_GenerateExcludingBrokenObjects(t *testing.T) {
 	})
 
 	t.Run("plugin is broken", func(t *testing.T) {
-		fallbackCache, _, err := g.GenerateExcludingBrokenObjects(inputCacheStores, []fallback.ObjectHash{fallback.GetObjectHash(plugin)})
+		fallbackCache, _, err := g.GenerateExcludingBrokenObjects(inputCacheStores, nil, []fallback.ObjectHash{fallback.GetObjectHash(plugin)})
 		require.NoError(t, err)
 		require.Equal(t, inputCacheStores, graphProvider.CacheToGraphLastCalledWith(), "expected the generator to call CacheToGraph with the input cache stores")
-		require.NotSame(t, inputCacheStores, fallbackCache)
+		require.NotSame(t, inputCacheStores.Service, fallbackCache.Service)
 		require.Empty(t, fallbackCache.Plugin.List(), "plugin should be excluded as it's broken")
 		require.ElementsMatch(t, fallbackCache.IngressClassV1.List(), []any{ingressClass}, "ingressClass shouldn't be excluded as it doesn't depend on plugin")
 		require.ElementsMatch(t, fallbackCache.Service.List(), []any{service}, "service shouldn't be excluded as it doesn't depend on plugin")
@@ -134,10 +134,10 @@ func TestGenerator_GenerateExcludingBrokenObjects(t *testing.T) {
 	})
 
 	t.Run("multiple objects are broken", func(t *testing.T) {
-		fallbackCache, _, err := g.GenerateExcludingBrokenObjects(inputCacheStores, []fallback.ObjectHash{fallback.GetObjectHash(ingressClass), fallback.GetObjectHash(service)})
+		fallbackCache, _, err := g.GenerateExcludingBrokenObjects(inputCacheStores, nil, []fallback.ObjectHash{fallback.GetObjectHash(ingressClass), fallback.GetObjectHash(service)})
 		require.NoError(t, err)
 		require.Equal(t, inputCacheStores, graphProvider.CacheToGraphLastCalledWith(), "expected the generator to call CacheToGraph with the input cache stores")
-		require.NotSame(t, inputCacheStores, fallbackCache)
+		require.NotSame(t, inputCacheStores.Service, fallbackCache.Service)
 		require.Empty(t, fallbackCache.IngressClassV1.List(), "ingressClass should be excluded as it's broken")
 		require.Empty(t, fallbackCache.Service.List(), "service should be excluded as it's broken")
 		require.Empty(t, fallbackCache.KongServiceFacade.List(), "serviceFacade should be excluded as it depends on service")
@@ -224,7 +224,7 @@ func TestGenerator_GenerateBackfillingBrokenObjects(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, []store.CacheStores{inputCacheStores, lastValidCacheStores}, graphProvider.CacheToGraphLastNCalledWith(2),
 			"expected the generator to call CacheToGraph with the input cache stores and the last valid cache stores")
-		require.NotSame(t, inputCacheStores, fallbackCache)
+		require.NotSame(t, inputCacheStores.Service, fallbackCache.Service)
 
 		fallbackIngressClass, err := getFromStore[*netv1.IngressClass](fallbackCache.IngressClassV1, ingressClass)
 		require.NoError(t, err)
@@ -246,7 +246,7 @@ func TestGenerator_GenerateBackfillingBrokenObjects(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, []store.CacheStores{inputCacheStores, lastValidCacheStores}, graphProvider.CacheToGraphLastNCalledWith(2),
 			"expected the generator to call CacheToGraph with the input cache stores and fallback cache")
-		require.NotSame(t, inputCacheStores, fallbackCache)
+		require.NotSame(t, inputCacheStores.Service, fallbackCache.Service)
 
 		fallbackService, err := getFromStore[*corev1.Service](fallbackCache.Service, service)
 		require.NoError(t, err)
@@ -267,7 +267,7 @@ func TestGenerator_GenerateBackfillingBrokenObjects(t *testing.T) {
 		fallbackCache, _, err := g.GenerateBackfillingBrokenObjects(inputCacheStores, &lastValidCacheStores, []fallback.ObjectHash{fallback.GetObjectHash(serviceFacade)})
 		require.NoError(t, err)
 		require.Equal(t, []store.CacheStores{inputCacheStores, lastValidCacheStores}, graphProvider.CacheToGraphLastNCalledWith(2), "expected the generator to call CacheToGraph with the input cache stores")
-		require.NotSame(t, inputCacheStores, fallbackCache)
+		require.NotSame(t, inputCacheStores.Service, fallbackCache.Service)
 
 		require.Empty(t, fallbackCache.KongServiceFacade.List(), "serviceFacade should be excluded as it's broken and it's not present in the last valid cache snapshot")
 
@@ -288,7 +288,7 @@ func TestGenerator_GenerateBackfillingBrokenObjects(t *testing.T) {
 		fallbackCache, _, err := g.GenerateBackfillingBrokenObjects(inputCacheStores, &lastValidCacheStores, []fallback.ObjectHash{fallback.GetObjectHash(plugin)})
 		require.NoError(t, err)
 		require.Equal(t, []store.CacheStores{inputCacheStores, lastValidCacheStores}, graphProvider.CacheToGraphLastNCalledWith(2), "expected the generator to call CacheToGraph with the input cache stores")
-		require.NotSame(t, inputCacheStores, fallbackCache)
+		require.NotSame(t, inputCacheStores.Service, fallbackCache.Service)
 
 		fallbackPlugin, err := getFromStore[*kongv1.KongPlugin](fallbackCache.Plugin, plugin)
 		require.NoError(t, err)
@@ -309,7 +309,7 @@ func TestGenerator_GenerateBackfillingBrokenObjects(t *testing.T) {
 		fallbackCache, _, err := g.GenerateBackfillingBrokenObjects(inputCacheStores, &lastValidCacheStores, []fallback.ObjectHash{fallback.GetObjectHash(ingressClass), fallback.GetObjectHash(service)})
 		require.NoError(t, err)
 		require.Equal(t, []store.CacheStores{inputCacheStores, lastValidCacheStores}, graphProvider.CacheToGraphLastNCalledWith(2), "expected the generator to call CacheToGraph with the input cache stores")
-		require.NotSame(t, inputCacheStores, fallbackCache)
+		require.NotSame(t, inputCacheStores.Service, fallbackCache.Service)
 
 		fallbackIngressClass, err := getFromStore[*netv1.IngressClass](fallbackCache.IngressClassV1, ingressClass)
 		require.NoError(t, err)
@@ -330,7 +330,7 @@ func TestGenerator_GenerateBackfillingBrokenObjects(t *testing.T) {
 		fallbackCache, _, err := g.GenerateBackfillingBrokenObjects(inputCacheStores, nil, []fallback.ObjectHash{fallback.GetObjectHash(ingressClass), fallback.GetObjectHash(service)})
 		require.NoError(t, err)
 		require.Equal(t, []store.CacheStores{inputCacheStores}, graphProvider.CacheToGraphLastNCalledWith(1), "expected the generator to call CacheToGraph with the input cache stores only")
-		require.NotSame(t, inputCacheStores, fallbackCache)
+		require.NotSame(t, inputCacheStores.Service, fallbackCache.Service)
 
 		require.Empty(t, fallbackCache.IngressClassV1.List(), "ingressClass should be excluded as it's broken and there's no last valid cache snapshot")
 		require.Empty(t, fallbackCache.Service.List(), "service should be excluded as it's broken and there's no last valid cache snapshot")
@@ -342,6 +342,133 @@ func TestGenerator_GenerateBackfillingBrokenObjects(t *testing.T) {
 	})
 }
 
+func TestGenerator_FallbackPolicy(t *testing.T) {
+	withFallbackPolicy := func(o client.Object, policy string) client.Object {
+		o.SetAnnotations(map[string]string{"konghq.com/fallback-policy": policy})
+		return o
+	}
+
+	// Graph structure (edges define dependency -> dependant relationship) of both current and last valid caches:
+	//  ┌────────────┐
+	//  │ingressClass│
+	//  └──────┬─────┘
+	//         │
+	//     ┌───▼───┐
+	//     │service│
+	//     └───┬───┘
+	//         │
+	//     ┌───▼──┐
+	//     │plugin│
+	//     └──────┘
+	setup := func(t *testing.T, currentService client.Object) (*fallback.Generator, store.CacheStores, store.CacheStores, []client.Object) {
+		ingressClass := testIngressClass(t, "ingressClass")
+		plugin := testKongPlugin(t, "kongPlugin")
+		inputCacheStores := cacheStoresFromObjs(t, ingressClass, currentService, plugin)
+		currentGraph, err := NewGraphBuilder().
+			WithVertices(ingressClass, currentService, plugin).
+			WithEdge(ingressClass, currentService).
+			WithEdge(currentService, plugin).
+			Build()
+		require.NoError(t, err)
+
+		lastValidService := testService(t, "service")
+		lastValidService.SetAnnotations(map[string]string{"from-last-valid": "true"})
+		lastValidCacheStores := cacheStoresFromObjs(t, ingressClass, lastValidService, plugin)
+		lastValidGraph, err := NewGraphBuilder().
+			WithVertices(ingressClass, lastValidService, plugin).
+			WithEdge(ingressClass, lastValidService).
+			WithEdge(lastValidService, plugin).
+			Build()
+		require.NoError(t, err)
+
+		graphProvider := &mockGraphProvider{}
+		graphProvider.ReturnGraphOn(inputCacheStores, currentGraph)
+		graphProvider.ReturnGraphOn(lastValidCacheStores, lastValidGraph)
+		return fallback.NewGenerator(graphProvider, logr.Discard()), inputCacheStores, lastValidCacheStores, []client.Object{ingressClass, plugin}
+	}
+	policies := func(affected []fallback.AffectedCacheObjectMetadata) map[string]fallback.Policy {
+		m := make(map[string]fallback.Policy)
+		for _, a := range affected {
+			m[a.Object.GetName()] = a.Policy
+		}
+		return m
+	}
+
+	t.Run("backfill policy is honored when excluding", func(t *testing.T) {
+		service := withFallbackPolicy(testService(t, "service"), string(fallback.PolicyBackfill))
+		g, inputCacheStores, lastValidCacheStores, objs := setup(t, service)
+		ingressClass := objs[0]
+
+		fallbackCache, meta, err := g.GenerateExcludingBrokenObjects(inputCacheStores, &lastValidCacheStores, []fallback.ObjectHash{fallback.GetObjectHash(ingressClass)})
+		require.NoError(t, err)
+
+		require.Empty(t, fallbackCache.IngressClassV1.List(), "ingressClass should be excluded according to the default policy")
+		fallbackService, err := getFromStore[*corev1.Service](fallbackCache.Service, service)
+		require.NoError(t, err)
+		require.Equal(t, "true", fallbackService.GetAnnotations()["from-last-valid"], "service should be backfilled according to its policy")
+		require.Empty(t, fallbackCache.Plugin.List(), "plugin should be excluded according to the default policy")
+
+		require.Equal(t, map[string]fallback.Policy{
+			"ingressClass": fallback.PolicyExclude,
+			"service":      fallback.PolicyBackfill,
+			"kongPlugin":   fallback.PolicyExclude,
+		}, policies(meta.ExcludedObjects))
+		require.Equal(t, map[string]fallback.Policy{
+			"service": fallback.PolicyBackfill,
+		}, policies(meta.BackfilledObjects))
+	})
+
+	t.Run("exclude policy is honored when backfilling", func(t *testing.T) {
+		service := withFallbackPolicy(testService(t, "service"), string(fallback.PolicyExclude))
+		g, inputCacheStores, lastValidCacheStores, objs := setup(t, service)
+		ingressClass, plugin := objs[0], objs[1]
+
+		fallbackCache, meta, err := g.GenerateBackfillingBrokenObjects(inputCacheStores, &lastValidCacheStores, []fallback.ObjectHash{fallback.GetObjectHash(ingressClass)})
+		require.NoError(t, err)
+
+		_, err = getFromStore[*netv1.IngressClass](fallbackCache.IngressClassV1, ingressClass)
+		require.NoError(t, err, "ingressClass should be backfilled according to the default policy")
+		require.Empty(t, fallbackCache.Service.List(), "service should be excluded according to its policy")
+		_, err = getFromStore[*kongv1.KongPlugin](fallbackCache.Plugin, plugin)
+		require.NoError(t, err, "plugin should be backfilled according to the default policy")
+
+		require.Equal(t, map[string]fallback.Policy{
+			"ingressClass": fallback.PolicyBackfill,
+			"kongPlugin":   fallback.PolicyBackfill,
+		}, policies(meta.BackfilledObjects))
+	})
+
+	t.Run("fail policy prevents generating", func(t *testing.T) {
+		service := withFallbackPolicy(testService(t, "service"), string(fallback.PolicyFail))
+		g, inputCacheStores, lastValidCacheStores, objs := setup(t, service)
+		ingressClass := objs[0]
+
+		_, _, err := g.GenerateExcludingBrokenObjects(inputCacheStores, &lastValidCacheStores, []fallback.ObjectHash{fallback.GetObjectHash(ingressClass)})
+		require.ErrorAs(t, err, &fallback.PolicyFailError{})
+		_, meta, err := g.GenerateBackfillingBrokenObjects(inputCacheStores, &lastValidCacheStores, []fallback.ObjectHash{fallback.GetObjectHash(ingressClass)})
+		require.Equal(t, fallback.PolicyFailError{
+			Object:        fallback.GetObjectHash(service),
+			CausingObject: fallback.GetObjectHash(ingressClass),
+		}, err)
+
+		t.Log("Verifying the object with the fail policy is recorded in the metadata")
+		require.Equal(t, map[string]fallback.Policy{"service": fallback.PolicyFail}, policies(meta.FailedObjects))
+		require.Equal(t, []fallback.ObjectHash{fallback.GetObjectHash(ingressClass)}, meta.FailedObjects[0].CausingObjects)
+		require.Empty(t, meta.BackfilledObjects)
+	})
+
+	t.Run("invalid policy falls back to the default one", func(t *testing.T) {
+		service := withFallbackPolicy(testService(t, "service"), "invalid")
+		g, inputCacheStores, lastValidCacheStores, objs := setup(t, service)
+		ingressClass := objs[0]
+
+		_, meta, err := g.GenerateExcludingBrokenObjects(inputCacheStores, &lastValidCacheStores, []fallback.ObjectHash{fallback.GetObjectHash(ingressClass)})
+		require.NoError(t, err)
+		require.Equal(t, fallback.PolicyExclude, policies(meta.ExcludedObjects)["service"])
+		require.Empty(t, meta.BackfilledObjects)
+	})
+}
+
 func TestGenerator_ReturnsMetadata(t *testing.T) {
 	ingressClass := testIngressClass(t, "ingressClass")
 	service := testService(t, "service")
@@ -361,7 +488,7 @@ func TestGenerator_ReturnsMetadata(t *testing.T) {
 	g := fallback.NewGenerator(graphProvider, logr.Discard())
 
 	t.Run("on excluding", func(t *testing.T) {
-		_, meta, err := g.GenerateExcludingBrokenObjects(inputCacheStores, []fallback.ObjectHash{
+		_, meta, err := g.GenerateExcludingBrokenObjects(inputCacheStores, nil, []fallback.ObjectHash{
 			fallback.GetObjectHash(ingressClass),
 		})
 		require.NoError(t, err)
@@ -122,3 +122,35 @@ func (g *ConfigGraph) SubgraphObjects(sourceHash ObjectHash) ([]client.Object, e
 	}
 	return objects, nil
 }
+
+// Subgraph returns a new graph consisting of the source object, all objects reachable from it and edges between them.
+// It can be used to determine the objects affected by the source object being broken along with the reasons
+// (dependencies) they are affected for.
+// If the source object is not in the graph, an empty graph is returned.
+func (g *ConfigGraph) Subgraph(sourceHash ObjectHash) (*ConfigGraph, error) {
+	objects, err := g.SubgraphObjects(sourceHash)
+	if err != nil {
+		return nil, err
+	}
+	am, err := g.graph.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	subgraph := NewConfigGraph()
+	for _, obj := range objects {
+		if err := subgraph.AddVertex(obj); err != nil {
+			return nil, fmt.Errorf("failed to add %s to the subgraph: %w", GetObjectHash(obj), err)
+		}
+	}
+	// All the neighbours of the subgraph objects are reachable from the source object, hence they're in the subgraph.
+	for _, obj := range objects {
+		from := GetObjectHash(obj)
+		for to := range am[from] {
+			if err := subgraph.AddEdge(from, to); err != nil {
+				return nil, fmt.Errorf("failed to add edge from %s to %s to the subgraph: %w", from, to, err)
+			}
+		}
+	}
+	return subgraph, nil
+}
@@ -54,16 +54,22 @@ func ResolveDependencies(cache store.CacheStores, obj client.Object) ([]client.O
 		return resolveKongServiceFacadeDependencies(cache, obj), nil
 	case *kongv1alpha1.KongCustomEntity:
 		return resolveKongCustomEntityDependencies(cache, obj), nil
+	case *kongv1alpha1.KongExternalBackend:
+		return resolveKongExternalBackendDependencies(cache, obj), nil
 	// Object types that have no dependencies.
 	case *netv1.IngressClass,
 		*corev1.Secret,
 		*discoveryv1.EndpointSlice,
 		*gatewayapi.ReferenceGrant,
 		*gatewayapi.Gateway,
+		*gatewayapi.GatewayClass,
 		*kongv1.KongIngress,
 		*kongv1beta1.KongUpstreamPolicy,
 		*kongv1alpha1.IngressClassParameters,
-		*kongv1alpha1.KongVault:
+		*kongv1alpha1.KongVault,
+		*kongv1alpha1.KongPluginPolicy,
+		*kongv1alpha1.KongHostnamePolicy,
+		*kongv1alpha1.GatewayClassParameters:
 		return nil, nil
 	default:
 		return nil, fmt.Errorf("unsupported object type: %T", obj)
@@ -10,26 +10,31 @@ import (
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
+	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
+	incubatorv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/incubator/v1alpha1"
 )
 
 // resolveHTTPRouteDependencies resolves potential dependencies for a given HTTPRoute object:
 // - Service
+// - KongServiceFacade
+// - KongExternalBackend
 // - KongPlugin
 // - KongClusterPlugin.
 func resolveHTTPRouteDependencies(cache store.CacheStores, route *gatewayapi.HTTPRoute) []client.Object {
 	return slices.Concat(
-		resolveGatewayAPIRouteDependenciesBackendRefs(cache, route, getHTTPRouteBackendRefs(route)),
+		resolveGatewayAPIRouteDependenciesBackendRefs(cache, route, gatewayapi.V1HTTPRouteTypeMeta.Kind, getHTTPRouteBackendRefs(route)),
 		resolveObjectDependenciesPlugin(cache, route),
 	)
 }
 
 // resolveTCPRouteDependencies resolves potential dependencies for a given TCPRoute object:
 // - Service
+// - KongExternalBackend
 // - KongPlugin
 // - KongClusterPlugin.
 func resolveTCPRouteDependencies(cache store.CacheStores, route *gatewayapi.TCPRoute) []client.Object {
 	return slices.Concat(
-		resolveGatewayAPIRouteDependenciesBackendRefs(cache, route, getTCPRouteBackendRefs(route)),
+		resolveGatewayAPIRouteDependenciesBackendRefs(cache, route, gatewayapi.TCPRouteTypeMeta.Kind, getTCPRouteBackendRefs(route)),
 		resolveObjectDependenciesPlugin(cache, route),
 	)
 }
@@ -40,7 +45,7 @@ func resolveTCPRouteDependencies(cache store.CacheStores, route *gatewayapi.TCPR
 // - KongClusterPlugin.
 func resolveUDPRouteDependencies(cache store.CacheStores, route *gatewayapi.UDPRoute) []client.Object {
 	return slices.Concat(
-		resolveGatewayAPIRouteDependenciesBackendRefs(cache, route, getUDPRouteBackendRefs(route)),
+		resolveGatewayAPIRouteDependenciesBackendRefs(cache, route, gatewayapi.UDPRouteTypeMeta.Kind, getUDPRouteBackendRefs(route)),
 		resolveObjectDependenciesPlugin(cache, route),
 	)
 }
@@ -51,18 +56,19 @@ func resolveUDPRouteDependencies(cache store.CacheStores, route *gatewayapi.UDPR
 // - KongClusterPlugin.
 func resolveTLSRouteDependencies(cache store.CacheStores, route *gatewayapi.TLSRoute) []client.Object {
 	return slices.Concat(
-		resolveGatewayAPIRouteDependenciesBackendRefs(cache, route, getTLSRouteBackendRefs(route)),
+		resolveGatewayAPIRouteDependenciesBackendRefs(cache, route, gatewayapi.TLSRouteTypeMeta.Kind, getTLSRouteBackendRefs(route)),
 		resolveObjectDependenciesPlugin(cache, route),
 	)
 }
 
 // resolveGRPCRouteDependencies resolves potential dependencies for a given GRPCRoute object:
 // - Service
+// - KongServiceFacade
 // - KongPlugin
 // - KongClusterPlugin.
 func resolveGRPCRouteDependencies(cache store.CacheStores, route *gatewayapi.GRPCRoute) []client.Object {
 	return slices.Concat(
-		resolveGatewayAPIRouteDependenciesBackendRefs(cache, route, getGRPCRouteBackendRefs(route)),
+		resolveGatewayAPIRouteDependenciesBackendRefs(cache, route, gatewayapi.GRPCRouteTypeMeta.Kind, getGRPCRouteBackendRefs(route)),
 		resolveObjectDependenciesPlugin(cache, route),
 	)
 }
@@ -73,20 +79,32 @@ type gatewayAPIRoute interface {
 	*gatewayapi.HTTPRoute | *gatewayapi.TCPRoute | *gatewayapi.UDPRoute | *gatewayapi.TLSRoute | *gatewayapi.GRPCRoute
 }
 
-// resolveGatewayAPIRouteDependenciesBackendRefs resolves backend references for a given gatewayAPIRoute object.
-func resolveGatewayAPIRouteDependenciesBackendRefs[T gatewayAPIRoute](cache store.CacheStores, route T, backendRefs []gatewayapi.BackendRef) []client.Object {
+// resolveGatewayAPIRouteDependenciesBackendRefs resolves backend references for a given gatewayAPIRoute object
+// of the given kind. Only backends of kinds supported by routes of that kind are resolved.
+func resolveGatewayAPIRouteDependenciesBackendRefs[T gatewayAPIRoute](
+	cache store.CacheStores, route T, routeKind string, backendRefs []gatewayapi.BackendRef,
+) []client.Object {
 	var dependencies []client.Object
 	for _, backendRef := range backendRefs {
-		if !util.IsBackendRefGroupKindSupported(backendRef.Group, backendRef.Kind) {
+		if !util.IsBackendRefGroupKindSupported(routeKind, backendRef.Group, backendRef.Kind) {
 			continue
 		}
 		ns := route.GetNamespace()
 		if backendRef.Namespace != nil {
 			ns = string(*backendRef.Namespace)
 		}
-		ingressClass, exists, err := cache.Service.GetByKey(fmt.Sprintf("%s/%s", ns, backendRef.Name))
+		backendStore := cache.Service
+		if backendRef.Kind != nil {
+			switch *backendRef.Kind {
+			case incubatorv1alpha1.KongServiceFacadeKind:
+				backendStore = cache.KongServiceFacade
+			case kongv1alpha1.KongExternalBackendKind:
+				backendStore = cache.KongExternalBackend
+			}
+		}
+		backend, exists, err := backendStore.GetByKey(fmt.Sprintf("%s/%s", ns, backendRef.Name))
 		if err == nil && exists {
-			dependencies = append(dependencies, ingressClass.(client.Object))
+			dependencies = append(dependencies, backend.(client.Object))
 		}
 	}
 	return dependencies
@@ -9,6 +9,8 @@ import (
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
+	incubatorv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/incubator/v1alpha1"
 )
 
 func TestResolveDependencies_HTTPRoute(t *testing.T) {
@@ -72,6 +74,72 @@ func TestResolveDependencies_HTTPRoute(t *testing.T) {
 				testService(t, "2"),
 			},
 		},
+		{
+			name: "HTTPRoute -> KongServiceFacade",
+			object: &gatewayapi.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-route",
+					Namespace: "test-namespace",
+				},
+				Spec: gatewayapi.HTTPRouteSpec{
+					Rules: []gatewayapi.HTTPRouteRule{
+						{
+							BackendRefs: []gatewayapi.HTTPBackendRef{
+								{
+									BackendRef: gatewayapi.BackendRef{
+										BackendObjectReference: gatewayapi.BackendObjectReference{
+											Name:  "1",
+											Group: lo.ToPtr(gatewayapi.Group(incubatorv1alpha1.GroupVersion.Group)),
+											Kind:  lo.ToPtr(gatewayapi.Kind(incubatorv1alpha1.KongServiceFacadeKind)),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			cache: cacheStoresFromObjs(t,
+				testService(t, "1"),
+				testKongServiceFacade(t, "1"),
+			),
+			expected: []client.Object{
+				testKongServiceFacade(t, "1"),
+			},
+		},
+		{
+			name: "HTTPRoute -> KongExternalBackend",
+			object: &gatewayapi.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-route",
+					Namespace: "test-namespace",
+				},
+				Spec: gatewayapi.HTTPRouteSpec{
+					Rules: []gatewayapi.HTTPRouteRule{
+						{
+							BackendRefs: []gatewayapi.HTTPBackendRef{
+								{
+									BackendRef: gatewayapi.BackendRef{
+										BackendObjectReference: gatewayapi.BackendObjectReference{
+											Name:  "1",
+											Group: lo.ToPtr(gatewayapi.Group(kongv1alpha1.GroupVersion.Group)),
+											Kind:  lo.ToPtr(gatewayapi.Kind(kongv1alpha1.KongExternalBackendKind)),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			cache: cacheStoresFromObjs(t,
+				testService(t, "1"),
+				testKongExternalBackend(t, "1"),
+			),
+			expected: []client.Object{
+				testKongExternalBackend(t, "1"),
+			},
+		},
 		{
 			name: "HTTPRoute -> KongPlugin, KongClusterPlugin",
 			object: &gatewayapi.HTTPRoute{
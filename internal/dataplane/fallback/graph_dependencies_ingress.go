@@ -16,6 +16,7 @@ import (
 // - IngressClass
 // - Service
 // - KongServiceFacade
+// - KongExternalBackend
 // - KongUpstreamPolicy
 // - KongPlugin
 // - KongClusterPlugin.
@@ -53,7 +54,8 @@ func resolveIngressDependenciesIngressClass(cache store.CacheStores, ingress *ne
 	return dependencies
 }
 
-// resolveIngressDependenciesService resolves Service and KongServiceFacade dependencies for an Ingress object.
+// resolveIngressDependenciesService resolves Service, KongServiceFacade and KongExternalBackend dependencies
+// for an Ingress object.
 func resolveIngressDependenciesService(cache store.CacheStores, ingress *netv1.Ingress) []client.Object {
 	var dependencies []client.Object
 	for _, rule := range ingress.Spec.Rules {
@@ -74,6 +76,13 @@ func resolveIngressDependenciesService(cache store.CacheStores, ingress *netv1.I
 					dependencies = append(dependencies, kongServiceFacade.(client.Object))
 				}
 			}
+
+			if resource := path.Backend.Resource; resource != nil && subtranslator.IsKongExternalBackend(resource) {
+				kongExternalBackend, exists, err := cache.KongExternalBackend.GetByKey(fmt.Sprintf("%s/%s", ingress.GetNamespace(), resource.Name))
+				if err == nil && exists {
+					dependencies = append(dependencies, kongExternalBackend.(client.Object))
+				}
+			}
 		}
 	}
 	return dependencies
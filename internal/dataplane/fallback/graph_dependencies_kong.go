@@ -142,6 +142,14 @@ func resolveKongServiceFacadeDependencies(cache store.CacheStores, kongServiceFa
 	return resolveDependenciesForServiceLikeObj(cache, kongServiceFacade)
 }
 
+// resolveKongExternalBackendDependencies resolves potential dependencies for a KongExternalBackend object:
+// - KongPlugin
+// - KongClusterPlugin
+// - KongUpstreamPolicy.
+func resolveKongExternalBackendDependencies(cache store.CacheStores, kongExternalBackend *kongv1alpha1.KongExternalBackend) []client.Object {
+	return resolveDependenciesForServiceLikeObj(cache, kongExternalBackend)
+}
+
 // resolveKongCustomEntityDependencies resolves potential dependencies for a KongCustomEntities object:
 // - KongPlugin
 // - KongClusterPlugin.
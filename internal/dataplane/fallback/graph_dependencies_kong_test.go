@@ -500,6 +500,48 @@ func TestResolveDependencies_KongServiceFacade(t *testing.T) {
 	}
 }
 
+func TestResolveDependencies_KongExternalBackend(t *testing.T) {
+	testCases := []resolveDependenciesTestCase{
+		{
+			name: "no dependencies",
+			object: &kongv1alpha1.KongExternalBackend{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-KongExternalBackend",
+					Namespace: "test-namespace",
+				},
+			},
+			cache: cacheStoresFromObjs(t,
+				testKongPlugin(t, "1"),
+				testKongUpstreamPolicy(t, "1"),
+			),
+			expected: []client.Object{},
+		},
+		{
+			name: "KongExternalBackend -> plugins - annotation and KongUpstreamPolicy",
+			object: &kongv1alpha1.KongExternalBackend{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-KongExternalBackend",
+					Namespace: "test-namespace",
+					Annotations: map[string]string{
+						annotations.AnnotationPrefix + annotations.PluginsKey: "1, cluster-1",
+						kongv1beta1.KongUpstreamPolicyAnnotationKey:           "1",
+					},
+				},
+			},
+			cache: cacheStoresFromObjs(t,
+				testKongPlugin(t, "1"),
+				testKongClusterPlugin(t, "cluster-1"),
+				testKongUpstreamPolicy(t, "1"),
+			),
+			expected: []client.Object{testKongPlugin(t, "1"), testKongClusterPlugin(t, "cluster-1"), testKongUpstreamPolicy(t, "1")},
+		},
+	}
+
+	for _, tc := range testCases {
+		runResolveDependenciesTest(t, tc)
+	}
+}
+
 func TestResolveDependencies_UDPIngress(t *testing.T) {
 	testCases := []resolveDependenciesTestCase{
 		{
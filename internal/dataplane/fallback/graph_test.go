@@ -86,3 +86,82 @@ func TestConfigGraph_SubgraphObjects(t *testing.T) {
 	require.NoError(t, err)
 	require.Empty(t, objects, "expected no objects returned for a source object not in the graph")
 }
+
+func TestConfigGraph_Subgraph(t *testing.T) {
+	var (
+		A = NewMockObject("A")
+		B = NewMockObject("B")
+		C = NewMockObject("C")
+		D = NewMockObject("D")
+		E = NewMockObject("E")
+		F = NewMockObject("F") // Not included in the graph.
+	)
+
+	// Graph structure (edges define dependency -> dependant relationship):
+	//     ┌───┐     ┌───┐
+	//     │ A │     │ E │
+	//     └─┬─┘     └─┬─┘
+	//       │         │
+	//   ┌───┴───┐     │
+	//   │       │     │
+	// ┌─▼─┐   ┌─▼─┐   │
+	// │ B │   │ C │   │
+	// └───┘   └─┬─┘   │
+	//           │     │
+	//           ├─────┘
+	//           │
+	//         ┌─▼─┐
+	//         │ D │
+	//         └───┘
+	g, err := NewGraphBuilder().
+		WithVertices(A, B, C, D, E).
+		WithEdge(A, B).
+		WithEdge(A, C).
+		WithEdge(C, D).
+		WithEdge(E, D).
+		Build()
+	require.NoError(t, err)
+
+	hash := fallback.GetObjectHash
+	testCases := []struct {
+		name        string
+		source      client.Object
+		expectedMap fallback.AdjacencyMap
+	}{
+		{
+			name:   "source with dependants",
+			source: A,
+			expectedMap: fallback.AdjacencyMap{
+				hash(A): {hash(B), hash(C)},
+				hash(B): {},
+				hash(C): {hash(D)},
+				hash(D): {},
+			},
+		},
+		{
+			name:   "edges from outside the subgraph are not included",
+			source: C,
+			expectedMap: fallback.AdjacencyMap{
+				hash(C): {hash(D)},
+				hash(D): {},
+			},
+		},
+		{
+			name:        "source not in the graph",
+			source:      F,
+			expectedMap: fallback.AdjacencyMap{},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			subgraph, err := g.Subgraph(hash(tc.source))
+			require.NoError(t, err)
+			am, err := subgraph.AdjacencyMap()
+			require.NoError(t, err)
+			require.Len(t, am, len(tc.expectedMap))
+			for v, neighbours := range tc.expectedMap {
+				require.ElementsMatch(t, neighbours, am[v])
+			}
+		})
+	}
+}
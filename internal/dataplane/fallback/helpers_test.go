@@ -14,6 +14,7 @@ import (
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/fallback"
 	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
+	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
 	kongv1beta1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1beta1"
 	incubatorv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/incubator/v1alpha1"
 	"github.com/kong/kubernetes-ingress-controller/v3/test/helpers"
@@ -66,6 +67,15 @@ func testKongServiceFacade(t *testing.T, name string) *incubatorv1alpha1.KongSer
 	})
 }
 
+func testKongExternalBackend(t *testing.T, name string) *kongv1alpha1.KongExternalBackend {
+	return helpers.WithTypeMeta(t, &kongv1alpha1.KongExternalBackend{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: testNamespace,
+		},
+	})
+}
+
 func testKongPlugin(t *testing.T, name string, modifiers ...func(p *kongv1.KongPlugin)) *kongv1.KongPlugin {
 	p := helpers.WithTypeMeta(t, &kongv1.KongPlugin{
 		ObjectMeta: metav1.ObjectMeta{
@@ -0,0 +1,64 @@
+package fallback
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
+)
+
+// Policy defines how an object affected by a broken object (the broken object itself or any of its dependants) is
+// handled when generating a fallback configuration. It can be set per object with the konghq.com/fallback-policy
+// annotation.
+type Policy string
+
+const (
+	// PolicyExclude excludes the affected object from the fallback configuration.
+	PolicyExclude Policy = "exclude"
+	// PolicyBackfill replaces the affected object with its version from the last valid configuration. If there's
+	// no such version, the object is excluded.
+	PolicyBackfill Policy = "backfill"
+	// PolicyFail prevents generating a fallback configuration altogether when the object is affected. In such case
+	// the last valid configuration is applied as a whole.
+	PolicyFail Policy = "fail"
+)
+
+// PolicyFailError is returned when a fallback configuration cannot be generated because one of the affected objects
+// has the PolicyFail policy. All such objects are listed in GeneratedCacheMetadata.FailedObjects.
+type PolicyFailError struct {
+	// Object is the affected object that has the PolicyFail policy.
+	Object ObjectHash
+	// CausingObject is the broken object that affected the Object.
+	CausingObject ObjectHash
+}
+
+func (e PolicyFailError) Error() string {
+	return fmt.Sprintf("%s affected by broken %s has %q fallback policy", e.Object, e.CausingObject, PolicyFail)
+}
+
+// ParsePolicy parses the value of the fallback policy annotation.
+func ParsePolicy(value string) (Policy, error) {
+	switch policy := Policy(value); policy {
+	case PolicyExclude, PolicyBackfill, PolicyFail:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("unknown fallback policy %q, expected one of: %q, %q, %q", value, PolicyExclude, PolicyBackfill, PolicyFail)
+	}
+}
+
+// policyFor returns the fallback policy of the object. When the object has no or an invalid policy annotation,
+// defaultPolicy is returned.
+func (g *Generator) policyFor(obj client.Object, defaultPolicy Policy) Policy {
+	value, ok := annotations.ExtractFallbackPolicy(obj.GetAnnotations())
+	if !ok {
+		return defaultPolicy
+	}
+	policy, err := ParsePolicy(value)
+	if err != nil {
+		g.logger.Error(err, "Invalid fallback policy annotation value, using the default one",
+			"object", GetObjectHash(obj).String(), "default", defaultPolicy)
+		return defaultPolicy
+	}
+	return policy
+}
@@ -39,9 +39,12 @@ import (
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/logging"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/metrics"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/tracing"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
 	k8sobj "github.com/kong/kubernetes-ingress-controller/v3/internal/util/kubernetes/object"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/kubernetes/object/status"
+	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
+	kongv1beta1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1beta1"
 )
 
 const (
@@ -58,6 +61,10 @@ const (
 	FallbackKongConfigurationTranslationFailedEventReason = "FallbackKongConfigurationTranslationFailed"
 	// FallbackKongConfigurationApplyFailedEventReason defines an event reason used for creating fallback config apply resource failure events.
 	FallbackKongConfigurationApplyFailedEventReason = "FallbackKongConfigurationApplyFailed"
+
+	// KongRouteConflictEventReason defines an event reason used for creating events about routes of an object
+	// conflicting with routes of another object.
+	KongRouteConflictEventReason = "KongRouteConflict"
 )
 
 // -----------------------------------------------------------------------------
@@ -66,7 +73,7 @@ const (
 
 // KongConfigBuilder builds a Kong configuration from a Kubernetes object cache.
 type KongConfigBuilder interface {
-	BuildKongConfig() translator.KongConfigBuildingResult
+	BuildKongConfig(ctx context.Context) translator.KongConfigBuildingResult
 	UpdateCache(store.CacheStores)
 	CustomEntityTypes() []string
 }
@@ -74,8 +81,9 @@ type KongConfigBuilder interface {
 // FallbackConfigGenerator generates a fallback configuration based on a cache snapshot and a set of broken objects.
 type FallbackConfigGenerator interface {
 	GenerateExcludingBrokenObjects(
-		store.CacheStores,
-		[]fallback.ObjectHash,
+		currentCache store.CacheStores,
+		lastValidCache *store.CacheStores,
+		brokenObjects []fallback.ObjectHash,
 	) (store.CacheStores, fallback.GeneratedCacheMetadata, error)
 	GenerateBackfillingBrokenObjects(
 		currentCache store.CacheStores,
@@ -137,6 +145,14 @@ type KongClient struct {
 	// is actively configured (e.g. to know how to set the object status).
 	kubernetesObjectReportsFilter k8sobj.ConfigurationStatusSet
 
+	// kongConsumersStatus holds the credentials, consumer groups and plugins of KongConsumers
+	// included in the most recent Update(), reported in their status.
+	kongConsumersStatus map[k8stypes.NamespacedName]kongv1.KongConsumerStatus
+
+	// kongConsumerGroupsStatus holds the member consumers count and the plugins of KongConsumerGroups
+	// included in the most recent Update(), reported in their status.
+	kongConsumerGroupsStatus map[k8stypes.NamespacedName]kongv1beta1.KongConsumerGroupStatus
+
 	// eventRecorder is used to record warning events for resource failures.
 	eventRecorder record.EventRecorder
 
@@ -168,17 +184,37 @@ type KongClient struct {
 	// fallbackConfigGenerator is used to generate a fallback configuration in case of sync failures.
 	fallbackConfigGenerator FallbackConfigGenerator
 
+	// cacheGraphProvider is used to build dependency graphs of cache snapshots shipped to the diagnostics server.
+	cacheGraphProvider fallback.CacheGraphProvider
+
 	// lastProcessedSnapshotHash stores the hash of the last processed Kubernetes objects cache snapshot. It's used to determine configuration
 	// changes. Please note it is always empty when the `FallbackConfiguration` feature gate is turned off.
 	lastProcessedSnapshotHash store.SnapshotHash
 
 	// lastValidCacheSnapshot stores the state of the cache that was last successfully synced with the gateways.
-	// Please note it is only populated when the `FallbackConfiguration` feature gate is turned on and the
-	// `--use-last-valid-config-for-fallback` flag is set.
+	// Please note it is only populated when the `FallbackConfiguration` feature gate is turned on.
 	// lastValidCacheSnapshot and lastProcessedSnapshotHash do not always keep values related to the same cache snapshot.
 	// While lastProcessedSnapshotHash keeps track of the last processed cache snapshot (the one kept in KongClient.cache),
 	// lastValidCacheSnapshot can also represent the fallback cache snapshot that was successfully synced with gateways.
 	lastValidCacheSnapshot *store.CacheStores
+
+	// configChanges tracks changes of the Kubernetes objects cache that haven't been pushed to the gateways yet.
+	configChanges *configChangesTracker
+
+	// stagedRolloutHealthChecker checks health of canary gateways during staged rollouts.
+	// It's used only when staged rollouts are enabled in kongConfig.
+	stagedRolloutHealthChecker StagedRolloutHealthChecker
+
+	// stagedRolloutLock protects stagedRolloutInProgress and rolledBackConfigSHA which are accessed
+	// by staged rollouts running in the background, without the lock held.
+	stagedRolloutLock sync.Mutex
+
+	// stagedRolloutInProgress is the staged rollout whose canaries are being soaked, nil if there's none.
+	stagedRolloutInProgress *stagedRollout
+
+	// rolledBackConfigSHA is the SHA of the configuration the most recent staged rollout was rolled back for.
+	// It's not pushed to the canaries again until the configuration changes.
+	rolledBackConfigSHA string
 }
 
 // NewKongClient provides a new KongClient object after connecting to the
@@ -214,6 +250,12 @@ func NewKongClient(
 		kongConfigBuilder:       kongConfigBuilder,
 		kongConfigFetcher:       kongConfigFetcher,
 		fallbackConfigGenerator: fallbackConfigGenerator,
+		cacheGraphProvider:      fallback.NewDefaultCacheGraphProvider(),
+		configChanges:           newConfigChangesTracker(),
+		stagedRolloutHealthChecker: NewDefaultStagedRolloutHealthChecker(
+			kongConfig.StagedRollout.HealthCheckURLs,
+			timeout,
+		),
 	}
 	c.initializeControllerPodReference()
 
@@ -237,9 +279,17 @@ func (c *KongClient) initializeControllerPodReference() {
 // It will be asynchronously converted into the upstream Kong DSL and applied to the Kong Admin API.
 // A status will later be added to the object whether the configuration update succeeds or fails.
 func (c *KongClient) UpdateObject(obj client.Object) error {
+	changed := c.isObjectChanged(obj)
+
 	// we do a deep copy of the object here so that the caller can continue to use
 	// the original object in a threadsafe manner.
-	return c.cache.Add(obj.DeepCopyObject())
+	if err := c.cache.Add(obj.DeepCopyObject()); err != nil {
+		return err
+	}
+	if changed {
+		c.configChanges.notify()
+	}
+	return nil
 }
 
 // DeleteObject accepts a Kubernetes controller-runtime client.Object and removes it from the configuration cache.
@@ -249,7 +299,37 @@ func (c *KongClient) UpdateObject(obj client.Object) error {
 // under the hood the cache implementation will ignore deletions on objects
 // that are not present in the cache, so in those cases this is a no-op.
 func (c *KongClient) DeleteObject(obj client.Object) error {
-	return c.cache.Delete(obj)
+	_, exists, getErr := c.cache.Get(obj)
+	if err := c.cache.Delete(obj); err != nil {
+		return err
+	}
+	// If it couldn't be determined whether the object was cached, assume it was.
+	if getErr != nil || exists {
+		c.configChanges.notify()
+	}
+	return nil
+}
+
+// ConfigChanges returns a channel receiving a notification whenever an object is added to, updated in
+// or removed from the configuration cache.
+func (c *KongClient) ConfigChanges() <-chan struct{} {
+	return c.configChanges.changes
+}
+
+// isObjectChanged returns true if the object is not in the configuration cache yet or its cached version
+// differs from the provided one. Reconcilers update objects in the cache also when nothing changed
+// (e.g. on periodic resyncs) which shouldn't be considered a configuration change.
+func (c *KongClient) isObjectChanged(obj client.Object) bool {
+	cached, exists, err := c.cache.Get(obj)
+	if err != nil || !exists {
+		return true
+	}
+	cachedObj, ok := cached.(client.Object)
+	if !ok {
+		return true
+	}
+	resourceVersion := obj.GetResourceVersion()
+	return resourceVersion == "" || resourceVersion != cachedObj.GetResourceVersion()
 }
 
 // ObjectExists indicates whether or not any version of the provided object is already present in the proxy.
@@ -393,7 +473,8 @@ func (c *KongClient) AreKubernetesObjectReportsEnabled() bool {
 func (c *KongClient) KubernetesObjectIsConfigured(obj client.Object) bool {
 	c.kubernetesObjectReportLock.RLock()
 	defer c.kubernetesObjectReportLock.RUnlock()
-	return c.kubernetesObjectReportsFilter.Get(obj) == k8sobj.ConfigurationStatusSucceeded
+	status := c.kubernetesObjectReportsFilter.Get(obj)
+	return status == k8sobj.ConfigurationStatusSucceeded || status == k8sobj.ConfigurationStatusConflicted
 }
 
 // KubernetesObjectConfigurationStatus reports the status of applying provided object's
@@ -404,6 +485,24 @@ func (c *KongClient) KubernetesObjectConfigurationStatus(obj client.Object) k8so
 	return c.kubernetesObjectReportsFilter.Get(obj)
 }
 
+// KongConsumerStatus returns the status fields (other than conditions) of the provided KongConsumer
+// computed in the most recent Update(). It returns false if the KongConsumer wasn't included in it.
+func (c *KongClient) KongConsumerStatus(obj *kongv1.KongConsumer) (kongv1.KongConsumerStatus, bool) {
+	c.kubernetesObjectReportLock.RLock()
+	defer c.kubernetesObjectReportLock.RUnlock()
+	status, ok := c.kongConsumersStatus[client.ObjectKeyFromObject(obj)]
+	return status, ok
+}
+
+// KongConsumerGroupStatus returns the status fields (other than conditions) of the provided KongConsumerGroup
+// computed in the most recent Update(). It returns false if the KongConsumerGroup wasn't included in it.
+func (c *KongClient) KongConsumerGroupStatus(obj *kongv1beta1.KongConsumerGroup) (kongv1beta1.KongConsumerGroupStatus, bool) {
+	c.kubernetesObjectReportLock.RLock()
+	defer c.kubernetesObjectReportLock.RUnlock()
+	status, ok := c.kongConsumerGroupsStatus[client.ObjectKeyFromObject(obj)]
+	return status, ok
+}
+
 // -----------------------------------------------------------------------------
 // Dataplane Client - Kong - Interface Implementation
 // -----------------------------------------------------------------------------
@@ -422,6 +521,32 @@ func (c *KongClient) Update(ctx context.Context) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
+	// Take the pending changes before building the configuration, so that changes happening in the meantime
+	// are measured from their own time.
+	changedAt, hasPendingChanges := c.configChanges.takePending()
+	ctx, span := tracing.StartSpan(ctx, "KongClient.Update")
+	err := c.update(ctx)
+	tracing.EndSpan(span, err)
+	if err != nil {
+		if hasPendingChanges {
+			c.configChanges.restorePending(changedAt)
+		}
+		// The configuration is being rolled out in the background. It will be recorded as applied by
+		// one of the following updates, once the rollout completes.
+		if errors.Is(err, errStagedRolloutInProgress) {
+			return nil
+		}
+		return err
+	}
+	if hasPendingChanges {
+		c.prometheusMetrics.RecordConfigSyncLatency(time.Since(changedAt))
+	}
+	return nil
+}
+
+// update parses the Kubernetes objects cache into Kong configuration and sends it to the data-plane.
+// It must be called with the lock held.
+func (c *KongClient) update(ctx context.Context) error {
 	// If Kong is running in dbless mode, we can fetch and store the last good configuration.
 	if c.dbmode.IsDBLessMode() {
 		// Fetch the last valid configuration from the proxy only in case there is no valid
@@ -445,7 +570,9 @@ func (c *KongClient) Update(ctx context.Context) error {
 		var err error
 		// Empty snapshot hash means that the cache hasn't changed since the last snapshot was taken. That optimization can be used
 		// in main code path to avoid unnecessary processing. TODO: https://github.com/Kong/kubernetes-ingress-controller/issues/6095
+		_, snapshotSpan := tracing.StartSpan(ctx, "CacheStores.TakeSnapshotIfChanged")
 		cacheSnapshot, newSnapshotHash, err = c.cache.TakeSnapshotIfChanged(c.lastProcessedSnapshotHash)
+		tracing.EndSpan(snapshotSpan, err)
 		if err != nil {
 			return fmt.Errorf("failed to take snapshot of cache: %w", err)
 		}
@@ -459,6 +586,7 @@ func (c *KongClient) Update(ctx context.Context) error {
 			c.logger.V(logging.DebugLevel).Info("New configuration snapshot detected", "hash", newSnapshotHash)
 			c.lastProcessedSnapshotHash = newSnapshotHash
 			c.kongConfigBuilder.UpdateCache(cacheSnapshot)
+			c.maybeSendConfigGraphDiagnostics(ctx, cacheSnapshot)
 		}
 
 		if allGatewaysAreInSync := lo.EveryBy(c.clientsProvider.GatewayClientsToConfigure(), func(cl *adminapi.Client) bool {
@@ -470,7 +598,7 @@ func (c *KongClient) Update(ctx context.Context) error {
 	}
 
 	c.logger.V(logging.DebugLevel).Info("Parsing kubernetes objects into data-plane configuration")
-	parsingResult := c.kongConfigBuilder.BuildKongConfig()
+	parsingResult := c.kongConfigBuilder.BuildKongConfig(ctx)
 	if failuresCount := len(parsingResult.TranslationFailures); failuresCount > 0 {
 		c.prometheusMetrics.RecordTranslationFailure()
 		c.prometheusMetrics.RecordTranslationBrokenResources(failuresCount)
@@ -481,10 +609,16 @@ func (c *KongClient) Update(ctx context.Context) error {
 		c.prometheusMetrics.RecordTranslationBrokenResources(0)
 		c.logger.V(logging.DebugLevel).Info("Successfully built data-plane configuration")
 	}
+	c.recordRouteConflictEvents(parsingResult.RouteConflicts)
+	c.maybeSendRouteConflictsDiagnostics(ctx, parsingResult.RouteConflicts)
 
 	const isFallback = false
 	shas, gatewaysSyncErr := c.sendOutToGatewayClients(ctx, parsingResult.KongState, c.kongConfig, isFallback)
 	konnectSyncErr := c.maybeSendOutToKonnectClient(ctx, parsingResult.KongState, c.kongConfig, isFallback)
+	if errors.Is(gatewaysSyncErr, errStagedRolloutInProgress) {
+		c.logger.V(logging.DebugLevel).Info("Configuration is being rolled out to canaries, skipping status updates until it's completed")
+		return gatewaysSyncErr
+	}
 
 	// Taking into account the results of syncing configuration with Gateways and Konnect, and potential translation
 	// failures, calculate the config status and update it.
@@ -520,7 +654,14 @@ func (c *KongClient) Update(ctx context.Context) error {
 		if !slices.Equal(shas, c.SHAs) {
 			c.logger.V(logging.DebugLevel).Info("Triggering report for configured Kubernetes objects", "count",
 				len(parsingResult.ConfiguredKubernetesObjects))
-			c.triggerKubernetesObjectReport(parsingResult.ConfiguredKubernetesObjects, parsingResult.TranslationFailures)
+			c.updateKongConsumersStatus(parsingResult.KongState)
+			c.updateKongConsumerGroupsStatus(parsingResult.KongState)
+			c.triggerKubernetesObjectReport(
+				parsingResult.ConfiguredKubernetesObjects,
+				parsingResult.TranslationFailures,
+				parsingResult.RouteConflicts,
+				parsingResult.HostnamePolicyViolations,
+			)
 		} else {
 			c.logger.V(logging.DebugLevel).Info("No configuration change; resource status update not necessary, skipping")
 		}
@@ -529,9 +670,10 @@ func (c *KongClient) Update(ctx context.Context) error {
 }
 
 // maybePreserveTheLastValidConfigCache preserves the last valid configuration cache if the `FallbackConfiguration`
-// feature gate is enabled and the `--enable-last-valid-config-fallback` flag is set.
+// feature gate is enabled. It's used for backfilling broken objects either globally (when the
+// `--use-last-valid-config-for-fallback` flag is set) or per object (with the backfill fallback policy).
 func (c *KongClient) maybePreserveTheLastValidConfigCache(lastValidCache store.CacheStores) {
-	if c.kongConfig.FallbackConfiguration && c.kongConfig.UseLastValidConfigForFallback {
+	if c.kongConfig.FallbackConfiguration {
 		c.logger.V(logging.DebugLevel).Info("Preserving the last valid configuration cache")
 		c.lastValidCacheSnapshot = &lastValidCache
 	}
@@ -570,9 +712,17 @@ func (c *KongClient) maybeTryRecoveringFromGatewaysSyncError(
 			c.logger.Info("Successfully recovered from configuration rejection with fallback configuration")
 			return nil
 		}
-		// If we failed to recover using the fallback configuration, we should log the error and carry on with the last
-		// valid configuration.
-		c.logger.Error(recoveringErr, "Failed to recover from configuration rejection with fallback configuration")
+		// If an affected object doesn't allow generating the fallback configuration or we failed to recover using it,
+		// we should log it and carry on with the last valid configuration.
+		if policyFailErr := (fallback.PolicyFailError{}); errors.As(recoveringErr, &policyFailErr) {
+			c.logger.Info("Not recovering from configuration rejection with fallback configuration due to fallback policy of an affected object",
+				"object", policyFailErr.Object.String(),
+				"causing_object", policyFailErr.CausingObject.String(),
+				"policy", fallback.PolicyFail,
+			)
+		} else {
+			c.logger.Error(recoveringErr, "Failed to recover from configuration rejection with fallback configuration")
+		}
 	}
 
 	// If FallbackConfiguration is disabled, we skipped or failed to recover using the fallback configuration, we should
@@ -595,7 +745,15 @@ func (c *KongClient) tryRecoveringWithFallbackConfiguration(
 	brokenObjects []fallback.ObjectHash,
 ) error {
 	// Generate a fallback cache snapshot.
-	fallbackCache, generatedCacheMetadata, err := c.generateFallbackCache(currentCache, brokenObjects)
+	fallbackCache, generatedCacheMetadata, err := c.generateFallbackCache(ctx, currentCache, brokenObjects)
+	if errors.As(err, &fallback.PolicyFailError{}) {
+		// Objects that prevented generating the fallback configuration are reported the same way as the handled ones.
+		c.logFallbackCacheMetadata(generatedCacheMetadata)
+		if diagnosticsErr := c.maybeSendFallbackConfigDiagnostics(ctx, generatedCacheMetadata); diagnosticsErr != nil {
+			c.logger.Error(diagnosticsErr, "Failed to send fallback configuration diagnostics")
+		}
+		return err
+	}
 	if err != nil {
 		return fmt.Errorf("failed to generate fallback configuration: %w", err)
 	}
@@ -606,7 +764,7 @@ func (c *KongClient) tryRecoveringWithFallbackConfiguration(
 
 	// Update the KongConfigBuilder with the fallback configuration and build the KongConfig.
 	c.kongConfigBuilder.UpdateCache(fallbackCache)
-	fallbackParsingResult := c.kongConfigBuilder.BuildKongConfig()
+	fallbackParsingResult := c.kongConfigBuilder.BuildKongConfig(ctx)
 
 	if failuresCount := len(fallbackParsingResult.TranslationFailures); failuresCount > 0 {
 		c.recordResourceFailureEvents(fallbackParsingResult.TranslationFailures, FallbackKongConfigurationTranslationFailedEventReason)
@@ -636,13 +794,18 @@ func (c *KongClient) tryRecoveringWithFallbackConfiguration(
 
 // generateFallbackCache generates a fallback configuration based on the current cache and a set of broken objects.
 // It will either exclude the broken objects from the cache or backfill them from the last valid cache snapshot
-// depending on the UseLastValidConfigForFallback flag.
+// depending on the UseLastValidConfigForFallback flag, unless the affected objects override it with their fallback policy.
 func (c *KongClient) generateFallbackCache(
+	ctx context.Context,
 	currentCache store.CacheStores,
 	brokenObjects []fallback.ObjectHash,
 ) (s store.CacheStores, metadata fallback.GeneratedCacheMetadata, err error) {
 	start := time.Now()
+	_, span := tracing.StartSpan(ctx, "KongClient.generateFallbackCache",
+		tracing.AttributeBrokenObjects.Int(len(brokenObjects)),
+	)
 	defer func() {
+		tracing.EndSpan(span, err)
 		c.prometheusMetrics.RecordFallbackCacheGenerationDuration(time.Since(start), err)
 	}()
 	if c.kongConfig.UseLastValidConfigForFallback {
@@ -654,6 +817,7 @@ func (c *KongClient) generateFallbackCache(
 	}
 	return c.fallbackConfigGenerator.GenerateExcludingBrokenObjects(
 		currentCache,
+		c.lastValidCacheSnapshot,
 		brokenObjects,
 	)
 }
@@ -691,9 +855,19 @@ func (c *KongClient) sendOutToGatewayClients(
 	configureGatewayClientURLs := lo.Map(gatewayClientsToConfigure, func(cl *adminapi.Client, _ int) string { return cl.BaseRootURL() })
 	c.logger.V(logging.DebugLevel).Info("Sending configuration to gateway clients", "urls", configureGatewayClientURLs)
 
-	shas, err := iter.MapErr(gatewayClientsToConfigure, func(client **adminapi.Client) (string, error) {
-		return c.sendToClient(ctx, *client, s, config, isFallback)
-	})
+	var (
+		shas []string
+		err  error
+	)
+	if c.shouldPerformStagedRollout(gatewayClientsToConfigure, isFallback) {
+		shas, err = c.sendOutToGatewayClientsInStages(ctx, gatewayClientsToConfigure, s, config)
+	} else {
+		// The configuration pushed to all the gateways supersedes the one being rolled out in stages.
+		c.stopStagedRollout()
+		shas, err = iter.MapErr(gatewayClientsToConfigure, func(client **adminapi.Client) (string, error) {
+			return c.sendToClient(ctx, *client, s, config, isFallback)
+		})
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -740,8 +914,11 @@ func (c *KongClient) maybeSendOutToKonnectClient(
 
 	// In case users have many consumers, konnect sync can be very slow and cause dataplane sync issues.
 	// For this reason, if the --disable-consumers-sync flag is set, we do not send consumers to Konnect.
+	// The state is copied as it may still be pushed to gateways by a staged rollout running in the background.
 	if konnectClient.ConsumersSyncDisabled() {
-		s.Consumers = nil
+		withoutConsumers := *s
+		withoutConsumers.Consumers = nil
+		s = &withoutConsumers
 	}
 
 	if _, err := c.sendToClient(ctx, konnectClient, s, config, isFallback); err != nil {
@@ -782,6 +959,43 @@ func logKonnectErrors(logger logr.Logger, err error) {
 	}
 }
 
+// kongStateEntityCounts counts Kong entities of each type in the KongState.
+func kongStateEntityCounts(s *kongstate.KongState) metrics.ConfigEntityCounts {
+	counts := metrics.ConfigEntityCounts{
+		Services:             len(s.Services),
+		Upstreams:            len(s.Upstreams),
+		Consumers:            len(s.Consumers),
+		ConsumerGroups:       len(s.ConsumerGroups),
+		Certificates:         len(s.Certificates),
+		PluginsByName:        make(map[string]int),
+		CustomEntitiesByType: make(map[string]int),
+	}
+	countPlugins := func(plugins []kong.Plugin) {
+		for _, p := range plugins {
+			counts.PluginsByName[lo.FromPtr(p.Name)]++
+		}
+	}
+	for _, svc := range s.Services {
+		counts.Routes += len(svc.Routes)
+		countPlugins(svc.Plugins)
+		for _, route := range svc.Routes {
+			countPlugins(route.Plugins)
+		}
+	}
+	for _, upstream := range s.Upstreams {
+		counts.Targets += len(upstream.Targets)
+	}
+	for _, consumer := range s.Consumers {
+		countPlugins(consumer.Plugins)
+	}
+	// Plugins scoped to consumer groups are kept along with the global ones.
+	countPlugins(lo.Map(s.Plugins, func(p kongstate.Plugin, _ int) kong.Plugin { return p.Plugin }))
+	for entityType, collection := range s.CustomEntities {
+		counts.CustomEntitiesByType[entityType] = len(collection.Entities)
+	}
+	return counts
+}
+
 func (c *KongClient) sendToClient(
 	ctx context.Context,
 	client sendconfig.AdminAPIClient,
@@ -789,32 +1003,43 @@ func (c *KongClient) sendToClient(
 	config sendconfig.Config,
 	isFallback bool,
 ) (string, error) {
+	return c.sendToClientWithCacheStoresHash(ctx, client, s, c.lastProcessedSnapshotHash, config, isFallback)
+}
+
+// sendToClientWithCacheStoresHash sends the configuration to the client and marks it as built from the cache snapshot
+// with the given hash. It doesn't access fields guarded by the lock, so it can be used without it being held.
+func (c *KongClient) sendToClientWithCacheStoresHash(
+	ctx context.Context,
+	client sendconfig.AdminAPIClient,
+	s *kongstate.KongState,
+	cacheStoresHash store.SnapshotHash,
+	config sendconfig.Config,
+	isFallback bool,
+) (sha string, err error) {
 	logger := c.logger.WithValues("url", client.AdminAPIClient().BaseRootURL())
+	ctx, span := tracing.StartSpan(ctx, "KongClient.sendToClient",
+		tracing.AttributeClientURL.String(client.BaseRootURL()),
+		tracing.AttributeFallback.Bool(isFallback),
+	)
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
 
 	// If the client is Konnect and the feature flag is turned on,
 	// we should sanitize the configuration before sending it out.
 	if client.IsKonnect() && config.SanitizeKonnectConfigDumps {
 		s = s.SanitizedCopy(util.DefaultUUIDGenerator{})
 	}
-	deckGenParams := deckgen.GenerateDeckContentParams{
-		SelectorTags:                    config.FilterTags,
-		ExpressionRoutes:                config.ExpressionRoutes,
-		PluginSchemas:                   client.PluginSchemaStore(),
-		AppendStubEntityWhenConfigEmpty: !client.IsKonnect() && config.InMemory,
-	}
-	targetContent := deckgen.ToDeckContent(ctx, logger, s, deckGenParams)
-	customEntities := make(sendconfig.CustomEntitiesByType)
-	for entityType, collection := range s.CustomEntities {
-		for _, entity := range collection.Entities {
-			customEntities[entityType] = append(customEntities[entityType], entity.Object)
-		}
-	}
+	deckGenParams, targetContent, customEntities := generateTargetContent(ctx, logger, client, s, config)
 
 	sendDiagnostic := prepareSendDiagnosticFn(ctx, logger, c.diagnostic, s, targetContent, deckGenParams, isFallback)
 
 	// apply the configuration update in Kong
 	timedCtx, cancel := context.WithTimeout(ctx, c.requestTimeout)
 	defer cancel()
+	timedCtx, updateSpan := tracing.StartSpan(timedCtx, "sendconfig.PerformUpdate",
+		tracing.AttributeClientURL.String(client.BaseRootURL()),
+	)
 	newConfigSHA, err := sendconfig.PerformUpdate(
 		timedCtx,
 		logger,
@@ -827,6 +1052,8 @@ func (c *KongClient) sendToClient(
 		c.configChangeDetector,
 		isFallback,
 	)
+	updateSpan.SetAttributes(tracing.AttributeConfigHash.String(string(newConfigSHA)))
+	tracing.EndSpan(updateSpan, err)
 	// Only record events on applying configuration to Kong gateway here.
 	// Nil error is expected to be passed to indicate success.
 	if !client.IsKonnect() {
@@ -856,12 +1083,95 @@ func (c *KongClient) sendToClient(
 		return "", fmt.Errorf("performing update for %s failed: %w", client.BaseRootURL(), err)
 	}
 	sendDiagnostic(diagnostics.DumpMeta{Failed: false, Hash: string(newConfigSHA)}, nil) // No error occurred.
+	// Entity counts describe the configuration the gateway runs, so they're recorded only once it's been accepted.
+	c.prometheusMetrics.RecordConfigEntityCounts(client.BaseRootURL(), isFallback, kongStateEntityCounts(s))
 	// update the lastConfigSHA with the new updated checksum
 	client.SetLastConfigSHA(newConfigSHA)
-	client.SetLastCacheStoresHash(c.lastProcessedSnapshotHash)
+	client.SetLastCacheStoresHash(cacheStoresHash)
 	return string(newConfigSHA), nil
 }
 
+// generateTargetContent generates the declarative configuration to be sent to the client from the KongState.
+func generateTargetContent(
+	ctx context.Context,
+	logger logr.Logger,
+	client sendconfig.AdminAPIClient,
+	s *kongstate.KongState,
+	config sendconfig.Config,
+) (deckgen.GenerateDeckContentParams, *file.Content, sendconfig.CustomEntitiesByType) {
+	deckGenParams := deckgen.GenerateDeckContentParams{
+		SelectorTags:                    config.FilterTags,
+		ExpressionRoutes:                config.ExpressionRoutes,
+		PluginSchemas:                   client.PluginSchemaStore(),
+		AppendStubEntityWhenConfigEmpty: !client.IsKonnect() && config.InMemory,
+	}
+	_, deckGenSpan := tracing.StartSpan(ctx, "deckgen.ToDeckContent")
+	targetContent := deckgen.ToDeckContent(ctx, logger, s, deckGenParams)
+	deckGenSpan.End()
+	customEntities := make(sendconfig.CustomEntitiesByType)
+	for entityType, collection := range s.CustomEntities {
+		for _, entity := range collection.Entities {
+			customEntities[entityType] = append(customEntities[entityType], entity.Object)
+		}
+	}
+	return deckGenParams, targetContent, customEntities
+}
+
+// maybeSendConfigGraphDiagnostics builds the dependency graph of objects in the cache snapshot and ships it to
+// the diagnostics server if it's enabled.
+func (c *KongClient) maybeSendConfigGraphDiagnostics(ctx context.Context, cacheSnapshot store.CacheStores) {
+	ch := c.diagnostic.ConfigGraphs
+	if ch == nil {
+		return
+	}
+	graph, err := c.cacheGraphProvider.CacheToGraph(cacheSnapshot)
+	if err != nil {
+		c.logger.Error(err, "Failed to build configuration graph for diagnostics")
+		return
+	}
+	select {
+	case ch <- graph:
+		c.logger.V(logging.DebugLevel).Info("Shipping configuration graph to diagnostics server")
+	case <-ctx.Done():
+	default:
+		c.logger.Error(nil, "Configuration graph buffer full, dropping diagnostics")
+	}
+}
+
+// maybeSendRouteConflictsDiagnostics ships the route conflicts found in the last translation to the diagnostics
+// server if it's enabled.
+func (c *KongClient) maybeSendRouteConflictsDiagnostics(ctx context.Context, routeConflicts []translator.RouteConflict) {
+	ch := c.diagnostic.RouteConflicts
+	if ch == nil {
+		return
+	}
+	conflicts := lo.Map(routeConflicts, func(conflict translator.RouteConflict, _ int) diagnostics.RouteConflict {
+		return diagnostics.RouteConflict{
+			Type: string(conflict.Type),
+			Objects: lo.Map(conflict.Objects[:], func(obj client.Object, i int) diagnostics.RouteConflictObject {
+				gvk := obj.GetObjectKind().GroupVersionKind()
+				return diagnostics.RouteConflictObject{
+					Group:     gvk.Group,
+					Kind:      gvk.Kind,
+					Namespace: obj.GetNamespace(),
+					Name:      obj.GetName(),
+					Route:     conflict.Routes[i],
+					Shadowing: conflict.Type == translator.RouteConflictTypeShadowed && conflict.Shadowing == i,
+				}
+			}),
+			Matches:  conflict.Matches,
+			Rejected: conflict.Rejected,
+		}
+	})
+	select {
+	case ch <- conflicts:
+		c.logger.V(logging.DebugLevel).Info("Shipping route conflicts to diagnostics server")
+	case <-ctx.Done():
+	default:
+		c.logger.Error(nil, "Route conflicts buffer full, dropping diagnostics")
+	}
+}
+
 // SetConfigStatusNotifier sets a notifier which notifies subscribers about configuration sending results.
 // Currently it is used for uploading the node status to konnect control plane.
 func (c *KongClient) SetConfigStatusNotifier(n clients.ConfigStatusNotifier) {
@@ -932,7 +1242,12 @@ func prepareSendDiagnosticFn(
 // enables filtering for which objects are currently applied to the data-plane,
 // as well as updating the c.kubernetesObjectStatusQueue to queue those objects
 // for reconciliation so their statuses can be properly updated.
-func (c *KongClient) triggerKubernetesObjectReport(reportedObjects []client.Object, translationFailures []failures.ResourceFailure) {
+func (c *KongClient) triggerKubernetesObjectReport(
+	reportedObjects []client.Object,
+	translationFailures []failures.ResourceFailure,
+	routeConflicts []translator.RouteConflict,
+	hostnamePolicyViolations []client.Object,
+) {
 	// first a new set of the included objects for the most recent configuration
 	// needs to be generated.
 	set := k8sobj.ConfigurationStatusSet{}
@@ -949,6 +1264,23 @@ func (c *KongClient) triggerKubernetesObjectReport(reportedObjects []client.Obje
 		}
 	}
 
+	// objects with routes conflicting with routes of other objects are reported as configured, but conflicted,
+	// unless the conflicting routes were rejected (that is reported as a translation failure of the newer object).
+	for _, conflict := range routeConflicts {
+		if conflict.Rejected {
+			continue
+		}
+		for _, obj := range conflict.Objects {
+			set.MarkConflicted(obj)
+		}
+	}
+
+	// objects with hostnames rejected by KongHostnamePolicies have translation failures, the reason is reported
+	// so it can be reflected in their statuses.
+	for _, obj := range hostnamePolicyViolations {
+		set.MarkHostnameNotAllowed(obj)
+	}
+
 	c.updateKubernetesObjectReportFilter(set)
 
 	// after the filter has been updated we signal the status queue so that the
@@ -979,6 +1311,41 @@ func (c *KongClient) updateKubernetesObjectReportFilter(set k8sobj.Configuration
 	c.kubernetesObjectReportsFilter = set
 }
 
+// updateKongConsumersStatus overrides the internal KongConsumers' status fields with
+// the ones computed for the consumers of the provided Kong state.
+func (c *KongClient) updateKongConsumersStatus(ks *kongstate.KongState) {
+	statuses := make(map[k8stypes.NamespacedName]kongv1.KongConsumerStatus, len(ks.Consumers))
+	for _, consumer := range ks.Consumers {
+		statuses[client.ObjectKeyFromObject(&consumer.K8sKongConsumer)] = kongv1.KongConsumerStatus{
+			Credentials: consumer.Credentials,
+			ConsumerGroups: lo.FilterMap(consumer.ConsumerGroups, func(cg kong.ConsumerGroup, _ int) (string, bool) {
+				return lo.FromPtr(cg.Name), cg.Name != nil
+			}),
+			Plugins: consumer.PluginsStatus,
+		}
+	}
+
+	c.kubernetesObjectReportLock.Lock()
+	defer c.kubernetesObjectReportLock.Unlock()
+	c.kongConsumersStatus = statuses
+}
+
+// updateKongConsumerGroupsStatus overrides the internal KongConsumerGroups' status fields with
+// the ones computed for the consumer groups of the provided Kong state.
+func (c *KongClient) updateKongConsumerGroupsStatus(ks *kongstate.KongState) {
+	statuses := make(map[k8stypes.NamespacedName]kongv1beta1.KongConsumerGroupStatus, len(ks.ConsumerGroups))
+	for _, cg := range ks.ConsumerGroups {
+		statuses[client.ObjectKeyFromObject(&cg.K8sKongConsumerGroup)] = kongv1beta1.KongConsumerGroupStatus{
+			Consumers: int32(cg.ConsumersCount),
+			Plugins:   cg.Plugins,
+		}
+	}
+
+	c.kubernetesObjectReportLock.Lock()
+	defer c.kubernetesObjectReportLock.Unlock()
+	c.kongConsumerGroupsStatus = statuses
+}
+
 // recordResourceFailureEvents records warning Events for each causing object in each input resource failure, with the
 // provided reason.
 func (c *KongClient) recordResourceFailureEvents(resourceFailures []failures.ResourceFailure, reason string) {
@@ -1000,6 +1367,22 @@ func (c *KongClient) recordResourceFailureEvents(resourceFailures []failures.Res
 	}
 }
 
+// recordRouteConflictEvents records warning Events for both objects of each route conflict.
+func (c *KongClient) recordRouteConflictEvents(routeConflicts []translator.RouteConflict) {
+	for _, conflict := range routeConflicts {
+		for _, obj := range conflict.Objects {
+			c.logger.V(logging.DebugLevel).Info(
+				"Recording a Warning event for object with conflicting routes",
+				"name", obj.GetName(),
+				"namespace", obj.GetNamespace(),
+				"kind", obj.GetObjectKind().GroupVersionKind().Kind,
+				"message", conflict.Message(),
+			)
+			c.eventRecorder.Event(obj, corev1.EventTypeWarning, KongRouteConflictEventReason, conflict.Message())
+		}
+	}
+}
+
 // recordApplyConfigurationEvents records event attached to KIC pod after KIC applied Kong configuration.
 func (c *KongClient) recordApplyConfigurationEvents(err error, rootURL string, isFallback bool) {
 	podNN, ok := c.controllerPodReference.Get()
@@ -1039,37 +1422,30 @@ func (c *KongClient) recordApplyConfigurationEvents(err error, rootURL string, i
 
 func (c *KongClient) logFallbackCacheMetadata(metadata fallback.GeneratedCacheMetadata) {
 	log := c.logger.WithName("fallback-cache-generator")
-
-	// Log excluded objects.
-	for _, excluded := range metadata.ExcludedObjects {
-		gvk := excluded.Object.GetObjectKind().GroupVersionKind()
-		obj := excluded.Object
-		causingObjects := lo.Map(excluded.CausingObjects, func(causing fallback.ObjectHash, _ int) string {
+	logAffected := func(msg string, affected fallback.AffectedCacheObjectMetadata) {
+		gvk := affected.Object.GetObjectKind().GroupVersionKind()
+		obj := affected.Object
+		causingObjects := lo.Map(affected.CausingObjects, func(causing fallback.ObjectHash, _ int) string {
 			return causing.String()
 		})
-		log.V(logging.DebugLevel).Info("Excluded object from fallback cache",
+		log.V(logging.DebugLevel).Info(msg,
 			"kind", gvk.Kind,
 			"group", gvk.Group,
 			"namespace", obj.GetNamespace(),
 			"name", obj.GetName(),
 			"causing_objects", strings.Join(causingObjects, ","),
+			"policy", affected.Policy,
 		)
 	}
 
-	// Log backfilled objects.
+	for _, excluded := range metadata.ExcludedObjects {
+		logAffected("Excluded object from fallback cache", excluded)
+	}
 	for _, backfilled := range metadata.BackfilledObjects {
-		gvk := backfilled.Object.GetObjectKind().GroupVersionKind()
-		obj := backfilled.Object
-		causingObjects := lo.Map(backfilled.CausingObjects, func(causing fallback.ObjectHash, _ int) string {
-			return causing.String()
-		})
-		log.V(logging.DebugLevel).Info("Backfilled object in fallback cache",
-			"kind", gvk.Kind,
-			"group", gvk.Group,
-			"namespace", obj.GetNamespace(),
-			"name", obj.GetName(),
-			"causing_objects", strings.Join(causingObjects, ","),
-		)
+		logAffected("Backfilled object in fallback cache", backfilled)
+	}
+	for _, failed := range metadata.FailedObjects {
+		logAffected("Object with fail fallback policy prevented generating fallback cache", failed)
 	}
 }
 
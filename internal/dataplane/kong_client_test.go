@@ -17,9 +17,13 @@ import (
 	"github.com/kong/go-database-reconciler/pkg/file"
 	"github.com/kong/go-database-reconciler/pkg/utils"
 	"github.com/kong/go-kong/kong"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
 	netv1 "k8s.io/api/networking/v1"
@@ -44,6 +48,7 @@ import (
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/diagnostics"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/metrics"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/tracing"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/versions"
 	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
 	"github.com/kong/kubernetes-ingress-controller/v3/test/helpers"
@@ -295,7 +300,7 @@ func (m mockConfigurationChangeDetector) HasConfigurationChanged(
 type mockFallbackConfigGenerator struct {
 	GenerateResult store.CacheStores
 
-	GenerateExcludingBrokenObjectsCalledWith   lo.Tuple2[store.CacheStores, []fallback.ObjectHash]
+	GenerateExcludingBrokenObjectsCalledWith   lo.Tuple3[store.CacheStores, *store.CacheStores, []fallback.ObjectHash]
 	GenerateBackfillingBrokenObjectsCalledWith lo.Tuple3[store.CacheStores, *store.CacheStores, []fallback.ObjectHash]
 }
 
@@ -305,9 +310,10 @@ func newMockFallbackConfigGenerator() *mockFallbackConfigGenerator {
 
 func (m *mockFallbackConfigGenerator) GenerateExcludingBrokenObjects(
 	stores store.CacheStores,
+	lastValidStores *store.CacheStores,
 	hashes []fallback.ObjectHash,
 ) (store.CacheStores, fallback.GeneratedCacheMetadata, error) {
-	m.GenerateExcludingBrokenObjectsCalledWith = lo.T2(stores, hashes)
+	m.GenerateExcludingBrokenObjectsCalledWith = lo.T3(stores, lastValidStores, hashes)
 	return m.GenerateResult, fallback.GeneratedCacheMetadata{}, nil
 }
 
@@ -512,7 +518,7 @@ func newMockKongConfigBuilder() *mockKongConfigBuilder {
 	}
 }
 
-func (p *mockKongConfigBuilder) BuildKongConfig() translator.KongConfigBuildingResult {
+func (p *mockKongConfigBuilder) BuildKongConfig(context.Context) translator.KongConfigBuildingResult {
 	if p.onlyFirstBuildCallWithNoTranslationFailures && !p.buildCalled {
 		p.buildCalled = true
 		return translator.KongConfigBuildingResult{
@@ -940,6 +946,73 @@ func TestKongClient_EmptyConfigUpdate(t *testing.T) {
 	})
 }
 
+func TestKongClient_ConfigChanges(t *testing.T) {
+	var (
+		ctx             = context.Background()
+		gatewayClient   = mustSampleGatewayClient(t)
+		clientsProvider = &mockGatewayClientsProvider{
+			gatewayClients: []*adminapi.Client{gatewayClient},
+		}
+		updateStrategyResolver = newMockUpdateStrategyResolver(t)
+		configChangeDetector   = mockConfigurationChangeDetector{hasConfigurationChanged: true}
+		kongClient             = setupTestKongClient(t, updateStrategyResolver, clientsProvider, configChangeDetector, newMockKongConfigBuilder(), nil, &mockKongLastValidConfigFetcher{})
+	)
+
+	notified := func() bool {
+		select {
+		case <-kongClient.ConfigChanges():
+			return true
+		default:
+			return false
+		}
+	}
+
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "svc",
+			Namespace:       "default",
+			ResourceVersion: "1",
+		},
+	}
+
+	t.Log("adding a new object notifies about a change")
+	require.NoError(t, kongClient.UpdateObject(svc))
+	require.True(t, notified())
+
+	t.Log("updating an object with the same resource version doesn't notify about a change")
+	require.NoError(t, kongClient.UpdateObject(svc))
+	require.False(t, notified())
+
+	t.Log("updating an object with a new resource version notifies about a change")
+	svc = svc.DeepCopy()
+	svc.ResourceVersion = "2"
+	require.NoError(t, kongClient.UpdateObject(svc))
+	require.True(t, notified())
+
+	t.Log("pending changes are restored when the update fails")
+	updateStrategyResolver.returnErrorOnUpdate(gatewayClient.BaseRootURL())
+	require.Error(t, kongClient.Update(ctx))
+	_, hasPendingChanges := kongClient.configChanges.takePending()
+	require.True(t, hasPendingChanges)
+	kongClient.configChanges.notify()
+
+	t.Log("pending changes are cleared after a successful update")
+	updateStrategyResolver = newMockUpdateStrategyResolver(t)
+	kongClient.updateStrategyResolver = updateStrategyResolver
+	require.NoError(t, kongClient.Update(ctx))
+	_, hasPendingChanges = kongClient.configChanges.takePending()
+	require.False(t, hasPendingChanges)
+	notified()
+
+	t.Log("deleting an object notifies about a change")
+	require.NoError(t, kongClient.DeleteObject(svc))
+	require.True(t, notified())
+
+	t.Log("deleting an object which isn't cached doesn't notify about a change")
+	require.NoError(t, kongClient.DeleteObject(svc))
+	require.False(t, notified())
+}
+
 // setupTestKongClient creates a KongClient with mocked dependencies.
 func setupTestKongClient(
 	t *testing.T,
@@ -1308,10 +1381,10 @@ func TestKongClient_FallbackConfiguration_SuccessfulRecovery(t *testing.T) {
 			require.True(t, hasConsumer, "expected consumer to be in the first cache snapshot")
 
 			if tc.expectGenerateExcludingBrokenObjectsCalled {
-				t.Log("Verifying that the fallback config generator was called with the first cache snapshot and the broken object hash")
-				expectedGenerateExcludingBrokenObjectsArgs := lo.T2(firstCacheUpdate, []fallback.ObjectHash{fallback.GetObjectHash(brokenConsumer)})
+				t.Log("Verifying that the fallback config generator was called with the first and last valid cache snapshots and the broken object hash")
+				expectedGenerateExcludingBrokenObjectsArgs := lo.T3(firstCacheUpdate, &lastValidCache, []fallback.ObjectHash{fallback.GetObjectHash(brokenConsumer)})
 				require.Equal(t, expectedGenerateExcludingBrokenObjectsArgs, fallbackConfigGenerator.GenerateExcludingBrokenObjectsCalledWith,
-					"expected fallback config generator to be called with the first cache snapshot and the broken object hash")
+					"expected fallback config generator to be called with the first and last valid cache snapshots and the broken object hash")
 
 				require.Empty(t, fallbackConfigGenerator.GenerateBackfillingBrokenObjectsCalledWith)
 			}
@@ -1613,7 +1686,7 @@ func TestKongClient_LastValidCacheSnapshot(t *testing.T) {
 			name:                                 "FallbackConfiguration=true, UseLastValidConfigForFallback=false",
 			fallbackConfigurationFeatureEnabled:  true,
 			useLastValidConfigForFallbackEnabled: false,
-			expectLastValidCacheSnapshotToBeSet:  false,
+			expectLastValidCacheSnapshotToBeSet:  true,
 		},
 		{
 			name:                                 "FallbackConfiguration=true, UseLastValidConfigForFallback=true",
@@ -1937,3 +2010,150 @@ func someConsumer(t *testing.T, name string) *kongv1.KongConsumer {
 		Username: name,
 	})
 }
+
+func TestKongClientUpdate_Tracing(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	previousProvider := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	t.Cleanup(func() { otel.SetTracerProvider(previousProvider) })
+
+	clientsProvider := &mockGatewayClientsProvider{
+		gatewayClients: []*adminapi.Client{
+			mustSampleGatewayClient(t),
+			mustSampleGatewayClient(t),
+		},
+	}
+	kongClient := setupTestKongClient(
+		t,
+		newMockUpdateStrategyResolver(t),
+		clientsProvider,
+		mockConfigurationChangeDetector{hasConfigurationChanged: true},
+		newMockKongConfigBuilder(),
+		nil,
+		&mockKongLastValidConfigFetcher{},
+	)
+	require.NoError(t, kongClient.Update(context.Background()))
+
+	spans := exporter.GetSpans()
+	spansNamed := func(name string) tracetest.SpanStubs {
+		return lo.Filter(spans, func(s tracetest.SpanStub, _ int) bool { return s.Name == name })
+	}
+
+	updateSpans := spansNamed("KongClient.Update")
+	require.Len(t, updateSpans, 1)
+	updateSpanID := updateSpans[0].SpanContext.SpanID()
+
+	sendSpans := spansNamed("KongClient.sendToClient")
+	require.Len(t, sendSpans, len(clientsProvider.gatewayClients))
+	for _, sendSpan := range sendSpans {
+		require.Equal(t, updateSpanID, sendSpan.Parent.SpanID())
+	}
+
+	performUpdateSpans := spansNamed("sendconfig.PerformUpdate")
+	require.Len(t, performUpdateSpans, len(clientsProvider.gatewayClients))
+	for _, gatewayClient := range clientsProvider.gatewayClients {
+		require.True(t, lo.ContainsBy(performUpdateSpans, func(s tracetest.SpanStub) bool {
+			return lo.Contains(s.Attributes, tracing.AttributeClientURL.String(gatewayClient.BaseRootURL())) &&
+				lo.Contains(s.Attributes, tracing.AttributeConfigHash.String(string(gatewayClient.LastConfigSHA())))
+		}), "expected a PerformUpdate span tagged with %s URL and its config hash", gatewayClient.BaseRootURL())
+	}
+
+	require.Len(t, spansNamed("deckgen.ToDeckContent"), len(clientsProvider.gatewayClients))
+}
+
+func TestKongClientUpdate_ConfigEntityCountsAreRecordedOnlyAfterSuccessfulPush(t *testing.T) {
+	gatewayClient := mustSampleGatewayClient(t)
+	clientsProvider := &mockGatewayClientsProvider{
+		gatewayClients: []*adminapi.Client{gatewayClient},
+	}
+	updateStrategyResolver := newMockUpdateStrategyResolver(t)
+	configChangeDetector := mockConfigurationChangeDetector{
+		hasConfigurationChanged: true,
+		status:                  defaultKongStatus,
+	}
+	configBuilder := newMockKongConfigBuilder()
+	configBuilder.kongState = &kongstate.KongState{
+		Services: []kongstate.Service{
+			{
+				Service: kong.Service{Name: kong.String("service"), Host: kong.String("example.com")},
+				Routes: []kongstate.Route{
+					{Route: kong.Route{Name: kong.String("route"), Paths: kong.StringSlice("/")}},
+				},
+			},
+		},
+	}
+	kongClient := setupTestKongClient(t, updateStrategyResolver, clientsProvider, configChangeDetector, configBuilder, nil, &mockKongLastValidConfigFetcher{})
+	routeCount := func() float64 {
+		return testutil.ToFloat64(kongClient.prometheusMetrics.ConfigEntityCount.WithLabelValues(
+			gatewayClient.BaseRootURL(), "false", metrics.EntityTypeRoute,
+		))
+	}
+
+	t.Log("Rejecting the configuration by the gateway")
+	updateStrategyResolver.returnErrorOnUpdate(gatewayClient.BaseRootURL())
+	require.Error(t, kongClient.Update(context.Background()))
+	require.Zero(t, testutil.CollectAndCount(kongClient.prometheusMetrics.ConfigEntityCount), "no counts expected for rejected configuration")
+
+	t.Log("Accepting the configuration by the gateway")
+	require.NoError(t, kongClient.Update(context.Background()))
+	require.Equal(t, 1.0, routeCount())
+}
+
+func TestKongStateEntityCounts(t *testing.T) {
+	plugin := func(name string) kong.Plugin { return kong.Plugin{Name: kong.String(name)} }
+	s := &kongstate.KongState{
+		Services: []kongstate.Service{
+			{
+				Plugins: []kong.Plugin{plugin("key-auth")},
+				Routes: []kongstate.Route{
+					{Plugins: []kong.Plugin{plugin("key-auth"), plugin("cors")}},
+					{},
+				},
+			},
+			{
+				Routes: []kongstate.Route{{}},
+			},
+		},
+		Upstreams: []kongstate.Upstream{
+			{Targets: []kongstate.Target{{}, {}}},
+			{Targets: []kongstate.Target{{}}},
+		},
+		Consumers: []kongstate.Consumer{
+			{Plugins: []kong.Plugin{plugin("rate-limiting")}},
+		},
+		ConsumerGroups: []kongstate.ConsumerGroup{{}},
+		Certificates:   []kongstate.Certificate{{}},
+		Plugins: []kongstate.Plugin{
+			{Plugin: plugin("prometheus")},
+			{
+				Plugin: kong.Plugin{
+					Name:          kong.String("rate-limiting-advanced"),
+					ConsumerGroup: &kong.ConsumerGroup{ID: kong.String("group")},
+				},
+			},
+		},
+		CustomEntities: map[string]*kongstate.KongCustomEntityCollection{
+			"degraphql_routes": {Entities: []kongstate.CustomEntity{{}, {}}},
+		},
+	}
+
+	require.Equal(t, metrics.ConfigEntityCounts{
+		Services:       2,
+		Routes:         3,
+		Upstreams:      2,
+		Targets:        3,
+		Consumers:      1,
+		ConsumerGroups: 1,
+		Certificates:   1,
+		PluginsByName: map[string]int{
+			"key-auth":               2,
+			"cors":                   1,
+			"rate-limiting":          1,
+			"rate-limiting-advanced": 1,
+			"prometheus":             1,
+		},
+		CustomEntitiesByType: map[string]int{
+			"degraphql_routes": 2,
+		},
+	}, kongStateEntityCounts(s))
+}
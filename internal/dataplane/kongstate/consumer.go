@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
 	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
@@ -25,6 +26,11 @@ type Consumer struct {
 	MTLSAuths   []*MTLSAuth
 
 	K8sKongConsumer kongv1.KongConsumer
+
+	// Credentials are the types of the credentials provisioned for the consumer and the Secrets they come from.
+	Credentials []kongv1.KongConsumerCredentialStatus
+	// PluginsStatus are the plugins scoped to the consumer or to its consumer groups.
+	PluginsStatus []kongv1.KongConsumerPluginStatus
 }
 
 // SanitizedCopy returns a shallow copy with sensitive values redacted best-effort.
@@ -65,6 +71,48 @@ func (c *Consumer) SanitizedCopy(uuidGenerator util.UUIDGenerator) *Consumer {
 		ACLGroups:       c.ACLGroups,
 		MTLSAuths:       c.MTLSAuths,
 		K8sKongConsumer: c.K8sKongConsumer,
+		Credentials:     c.Credentials,
+		PluginsStatus:   c.PluginsStatus,
+	}
+}
+
+// FillConsumersPlugins fills the plugins scoped to each consumer, either directly or through the consumer groups
+// it's a member of, so they can be reported in the KongConsumers' status.
+// It must be called after the consumers and the plugins are filled.
+func (ks *KongState) FillConsumersPlugins() {
+	for i := range ks.Consumers {
+		c := &ks.Consumers[i]
+		consumerGroups := lo.FilterMap(c.ConsumerGroups, func(cg kong.ConsumerGroup, _ int) (string, bool) {
+			return lo.FromPtr(cg.Name), cg.Name != nil
+		})
+
+		c.PluginsStatus = nil
+		for _, plugin := range ks.Plugins {
+			var consumerGroup string
+			switch {
+			case c.Username != nil && plugin.Consumer != nil && lo.FromPtr(plugin.Consumer.ID) == *c.Username:
+			case plugin.Consumer == nil && plugin.ConsumerGroup != nil &&
+				lo.Contains(consumerGroups, lo.FromPtr(plugin.ConsumerGroup.ID)):
+				consumerGroup = *plugin.ConsumerGroup.ID
+			default:
+				continue
+			}
+			parentStatus, ok := consumerGroupPluginStatusForPlugin(plugin)
+			if !ok {
+				continue
+			}
+			pluginStatus := kongv1.KongConsumerPluginStatus{
+				Kind:          parentStatus.Kind,
+				Namespace:     parentStatus.Namespace,
+				Name:          parentStatus.Name,
+				PluginName:    parentStatus.PluginName,
+				ConsumerGroup: consumerGroup,
+			}
+			if lo.Contains(c.PluginsStatus, pluginStatus) {
+				continue
+			}
+			c.PluginsStatus = append(c.PluginsStatus, pluginStatus)
+		}
 	}
 }
 
@@ -5,6 +5,8 @@ import (
 
 	"github.com/kong/go-kong/kong"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
 	"github.com/kong/kubernetes-ingress-controller/v3/test/mocks"
@@ -71,6 +73,55 @@ func TestConsumer_SanitizedCopy(t *testing.T) {
 	}
 }
 
+func TestFillConsumersPlugins(t *testing.T) {
+	rateLimiting := &kongv1.KongPlugin{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "rate-limit"},
+		PluginName: "rate-limiting",
+	}
+	transformer := &kongv1.KongClusterPlugin{
+		ObjectMeta: metav1.ObjectMeta{Name: "transform"},
+		PluginName: "request-transformer",
+	}
+
+	ks := KongState{
+		Consumers: []Consumer{
+			{
+				Consumer:       kong.Consumer{Username: kong.String("alice")},
+				ConsumerGroups: []kong.ConsumerGroup{{Name: kong.String("gold")}},
+			},
+			{
+				Consumer: kong.Consumer{Username: kong.String("bob")},
+			},
+		},
+		Plugins: []Plugin{
+			{
+				Plugin:    kong.Plugin{Name: kong.String("rate-limiting"), Consumer: &kong.Consumer{ID: kong.String("alice")}},
+				K8sParent: rateLimiting,
+			},
+			{
+				Plugin:    kong.Plugin{Name: kong.String("rate-limiting"), Consumer: &kong.Consumer{ID: kong.String("alice")}, Route: &kong.Route{ID: kong.String("route")}},
+				K8sParent: rateLimiting,
+			},
+			{
+				Plugin:    kong.Plugin{Name: kong.String("request-transformer"), ConsumerGroup: &kong.ConsumerGroup{ID: kong.String("gold")}},
+				K8sParent: transformer,
+			},
+			{
+				Plugin:    kong.Plugin{Name: kong.String("request-transformer"), ConsumerGroup: &kong.ConsumerGroup{ID: kong.String("silver")}},
+				K8sParent: transformer,
+			},
+		},
+	}
+
+	ks.FillConsumersPlugins()
+
+	require.Equal(t, []kongv1.KongConsumerPluginStatus{
+		{Kind: "KongPlugin", Namespace: "default", Name: "rate-limit", PluginName: "rate-limiting"},
+		{Kind: "KongClusterPlugin", Name: "transform", PluginName: "request-transformer", ConsumerGroup: "gold"},
+	}, ks.Consumers[0].PluginsStatus)
+	require.Empty(t, ks.Consumers[1].PluginsStatus)
+}
+
 func TestConsumer_SetCredential(t *testing.T) {
 	username := "example"
 	type args struct {
@@ -2,7 +2,9 @@ package kongstate
 
 import (
 	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
 
+	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
 	kongv1beta1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1beta1"
 )
 
@@ -11,4 +13,56 @@ type ConsumerGroup struct {
 	kong.ConsumerGroup
 
 	K8sKongConsumerGroup kongv1beta1.KongConsumerGroup
+
+	// ConsumersCount is the number of consumers that are members of the consumer group.
+	ConsumersCount int
+	// Plugins are the plugins scoped to the consumer group.
+	Plugins []kongv1beta1.KongConsumerGroupPluginStatus
+}
+
+// FillConsumerGroupsMembersAndPlugins fills the number of member consumers and the plugins scoped
+// to each consumer group, so they can be reported in the KongConsumerGroups' status.
+// It must be called after the consumers and the plugins are filled.
+func (ks *KongState) FillConsumerGroupsMembersAndPlugins() {
+	for i := range ks.ConsumerGroups {
+		cg := &ks.ConsumerGroups[i]
+		cg.ConsumersCount = lo.CountBy(ks.Consumers, func(c Consumer) bool {
+			return c.K8sKongConsumer.Namespace == cg.K8sKongConsumerGroup.Namespace &&
+				lo.Contains(c.K8sKongConsumer.ConsumerGroups, cg.K8sKongConsumerGroup.Name)
+		})
+
+		cg.Plugins = nil
+		for _, plugin := range ks.Plugins {
+			if plugin.ConsumerGroup == nil || plugin.ConsumerGroup.ID == nil || *plugin.ConsumerGroup.ID != *cg.Name {
+				continue
+			}
+			pluginStatus, ok := consumerGroupPluginStatusForPlugin(plugin)
+			if !ok || lo.Contains(cg.Plugins, pluginStatus) {
+				continue
+			}
+			cg.Plugins = append(cg.Plugins, pluginStatus)
+		}
+	}
+}
+
+// consumerGroupPluginStatusForPlugin returns the status entry describing the KongPlugin or KongClusterPlugin
+// the plugin was translated from.
+func consumerGroupPluginStatusForPlugin(plugin Plugin) (kongv1beta1.KongConsumerGroupPluginStatus, bool) {
+	switch parent := plugin.K8sParent.(type) {
+	case *kongv1.KongPlugin:
+		return kongv1beta1.KongConsumerGroupPluginStatus{
+			Kind:       "KongPlugin",
+			Namespace:  parent.Namespace,
+			Name:       parent.Name,
+			PluginName: parent.PluginName,
+		}, true
+	case *kongv1.KongClusterPlugin:
+		return kongv1beta1.KongConsumerGroupPluginStatus{
+			Kind:       "KongClusterPlugin",
+			Name:       parent.Name,
+			PluginName: parent.PluginName,
+		}, true
+	default:
+		return kongv1beta1.KongConsumerGroupPluginStatus{}, false
+	}
 }
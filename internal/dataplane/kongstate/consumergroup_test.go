@@ -0,0 +1,84 @@
+package kongstate
+
+import (
+	"testing"
+
+	"github.com/kong/go-kong/kong"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
+	kongv1beta1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1beta1"
+)
+
+func TestFillConsumerGroupsMembersAndPlugins(t *testing.T) {
+	consumer := func(namespace, name string, groups ...string) Consumer {
+		return Consumer{
+			Consumer: kong.Consumer{Username: kong.String(name)},
+			K8sKongConsumer: kongv1.KongConsumer{
+				ObjectMeta:     metav1.ObjectMeta{Namespace: namespace, Name: name},
+				Username:       name,
+				ConsumerGroups: groups,
+			},
+		}
+	}
+	rateLimiting := &kongv1.KongPlugin{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "rate-limit"},
+		PluginName: "rate-limiting",
+	}
+	transformer := &kongv1.KongClusterPlugin{
+		ObjectMeta: metav1.ObjectMeta{Name: "transform"},
+		PluginName: "request-transformer",
+	}
+
+	ks := KongState{
+		ConsumerGroups: []ConsumerGroup{
+			{
+				ConsumerGroup: kong.ConsumerGroup{Name: kong.String("gold")},
+				K8sKongConsumerGroup: kongv1beta1.KongConsumerGroup{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "gold"},
+				},
+			},
+			{
+				ConsumerGroup: kong.ConsumerGroup{Name: kong.String("silver")},
+				K8sKongConsumerGroup: kongv1beta1.KongConsumerGroup{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "silver"},
+				},
+			},
+		},
+		Consumers: []Consumer{
+			consumer("default", "alice", "gold"),
+			consumer("default", "bob", "gold", "silver"),
+			// Consumer groups are referenced in the consumer's namespace.
+			consumer("other", "carol", "gold"),
+		},
+		Plugins: []Plugin{
+			{
+				Plugin:    kong.Plugin{Name: kong.String("rate-limiting"), ConsumerGroup: &kong.ConsumerGroup{ID: kong.String("gold")}},
+				K8sParent: rateLimiting,
+			},
+			{
+				Plugin:    kong.Plugin{Name: kong.String("rate-limiting"), ConsumerGroup: &kong.ConsumerGroup{ID: kong.String("gold")}, Route: &kong.Route{ID: kong.String("route")}},
+				K8sParent: rateLimiting,
+			},
+			{
+				Plugin:    kong.Plugin{Name: kong.String("request-transformer"), ConsumerGroup: &kong.ConsumerGroup{ID: kong.String("gold")}},
+				K8sParent: transformer,
+			},
+			{
+				Plugin:    kong.Plugin{Name: kong.String("rate-limiting"), Consumer: &kong.Consumer{ID: kong.String("alice")}},
+				K8sParent: rateLimiting,
+			},
+		},
+	}
+
+	ks.FillConsumerGroupsMembersAndPlugins()
+
+	require.Equal(t, 2, ks.ConsumerGroups[0].ConsumersCount)
+	require.Equal(t, []kongv1beta1.KongConsumerGroupPluginStatus{
+		{Kind: "KongPlugin", Namespace: "default", Name: "rate-limit", PluginName: "rate-limiting"},
+		{Kind: "KongClusterPlugin", Name: "transform", PluginName: "request-transformer"},
+	}, ks.ConsumerGroups[0].Plugins)
+	require.Equal(t, 1, ks.ConsumerGroups[1].ConsumersCount)
+	require.Empty(t, ks.ConsumerGroups[1].Plugins)
+}
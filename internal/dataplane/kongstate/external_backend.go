@@ -0,0 +1,67 @@
+package kongstate
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/kong/go-kong/kong"
+
+	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
+)
+
+// DefaultExternalBackendTargetWeight is the weight of KongExternalBackend's targets that do not specify it.
+const DefaultExternalBackendTargetWeight = 100
+
+// ExternalBackendServiceName returns the name of the Kong Service generated for a KongExternalBackend.
+// KongExternalBackends used by stream routes (e.g. TCPRoute) get a separate Kong Service as its protocol
+// differs from the one used by HTTP routes. The naming pattern is `<namespace>.<name>.external` for HTTP
+// and `<namespace>.<name>.tcp.external` for stream routes.
+func ExternalBackendServiceName(namespace, name string, stream bool) string {
+	if stream {
+		return fmt.Sprintf("%s.%s.tcp.external", namespace, name)
+	}
+	return fmt.Sprintf("%s.%s.external", namespace, name)
+}
+
+// ApplyExternalBackendTLS configures the Kong Service to connect to KongExternalBackend's targets using TLS
+// if the KongExternalBackend requests it.
+func ApplyExternalBackendTLS(service *kong.Service, tls *kongv1alpha1.KongExternalBackendTLS) {
+	if tls == nil {
+		return
+	}
+	if service.Protocol != nil {
+		switch *service.Protocol {
+		case "http":
+			service.Protocol = kong.String("https")
+		case "grpc":
+			service.Protocol = kong.String("grpcs")
+		case "ws":
+			service.Protocol = kong.String("wss")
+		case "tcp":
+			service.Protocol = kong.String("tls")
+		}
+	}
+	service.TLSVerify = kong.Bool(tls.Verify)
+	if tls.VerifyDepth != nil {
+		service.TLSVerifyDepth = kong.Int(int(*tls.VerifyDepth))
+	}
+}
+
+// ExternalBackendTargets returns Kong targets for all the targets of a KongExternalBackend.
+func ExternalBackendTargets(externalBackend *kongv1alpha1.KongExternalBackend) []Target {
+	targets := make([]Target, 0, len(externalBackend.Spec.Targets))
+	for _, t := range externalBackend.Spec.Targets {
+		weight := DefaultExternalBackendTargetWeight
+		if t.Weight != nil {
+			weight = int(*t.Weight)
+		}
+		targets = append(targets, Target{
+			Target: kong.Target{
+				Target: kong.String(net.JoinHostPort(t.Host, strconv.Itoa(int(t.Port)))),
+				Weight: kong.Int(weight),
+			},
+		})
+	}
+	return targets
+}
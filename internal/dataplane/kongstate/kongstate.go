@@ -22,6 +22,7 @@ import (
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/logging"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
+	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
 	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
 	kongv1beta1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1beta1"
 )
@@ -176,6 +177,10 @@ func (ks *KongState) FillConsumersAndCredentials(
 				)
 				continue
 			}
+			c.Credentials = append(c.Credentials, kongv1.KongConsumerCredentialStatus{
+				Secret: cred,
+				Type:   credType,
+			})
 		}
 
 		consumerIndex[consumer.Namespace+"/"+consumer.Name] = c
@@ -340,9 +345,16 @@ type NamespacedKongPlugin struct {
 	Name      string
 }
 
-func (ks *KongState) getPluginRelations(cacheStore store.Storer, log logr.Logger) map[string]util.ForeignRelations {
+// getPluginRelations returns the entities each plugin is attached to. References to plugins that are not
+// allowed by KongPluginPolicies in the namespace of the referrer are skipped and reported as failures.
+func (ks *KongState) getPluginRelations(
+	cacheStore store.Storer,
+	log logr.Logger,
+	failuresCollector *failures.ResourceFailuresCollector,
+) map[string]util.ForeignRelations {
 	// KongPlugin key (KongPlugin's name:namespace) to corresponding associations
 	pluginRels := map[string]util.ForeignRelations{}
+	policies := cacheStore.ListKongPluginPolicies()
 
 	type entityRelationType int
 	const (
@@ -368,6 +380,13 @@ func (ks *KongState) getPluginRelations(cacheStore store.Storer, log logr.Logger
 			return
 		}
 
+		// The plugin is used in the namespace of the referrer, which can differ from the plugin's namespace
+		// for remote references, so the policies of the referrer's namespace apply.
+		if k8sPlugin, err := checkReferredPluginAllowedByPolicies(cacheStore, policies, referrer.GetNamespace(), namespace, plugin.Name); err != nil {
+			failuresCollector.PushResourceFailure(err.Error(), referrer, k8sPlugin)
+			return
+		}
+
 		pluginKey := namespace + ":" + plugin.Name
 		relations, ok := pluginRels[pluginKey]
 		if !ok {
@@ -495,6 +514,7 @@ func buildPlugins(
 	pluginRels map[string]util.ForeignRelations,
 ) []Plugin {
 	var plugins []Plugin
+	policies := s.ListKongPluginPolicies()
 
 	for pluginIdentifier, relations := range pluginRels {
 		identifier := strings.Split(pluginIdentifier, ":")
@@ -522,7 +542,6 @@ func buildPlugins(
 				continue
 			}
 		}
-
 		usedInstanceNames := sets.New[string]()
 		for _, rel := range relations.GetCombinations() {
 			plugin := plugin.DeepCopy()
@@ -566,8 +585,15 @@ func buildPlugins(
 	if err != nil {
 		logger.Error(err, "Failed to fetch global plugins")
 	}
-	// global plugins have no instance_name transform as they can only be applied once
-	plugins = append(plugins, gKCPs...)
+	for _, plugin := range gKCPs {
+		// Global plugins apply to all namespaces, so only policies applying to all namespaces are checked.
+		if err := CheckPluginAllowedByPolicies(policies, "", *plugin.Name); err != nil {
+			failuresCollector.PushResourceFailure(err.Error(), plugin.K8sParent)
+			continue
+		}
+		// global plugins have no instance_name transform as they can only be applied once
+		plugins = append(plugins, plugin)
+	}
 
 	return plugins
 }
@@ -618,7 +644,67 @@ func (ks *KongState) FillPlugins(
 	s store.Storer,
 	failuresCollector *failures.ResourceFailuresCollector,
 ) {
-	ks.Plugins = buildPlugins(log, s, failuresCollector, ks.getPluginRelations(s, log))
+	ks.Plugins = buildPlugins(log, s, failuresCollector, ks.getPluginRelations(s, log, failuresCollector))
+	ks.removeRoutePluginsOverriddenByKongPlugins(failuresCollector)
+}
+
+// routePluginsOverridableByKongPlugins are the types of the plugins generated for routes that give way
+// to KongPlugins of the same type attached to the routes. Currently it's only the cors plugin generated
+// from the HTTPRoute CORS filter, that users may want to replace with a fully configured KongPlugin.
+var routePluginsOverridableByKongPlugins = map[string]struct{}{
+	"cors": {},
+}
+
+// removeRoutePluginsOverriddenByKongPlugins removes the overridable plugins generated for routes (see
+// routePluginsOverridableByKongPlugins) when a KongPlugin of the same type is attached to the route alone
+// with the konghq.com/plugins annotation or an ExtensionRef filter. Kong allows a single plugin of a type
+// per route, and the KongPlugin takes precedence as it is configured explicitly. Every overridden plugin
+// is reported as a failure.
+func (ks *KongState) removeRoutePluginsOverriddenByKongPlugins(failuresCollector *failures.ResourceFailuresCollector) {
+	// Route name to KongPlugins attached to the route by plugin type.
+	routeKongPlugins := map[string]map[string]Plugin{}
+	for _, plugin := range ks.Plugins {
+		if plugin.Route == nil || plugin.Route.ID == nil || plugin.Name == nil ||
+			plugin.Service != nil || plugin.Consumer != nil || plugin.ConsumerGroup != nil {
+			continue
+		}
+		if _, ok := routePluginsOverridableByKongPlugins[*plugin.Name]; !ok {
+			continue
+		}
+		if _, ok := routeKongPlugins[*plugin.Route.ID]; !ok {
+			routeKongPlugins[*plugin.Route.ID] = map[string]Plugin{}
+		}
+		routeKongPlugins[*plugin.Route.ID][*plugin.Name] = plugin
+	}
+
+	for i := range ks.Services {
+		for j := range ks.Services[i].Routes {
+			route := &ks.Services[i].Routes[j]
+			kongPlugins, ok := routeKongPlugins[*route.Name]
+			if !ok || len(route.Plugins) == 0 {
+				continue
+			}
+			route.Plugins = lo.Filter(route.Plugins, func(plugin kong.Plugin, _ int) bool {
+				kongPlugin, overridden := kongPlugins[*plugin.Name]
+				if overridden {
+					routeParent := &metav1.PartialObjectMetadata{
+						TypeMeta: util.TypeMetaFromGVK(route.Ingress.GroupVersionKind),
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: route.Ingress.Namespace,
+							Name:      route.Ingress.Name,
+						},
+					}
+					failuresCollector.PushResourceFailure(
+						fmt.Sprintf("%s plugin generated from the %s is overridden by plugin %s/%s attached to the route",
+							*plugin.Name, route.Ingress.GroupVersionKind.Kind,
+							kongPlugin.K8sParent.GetNamespace(), kongPlugin.K8sParent.GetName()),
+						routeParent,
+					)
+				}
+				return !overridden
+			})
+		}
+	}
 }
 
 // FillIDs iterates over the KongState and fills in the ID field for each entity
@@ -808,6 +894,30 @@ func (ks *KongState) getPluginRelatedEntitiesRef(cacheStore store.Storer, log lo
 	return pluginRels
 }
 
+// checkReferredPluginAllowedByPolicies returns an error if the KongPlugin or KongClusterPlugin referred from
+// the referrer's namespace is not allowed there by any of the KongPluginPolicies, along with the referred object.
+// Plugins that can't be fetched are reported when building plugins, so they pass the check.
+func checkReferredPluginAllowedByPolicies(
+	s store.Storer,
+	policies []*kongv1alpha1.KongPluginPolicy,
+	referrerNamespace string,
+	pluginNamespace string,
+	pluginName string,
+) (client.Object, error) {
+	if len(policies) == 0 {
+		return nil, nil
+	}
+	k8sPlugin, k8sClusterPlugin, _ := getKongPluginOrKongClusterPlugin(s, pluginNamespace, pluginName)
+	switch {
+	case k8sPlugin != nil:
+		return k8sPlugin, CheckPluginAllowedByPolicies(policies, referrerNamespace, k8sPlugin.PluginName)
+	case k8sClusterPlugin != nil:
+		return k8sClusterPlugin, CheckPluginAllowedByPolicies(policies, referrerNamespace, k8sClusterPlugin.PluginName)
+	default:
+		return nil, nil
+	}
+}
+
 func extractReferredPluginNamespace(
 	log logr.Logger, cacheStore store.Storer, referrer client.Object, plugin annotations.NamespacedKongPlugin,
 ) (string, error) {
@@ -20,6 +20,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	k8stypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -497,7 +498,7 @@ func TestGetPluginRelations(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			store, _ := store.NewFakeStore(store.FakeObjects{})
-			if got := tt.args.state.getPluginRelations(store, logr.Discard()); !reflect.DeepEqual(got, tt.want) {
+			if got := tt.args.state.getPluginRelations(store, logr.Discard(), nil); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("getPluginRelations() = %v, want %v", got, tt.want)
 			}
 		})
@@ -636,6 +637,10 @@ func TestFillConsumersAndCredentials(t *testing.T) {
 							},
 						},
 					},
+					Credentials: []kongv1.KongConsumerCredentialStatus{
+						{Secret: "fooCredSecret", Type: "key-auth"},
+						{Secret: "barCredSecret", Type: "oauth2"},
+					},
 				},
 			},
 		},
@@ -779,6 +784,9 @@ func TestFillConsumersAndCredentials(t *testing.T) {
 							ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "labeledSecret"},
 						}),
 					}}},
+					Credentials: []kongv1.KongConsumerCredentialStatus{
+						{Secret: "labeledSecret", Type: "key-auth"},
+					},
 				},
 			},
 		},
@@ -807,6 +815,7 @@ func TestFillConsumersAndCredentials(t *testing.T) {
 				// compare credentials.
 				assert.Equal(t, expectedConsumer.KeyAuths, kongStateConsumer.KeyAuths)
 				assert.Equal(t, expectedConsumer.Oauth2Creds, kongStateConsumer.Oauth2Creds)
+				assert.Equal(t, expectedConsumer.Credentials, kongStateConsumer.Credentials)
 			}
 			// check for expected translation failures.
 			if len(tc.expectedTranslationFailureMessages) > 0 {
@@ -1022,6 +1031,263 @@ func TestKongState_BuildPluginsCollisions(t *testing.T) {
 	}
 }
 
+func TestKongState_BuildPluginsPolicies(t *testing.T) {
+	kongPluginTypeMeta := metav1.TypeMeta{
+		APIVersion: kongv1.GroupVersion.String(),
+		Kind:       "KongPlugin",
+	}
+	kongPlugins := []*kongv1.KongPlugin{
+		{
+			TypeMeta: kongPluginTypeMeta,
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "serverless",
+				Namespace: "team-a",
+			},
+			PluginName: "pre-function",
+		},
+		{
+			TypeMeta: kongPluginTypeMeta,
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "rate-limiting",
+				Namespace: "team-a",
+			},
+			PluginName: "rate-limiting",
+		},
+		{
+			TypeMeta: kongPluginTypeMeta,
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "serverless",
+				Namespace: "team-b",
+			},
+			PluginName: "pre-function",
+		},
+	}
+	kongClusterPluginTypeMeta := metav1.TypeMeta{
+		APIVersion: kongv1.GroupVersion.String(),
+		Kind:       "KongClusterPlugin",
+	}
+	kongClusterPlugins := []*kongv1.KongClusterPlugin{
+		{
+			TypeMeta: kongClusterPluginTypeMeta,
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "serverless-global",
+				Labels: map[string]string{
+					"global": "true",
+				},
+				Annotations: map[string]string{
+					annotations.IngressClassKey: annotations.DefaultIngressClass,
+				},
+			},
+			PluginName: "post-function",
+		},
+		{
+			TypeMeta: kongClusterPluginTypeMeta,
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "serverless-cluster",
+				Annotations: map[string]string{
+					annotations.IngressClassKey: annotations.DefaultIngressClass,
+				},
+			},
+			PluginName: "pre-function",
+		},
+	}
+	policies := []*kongv1alpha1.KongPluginPolicy{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "team-a-no-serverless"},
+			Spec: kongv1alpha1.KongPluginPolicySpec{
+				Namespaces:    []string{"team-a"},
+				DeniedPlugins: []string{"pre-function"},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "no-global-serverless"},
+			Spec: kongv1alpha1.KongPluginPolicySpec{
+				DeniedPlugins: []string{"post-function"},
+			},
+		},
+	}
+	// Grants allowing HTTPRoutes of each team to use the KongPlugins of the other team.
+	referenceGrant := func(namespace, fromNamespace string) *gatewayapi.ReferenceGrant {
+		return &gatewayapi.ReferenceGrant{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      "plugins-for-" + fromNamespace,
+			},
+			Spec: gatewayapi.ReferenceGrantSpec{
+				From: []gatewayapi.ReferenceGrantFrom{
+					{
+						Group:     gatewayapi.V1Group,
+						Kind:      gatewayapi.Kind("HTTPRoute"),
+						Namespace: gatewayapi.Namespace(fromNamespace),
+					},
+				},
+				To: []gatewayapi.ReferenceGrantTo{
+					{
+						Group: gatewayapi.Group(kongv1.GroupVersion.Group),
+						Kind:  gatewayapi.Kind("KongPlugin"),
+					},
+				},
+			},
+		}
+	}
+	s, err := store.NewFakeStore(store.FakeObjects{
+		KongPlugins:        kongPlugins,
+		KongClusterPlugins: kongClusterPlugins,
+		KongPluginPolicies: policies,
+		ReferenceGrants: []*gatewayapi.ReferenceGrant{
+			referenceGrant("team-a", "team-b"),
+			referenceGrant("team-b", "team-a"),
+		},
+	})
+	require.NoError(t, err)
+
+	route := func(name, namespace, plugins string) Route {
+		return Route{
+			Route: kong.Route{Name: kong.String(name)},
+			Ingress: util.K8sObjectInfo{
+				Name:      name,
+				Namespace: namespace,
+				Annotations: map[string]string{
+					annotations.AnnotationPrefix + annotations.PluginsKey: plugins,
+				},
+				GroupVersionKind: schema.GroupVersionKind{Group: string(gatewayapi.V1Group), Version: gatewayapi.V1GroupVersion, Kind: "HTTPRoute"},
+			},
+		}
+	}
+	ks := KongState{
+		Services: []Service{
+			{
+				Service: kong.Service{Name: kong.String("service")},
+				Routes: []Route{
+					route("route-a", "team-a", "rate-limiting,serverless"),
+					// The KongPlugin is allowed in its namespace, but it's used in the referrer's namespace.
+					route("route-a-remote", "team-a", "team-b:serverless"),
+					route("route-a-cluster", "team-a", "serverless-cluster"),
+					route("route-b", "team-b", "serverless"),
+					// The KongPlugin is denied in its namespace, but it's used in the referrer's namespace.
+					route("route-b-remote", "team-b", "team-a:serverless"),
+					route("route-b-cluster", "team-b", "serverless-cluster"),
+				},
+			},
+		},
+	}
+	logger := zapr.NewLogger(zap.NewNop())
+	failuresCollector := failures.NewResourceFailuresCollector(logger)
+
+	ks.FillPlugins(logger, s, failuresCollector)
+
+	require.ElementsMatch(t,
+		[]string{
+			"rate-limiting/route-a",
+			"pre-function/route-b",
+			"pre-function/route-b-remote",
+			"pre-function/route-b-cluster",
+		},
+		lo.Map(ks.Plugins, func(p Plugin, _ int) string { return *p.Name + "/" + *p.Route.ID }),
+	)
+	const deniedInTeamA = `plugin "pre-function" is not allowed in namespace team-a by KongPluginPolicy team-a-no-serverless`
+	require.ElementsMatch(t,
+		[]string{
+			deniedInTeamA + ": team-a/route-a, team-a/serverless",
+			deniedInTeamA + ": team-a/route-a-remote, team-b/serverless",
+			deniedInTeamA + ": team-a/route-a-cluster, /serverless-cluster",
+			`plugin "post-function" is not allowed by KongPluginPolicy no-global-serverless: /serverless-global`,
+		},
+		lo.Map(failuresCollector.PopResourceFailures(), func(f failures.ResourceFailure, _ int) string {
+			causingObjects := lo.Map(f.CausingObjects(), func(o client.Object, _ int) string {
+				return o.GetNamespace() + "/" + o.GetName()
+			})
+			return f.Message() + ": " + strings.Join(causingObjects, ", ")
+		}),
+	)
+}
+
+func TestKongState_FillPluginsOverridesRoutePlugins(t *testing.T) {
+	s, err := store.NewFakeStore(store.FakeObjects{
+		KongPlugins: []*kongv1.KongPlugin{
+			{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: kongv1.GroupVersion.String(),
+					Kind:       "KongPlugin",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cors",
+					Namespace: "default",
+				},
+				PluginName: "cors",
+			},
+			{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: kongv1.GroupVersion.String(),
+					Kind:       "KongPlugin",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "request-transformer",
+					Namespace: "default",
+				},
+				PluginName: "request-transformer",
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	httpRouteInfo := func(name string, annotations map[string]string) util.K8sObjectInfo {
+		return util.K8sObjectInfo{
+			Name:             name,
+			Namespace:        "default",
+			Annotations:      annotations,
+			GroupVersionKind: schema.GroupVersionKind{Group: string(gatewayapi.V1Group), Version: gatewayapi.V1GroupVersion, Kind: "HTTPRoute"},
+		}
+	}
+	generatedPlugins := func() []kong.Plugin {
+		return []kong.Plugin{
+			{Name: kong.String("cors")},
+			{Name: kong.String("request-transformer")},
+		}
+	}
+	ks := KongState{
+		Services: []Service{
+			{
+				Service: kong.Service{Name: kong.String("service")},
+				Routes: []Route{
+					{
+						Route:   kong.Route{Name: kong.String("route-with-kongplugin")},
+						Ingress: httpRouteInfo("with-kongplugin", map[string]string{annotations.AnnotationPrefix + annotations.PluginsKey: "cors,request-transformer"}),
+						Plugins: generatedPlugins(),
+					},
+					{
+						Route:   kong.Route{Name: kong.String("route-without-kongplugin")},
+						Ingress: httpRouteInfo("without-kongplugin", nil),
+						Plugins: generatedPlugins(),
+					},
+				},
+			},
+		},
+	}
+	logger := zapr.NewLogger(zap.NewNop())
+	failuresCollector := failures.NewResourceFailuresCollector(logger)
+
+	ks.FillPlugins(logger, s, failuresCollector)
+
+	require.Len(t, ks.Plugins, 2)
+	for _, plugin := range ks.Plugins {
+		require.Equal(t, "route-with-kongplugin", *plugin.Route.ID)
+	}
+	pluginNames := func(plugins []kong.Plugin) []string {
+		return lo.Map(plugins, func(p kong.Plugin, _ int) string { return *p.Name })
+	}
+	require.Equal(t, []string{"request-transformer"}, pluginNames(ks.Services[0].Routes[0].Plugins),
+		"only the cors plugin generated for the route should be overridden by the KongPlugin")
+	require.Equal(t, []string{"cors", "request-transformer"}, pluginNames(ks.Services[0].Routes[1].Plugins))
+
+	translationFailures := failuresCollector.PopResourceFailures()
+	require.Len(t, translationFailures, 1)
+	require.Equal(t, "cors plugin generated from the HTTPRoute is overridden by plugin default/cors attached to the route",
+		translationFailures[0].Message())
+	require.Len(t, translationFailures[0].CausingObjects(), 1)
+	require.Equal(t, "with-kongplugin", translationFailures[0].CausingObjects()[0].GetName())
+}
+
 func TestKongState_FillUpstreamOverrides(t *testing.T) {
 	const (
 		kongIngressName        = "kongIngress"
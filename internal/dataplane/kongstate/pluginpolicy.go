@@ -0,0 +1,44 @@
+package kongstate
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
+)
+
+// CheckPluginAllowedByPolicies returns an error if any of the given KongPluginPolicies does not allow
+// using the Kong plugin with the given name in the namespace.
+// An empty namespace means that the plugin is used cluster-wide (e.g. it's a KongClusterPlugin not attached
+// to any namespaced resource). In that case only policies applying to all namespaces are taken into account.
+func CheckPluginAllowedByPolicies(policies []*kongv1alpha1.KongPluginPolicy, namespace, pluginName string) error {
+	// Sort policies by name to report violations deterministically.
+	policies = slices.Clone(policies)
+	sort.Slice(policies, func(i, j int) bool {
+		return policies[i].Name < policies[j].Name
+	})
+
+	for _, policy := range policies {
+		if !pluginPolicyAppliesToNamespace(policy, namespace) {
+			continue
+		}
+		denied := slices.Contains(policy.Spec.DeniedPlugins, pluginName) ||
+			(len(policy.Spec.AllowedPlugins) > 0 && !slices.Contains(policy.Spec.AllowedPlugins, pluginName))
+		if !denied {
+			continue
+		}
+		if namespace == "" {
+			return fmt.Errorf("plugin %q is not allowed by KongPluginPolicy %s", pluginName, policy.Name)
+		}
+		return fmt.Errorf("plugin %q is not allowed in namespace %s by KongPluginPolicy %s", pluginName, namespace, policy.Name)
+	}
+	return nil
+}
+
+func pluginPolicyAppliesToNamespace(policy *kongv1alpha1.KongPluginPolicy, namespace string) bool {
+	if len(policy.Spec.Namespaces) == 0 {
+		return true
+	}
+	return namespace != "" && slices.Contains(policy.Spec.Namespaces, namespace)
+}
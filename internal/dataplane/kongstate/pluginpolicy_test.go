@@ -0,0 +1,115 @@
+package kongstate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
+)
+
+func TestCheckPluginAllowedByPolicies(t *testing.T) {
+	policy := func(name string, spec kongv1alpha1.KongPluginPolicySpec) *kongv1alpha1.KongPluginPolicy {
+		return &kongv1alpha1.KongPluginPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       spec,
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		policies    []*kongv1alpha1.KongPluginPolicy
+		namespace   string
+		pluginName  string
+		expectedErr string
+	}{
+		{
+			name:       "no policies",
+			namespace:  "default",
+			pluginName: "pre-function",
+		},
+		{
+			name: "plugin denied in all namespaces",
+			policies: []*kongv1alpha1.KongPluginPolicy{
+				policy("no-serverless", kongv1alpha1.KongPluginPolicySpec{
+					DeniedPlugins: []string{"pre-function", "post-function"},
+				}),
+			},
+			namespace:   "default",
+			pluginName:  "pre-function",
+			expectedErr: `plugin "pre-function" is not allowed in namespace default by KongPluginPolicy no-serverless`,
+		},
+		{
+			name: "plugin not listed in denied plugins",
+			policies: []*kongv1alpha1.KongPluginPolicy{
+				policy("no-serverless", kongv1alpha1.KongPluginPolicySpec{
+					DeniedPlugins: []string{"pre-function", "post-function"},
+				}),
+			},
+			namespace:  "default",
+			pluginName: "rate-limiting",
+		},
+		{
+			name: "plugin not listed in allowed plugins",
+			policies: []*kongv1alpha1.KongPluginPolicy{
+				policy("only-rate-limiting", kongv1alpha1.KongPluginPolicySpec{
+					AllowedPlugins: []string{"rate-limiting"},
+				}),
+			},
+			namespace:   "default",
+			pluginName:  "key-auth",
+			expectedErr: `plugin "key-auth" is not allowed in namespace default by KongPluginPolicy only-rate-limiting`,
+		},
+		{
+			name: "plugin denied in another namespace",
+			policies: []*kongv1alpha1.KongPluginPolicy{
+				policy("no-serverless", kongv1alpha1.KongPluginPolicySpec{
+					Namespaces:    []string{"team-a"},
+					DeniedPlugins: []string{"pre-function"},
+				}),
+			},
+			namespace:  "team-b",
+			pluginName: "pre-function",
+		},
+		{
+			name: "cluster-wide plugin is checked only against policies applying to all namespaces",
+			policies: []*kongv1alpha1.KongPluginPolicy{
+				policy("team-a", kongv1alpha1.KongPluginPolicySpec{
+					Namespaces:    []string{"team-a"},
+					DeniedPlugins: []string{"pre-function"},
+				}),
+				policy("all", kongv1alpha1.KongPluginPolicySpec{
+					DeniedPlugins: []string{"post-function"},
+				}),
+			},
+			pluginName:  "post-function",
+			expectedErr: `plugin "post-function" is not allowed by KongPluginPolicy all`,
+		},
+		{
+			name: "violations are reported for the first policy by name",
+			policies: []*kongv1alpha1.KongPluginPolicy{
+				policy("b", kongv1alpha1.KongPluginPolicySpec{
+					DeniedPlugins: []string{"pre-function"},
+				}),
+				policy("a", kongv1alpha1.KongPluginPolicySpec{
+					AllowedPlugins: []string{"rate-limiting"},
+				}),
+			},
+			namespace:   "default",
+			pluginName:  "pre-function",
+			expectedErr: `plugin "pre-function" is not allowed in namespace default by KongPluginPolicy a`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := CheckPluginAllowedByPolicies(tc.policies, tc.namespace, tc.pluginName)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
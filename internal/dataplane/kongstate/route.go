@@ -22,6 +22,27 @@ type Route struct {
 	ExpressionRoutes bool
 }
 
+// DeepCopy returns a copy of the Route with its Kong entities copied deeply.
+func (r Route) DeepCopy() Route {
+	return Route{
+		Route:            *r.Route.DeepCopy(),
+		Ingress:          r.Ingress,
+		Plugins:          deepCopyKongPlugins(r.Plugins),
+		ExpressionRoutes: r.ExpressionRoutes,
+	}
+}
+
+func deepCopyKongPlugins(plugins []kong.Plugin) []kong.Plugin {
+	if plugins == nil {
+		return nil
+	}
+	out := make([]kong.Plugin, 0, len(plugins))
+	for _, p := range plugins {
+		out = append(out, *p.DeepCopy())
+	}
+	return out
+}
+
 var (
 	validMethods      = regexp.MustCompile(`\A[A-Z]+$`)
 	validPathHandling = regexp.MustCompile(`v\d`)
@@ -2,6 +2,8 @@ package kongstate
 
 import (
 	"fmt"
+	"maps"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -60,6 +62,28 @@ type Service struct {
 	Parent client.Object
 }
 
+// DeepCopy returns a copy of the Service with its Kong entities copied deeply.
+// Kubernetes objects (parent and Services) are not copied as they are never modified.
+func (s Service) DeepCopy() Service {
+	out := Service{
+		Service:   *s.Service.DeepCopy(),
+		Namespace: s.Namespace,
+		Backends:  slices.Clone(s.Backends),
+		Parent:    s.Parent,
+	}
+	if s.Routes != nil {
+		out.Routes = make([]Route, 0, len(s.Routes))
+		for _, r := range s.Routes {
+			out.Routes = append(out.Routes, r.DeepCopy())
+		}
+	}
+	out.Plugins = deepCopyKongPlugins(s.Plugins)
+	if s.K8sServices != nil {
+		out.K8sServices = maps.Clone(s.K8sServices)
+	}
+	return out
+}
+
 func (s *Service) overridePath(anns map[string]string) {
 	if s == nil {
 		return
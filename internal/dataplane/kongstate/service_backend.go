@@ -15,13 +15,17 @@ const (
 	// ServiceBackendTypeKongServiceFacade means that the backend is an incubatorv1alpha1.KongServiceFacade.
 	ServiceBackendTypeKongServiceFacade ServiceBackendType = "KongServiceFacade"
 
+	// ServiceBackendTypeKongExternalBackend means that the backend is a kongv1alpha1.KongExternalBackend.
+	ServiceBackendTypeKongExternalBackend ServiceBackendType = "KongExternalBackend"
+
 	// ServiceBackendTypeKubernetesService means that the backend is a Kubernetes Service.
 	ServiceBackendTypeKubernetesService ServiceBackendType = "KubernetesService"
 )
 
 type ServiceBackends []ServiceBackend
 
-// ServiceBackend represents a backend for a Kong Service. It can be a Kubernetes Service or a KongServiceFacade.
+// ServiceBackend represents a backend for a Kong Service. It can be a Kubernetes Service, a KongServiceFacade
+// or a KongExternalBackend.
 type ServiceBackend struct {
 	backendType    ServiceBackendType
 	namespacedName k8stypes.NamespacedName
@@ -69,6 +73,15 @@ func NewServiceBackendForServiceFacade(nn k8stypes.NamespacedName, portDef PortD
 	)
 }
 
+// NewServiceBackendForExternalBackend creates a new ServiceBackend for a KongExternalBackend.
+func NewServiceBackendForExternalBackend(nn k8stypes.NamespacedName) (ServiceBackend, error) {
+	return NewServiceBackend(
+		ServiceBackendTypeKongExternalBackend,
+		nn,
+		PortDef{Mode: PortModeImplicit},
+	)
+}
+
 // SetWeight sets the weight of the backend used for load-balancing.
 func (s *ServiceBackend) SetWeight(weight int32) {
 	s.weight = lo.ToPtr(int(weight))
@@ -97,8 +110,12 @@ func (s *ServiceBackend) Weight() mo.Option[int] {
 	return mo.None[int]()
 }
 
-// IsServiceFacade returns true if the backend is a KongServiceFacade. Otherwise, returns false
-// what means that the backend is a Kubernetes Service.
+// IsServiceFacade returns true if the backend is a KongServiceFacade.
 func (s *ServiceBackend) IsServiceFacade() bool {
 	return s.backendType == ServiceBackendTypeKongServiceFacade
 }
+
+// IsExternalBackend returns true if the backend is a KongExternalBackend.
+func (s *ServiceBackend) IsExternalBackend() bool {
+	return s.backendType == ServiceBackendTypeKongExternalBackend
+}
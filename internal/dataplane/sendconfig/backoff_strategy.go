@@ -63,6 +63,14 @@ func (s UpdateStrategyWithBackoff) Update(ctx context.Context, targetContent Con
 	return nil
 }
 
+// LastPayloadStats returns the payload stats reported by the decorated UpdateStrategy, if it reports them.
+func (s UpdateStrategyWithBackoff) LastPayloadStats() (PayloadStats, bool) {
+	if decorated, ok := s.decorated.(UpdateStrategyWithPayloadStats); ok {
+		return decorated.LastPayloadStats()
+	}
+	return PayloadStats{}, false
+}
+
 func (s UpdateStrategyWithBackoff) MetricsProtocol() metrics.Protocol {
 	return s.decorated.MetricsProtocol()
 }
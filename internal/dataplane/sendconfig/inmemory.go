@@ -1,13 +1,16 @@
 package sendconfig
 
 import (
-	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
 
 	"github.com/go-logr/logr"
 	"github.com/kong/go-database-reconciler/pkg/file"
@@ -34,10 +37,47 @@ type ContentToDBLessConfigConverter interface {
 
 // UpdateStrategyInMemory implements the UpdateStrategy interface. It updates Kong's data-plane
 // configuration using its `POST /config` endpoint that is used by ConfigService.ReloadDeclarativeRawConfig.
+// The configuration is streamed to the endpoint instead of being marshaled as a whole beforehand.
 type UpdateStrategyInMemory struct {
-	configService   ConfigService
+	configService ConfigService
+	// gzipConfigService is used to send gzip-compressed configuration. When nil, the configuration is sent uncompressed.
+	gzipConfigService ConfigService
+	// gzipSupport remembers whether the gateway failed to decode gzip-compressed configuration.
+	gzipSupport     *gzipSupport
 	configConverter ContentToDBLessConfigConverter
 	logger          logr.Logger
+
+	// lastPayloadStats holds the stats of the payload accepted by the gateway in the last Update call.
+	lastPayloadStats *PayloadStats
+}
+
+// gzipSupport tells whether a gateway is known not to decode gzip-compressed configuration. Once the gateway
+// fails to decode compressed configuration, the configuration is sent to it uncompressed right away.
+type gzipSupport struct {
+	unsupported atomic.Bool
+}
+
+// gzipSupportByGateway holds gzipSupport of gateways by their Admin API base URLs, so it's remembered across
+// update strategies resolved for the same gateway.
+type gzipSupportByGateway struct {
+	lock     sync.Mutex
+	gateways map[string]*gzipSupport
+}
+
+func newGzipSupportByGateway() *gzipSupportByGateway {
+	return &gzipSupportByGateway{gateways: make(map[string]*gzipSupport)}
+}
+
+// get returns gzipSupport of the gateway with the given Admin API base URL.
+func (g *gzipSupportByGateway) get(baseRootURL string) *gzipSupport {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	support, ok := g.gateways[baseRootURL]
+	if !ok {
+		support = &gzipSupport{}
+		g.gateways[baseRootURL] = support
+	}
+	return support
 }
 
 func NewUpdateStrategyInMemory(
@@ -46,40 +86,40 @@ func NewUpdateStrategyInMemory(
 	logger logr.Logger,
 ) UpdateStrategyInMemory {
 	return UpdateStrategyInMemory{
-		configService:   configService,
-		configConverter: configConverter,
-		logger:          logger,
+		configService:    configService,
+		configConverter:  configConverter,
+		logger:           logger,
+		lastPayloadStats: &PayloadStats{},
 	}
 }
 
+// NewUpdateStrategyInMemoryWithCompression creates an UpdateStrategyInMemory that sends gzip-compressed configuration
+// using gzipConfigService. When the gateway couldn't decode the compressed configuration (it responded with 415
+// Unsupported Media Type or with 400 Bad Request because it couldn't parse the body), the configuration is sent
+// uncompressed using configService, and so it is in the following Update calls of the strategy.
+func NewUpdateStrategyInMemoryWithCompression(
+	configService ConfigService,
+	gzipConfigService ConfigService,
+	configConverter ContentToDBLessConfigConverter,
+	logger logr.Logger,
+) UpdateStrategyInMemory {
+	s := NewUpdateStrategyInMemory(configService, configConverter, logger)
+	s.gzipConfigService = gzipConfigService
+	s.gzipSupport = &gzipSupport{}
+	return s
+}
+
 func (s UpdateStrategyInMemory) Update(ctx context.Context, targetState ContentWithHash) error {
 	dblessConfig := s.configConverter.Convert(targetState.Content)
-	config, err := json.Marshal(dblessConfig)
-	if err != nil {
-		return fmt.Errorf("constructing kong configuration: %w", err)
+	for entityType := range targetState.CustomEntities {
+		s.logger.V(logging.DebugLevel).Info("Filled custom entities", "entity_type", entityType)
 	}
 
-	if len(targetState.CustomEntities) > 0 {
-		unmarshaledConfig := map[string]any{}
-		if err := json.Unmarshal(config, &unmarshaledConfig); err != nil {
-			return fmt.Errorf("unmarshaling config for adding custom entities: %w", err)
+	if reloadConfigErr := s.reloadConfig(ctx, dblessConfig, targetState.CustomEntities); reloadConfigErr != nil {
+		var constructionErr configConstructionError
+		if errors.As(reloadConfigErr, &constructionErr) {
+			return fmt.Errorf("constructing kong configuration: %w", constructionErr.err)
 		}
-		for entityType, entities := range targetState.CustomEntities {
-			unmarshaledConfig[entityType] = entities
-			s.logger.V(logging.DebugLevel).Info("Filled custom entities", "entity_type", entityType)
-		}
-		config, err = json.Marshal(unmarshaledConfig)
-		if err != nil {
-			return fmt.Errorf("constructing kong configuration again with custom entities: %w", err)
-		}
-	}
-
-	if reloadConfigErr := s.configService.ReloadDeclarativeRawConfig(
-		ctx,
-		bytes.NewReader(config),
-		true,
-		true,
-	); reloadConfigErr != nil {
 		// If the returned error is an APIError with a 400 status code, we can try to parse the response body to get the
 		// resource errors and produce an UpdateError with them.
 		var apiError *kong.APIError
@@ -100,6 +140,139 @@ func (s UpdateStrategyInMemory) Update(ctx context.Context, targetState ContentW
 	return nil
 }
 
+// LastPayloadStats returns the stats of the payload accepted by the gateway in the last Update call. When the
+// compressed configuration couldn't be decoded, these are the stats of the uncompressed configuration sent instead.
+func (s UpdateStrategyInMemory) LastPayloadStats() (PayloadStats, bool) {
+	if s.lastPayloadStats == nil || s.lastPayloadStats.ConfigSize == 0 {
+		return PayloadStats{}, false
+	}
+	return *s.lastPayloadStats, true
+}
+
+// reloadConfig sends the configuration compressed if compression is enabled and the gateway is not known to be
+// unable to decode it, falling back to sending it uncompressed when the gateway couldn't decode it. It records
+// the stats of the payload the gateway accepted.
+func (s UpdateStrategyInMemory) reloadConfig(
+	ctx context.Context,
+	config DBLessConfig,
+	customEntities CustomEntitiesByType,
+) error {
+	*s.lastPayloadStats = PayloadStats{}
+	if s.gzipConfigService != nil && !s.gzipSupport.unsupported.Load() {
+		const compress = true
+		stats, err := s.sendConfig(ctx, s.gzipConfigService, config, customEntities, compress)
+		if !isCompressedConfigDecodeError(err) {
+			if err == nil {
+				*s.lastPayloadStats = stats
+			}
+			return err
+		}
+		s.gzipSupport.unsupported.Store(true)
+		s.logger.Info(
+			"Gateway could not decode gzip-compressed configuration, sending it uncompressed from now on", "error", err,
+		)
+	}
+	const compress = false
+	stats, err := s.sendConfig(ctx, s.configService, config, customEntities, compress)
+	if err == nil {
+		*s.lastPayloadStats = stats
+	}
+	return err
+}
+
+// sendConfig streams the configuration to the configService and returns the stats of the sent payload. The configuration
+// is marshaled and written to the request body while it's being sent, so the marshaled configuration is never held in
+// memory as a whole.
+func (s UpdateStrategyInMemory) sendConfig(
+	ctx context.Context,
+	configService ConfigService,
+	config DBLessConfig,
+	customEntities CustomEntitiesByType,
+	compress bool,
+) (PayloadStats, error) {
+	body, bodyWriter := io.Pipe()
+	stats := PayloadStats{Compressed: compress}
+	var writeErr error
+	writeDone := make(chan struct{})
+	go func() {
+		defer close(writeDone)
+		payload := &countingWriter{w: bodyWriter}
+		var (
+			w  io.Writer = payload
+			gz *gzip.Writer
+		)
+		if compress {
+			gz = gzip.NewWriter(payload)
+			w = gz
+		}
+		configWriter := &countingWriter{w: w}
+		stats.MaxBufferedSize, writeErr = writeDBLessConfig(configWriter, config, customEntities)
+		if writeErr == nil && gz != nil {
+			writeErr = gz.Close()
+		}
+		stats.ConfigSize, stats.PayloadSize = configWriter.n, payload.n
+		// Closing the writer with a nil error makes the reader return io.EOF.
+		_ = bodyWriter.CloseWithError(writeErr)
+	}()
+
+	reloadErr := configService.ReloadDeclarativeRawConfig(ctx, body, true, true)
+	// The request could have finished before the whole body was consumed, unblock the writer in such case.
+	_ = body.Close()
+	<-writeDone
+
+	if writeErr != nil && !errors.Is(writeErr, io.ErrClosedPipe) {
+		return stats, configConstructionError{err: writeErr}
+	}
+	return stats, reloadErr
+}
+
+// configDecodeErrorMessages are (parts of) messages of 400 Bad Request responses of gateways that couldn't parse
+// the configuration, as gateways not supporting compressed configuration try to parse it as JSON regardless.
+var configDecodeErrorMessages = []string{
+	"cannot parse json body",
+	"failed parsing declarative configuration",
+}
+
+// isCompressedConfigDecodeError tells whether the error returned for compressed configuration indicates the gateway
+// couldn't decode it. Gateways not supporting compressed configuration either reject it as an unsupported media type
+// or fail to parse it as JSON. Other client errors (e.g. 401 Unauthorized or 413 Content Too Large) would be returned
+// for uncompressed configuration as well, so they're not considered decode errors.
+func isCompressedConfigDecodeError(err error) bool {
+	var apiError *kong.APIError
+	if !errors.As(err, &apiError) {
+		return false
+	}
+	switch apiError.Code() {
+	case http.StatusUnsupportedMediaType:
+		return true
+	case http.StatusBadRequest:
+		var configError ConfigError
+		if unmarshalErr := json.Unmarshal(apiError.Raw(), &configError); unmarshalErr != nil ||
+			len(configError.Flattened) > 0 {
+			// Per-entity errors are only reported once the configuration was decoded and validated.
+			return false
+		}
+		message := strings.ToLower(configError.Message)
+		for _, decodeErrorMessage := range configDecodeErrorMessages {
+			if strings.Contains(message, decodeErrorMessage) {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
+
+// configConstructionError is returned when the configuration couldn't be marshaled.
+type configConstructionError struct {
+	err error
+}
+
+func (e configConstructionError) Error() string {
+	return e.err.Error()
+}
+
 func (s UpdateStrategyInMemory) MetricsProtocol() metrics.Protocol {
 	return metrics.ProtocolDBLess
 }
@@ -0,0 +1,218 @@
+package sendconfig
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
+)
+
+// GzipConfigService implements ConfigService sending gzip-compressed configuration to Kong's `POST /config` endpoint.
+// The config passed to ReloadDeclarativeRawConfig is expected to be already compressed.
+type GzipConfigService struct {
+	client *kong.Client
+}
+
+func NewGzipConfigService(client *kong.Client) GzipConfigService {
+	return GzipConfigService{client: client}
+}
+
+// ReloadDeclarativeRawConfig sends the compressed config to the `POST /config` endpoint with the
+// `Content-Encoding: gzip` header.
+func (s GzipConfigService) ReloadDeclarativeRawConfig(
+	ctx context.Context,
+	config io.Reader,
+	checkHash bool,
+	flattenErrors bool,
+) error {
+	type sendConfigParams struct {
+		CheckHash     int `url:"check_hash,omitempty"`
+		FlattenErrors int `url:"flatten_errors,omitempty"`
+	}
+	req, err := s.client.NewRequest(
+		http.MethodPost,
+		"/config",
+		sendConfigParams{CheckHash: lo.Ternary(checkHash, 1, 0), FlattenErrors: lo.Ternary(flattenErrors, 1, 0)},
+		config,
+	)
+	if err != nil {
+		return fmt.Errorf("creating new HTTP request for /config: %w", err)
+	}
+	req.Header.Set("Content-Encoding", "gzip")
+
+	resp, err := s.client.DoRAW(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed posting new config to /config: %w", err)
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("could not read /config %d status response body: %w", resp.StatusCode, err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+		return kong.NewAPIErrorWithRaw(resp.StatusCode, "failed posting new config to /config", b)
+	}
+	return nil
+}
+
+// writeDBLessConfig writes the configuration along with the custom entities as a JSON object to w. Top-level fields
+// are encoded one by one and lists of entities element by element, so only a single entity (e.g. a service with its
+// routes) is held in memory at once. Custom entities override configuration fields of the same name. It returns the
+// size of the largest encoded part of the configuration.
+func writeDBLessConfig(w io.Writer, config DBLessConfig, customEntities CustomEntitiesByType) (int64, error) {
+	members := lo.Filter(jsonObjectMembers(reflect.ValueOf(config)), func(m jsonObjectMember, _ int) bool {
+		_, overridden := customEntities[m.name]
+		return !overridden
+	})
+	entityTypes := lo.Keys(customEntities)
+	slices.Sort(entityTypes)
+	for _, entityType := range entityTypes {
+		members = append(members, jsonObjectMember{name: entityType, value: reflect.ValueOf(customEntities[entityType])})
+	}
+
+	jw := &jsonStreamWriter{w: w}
+	jw.writeString("{")
+	for i, m := range members {
+		if i > 0 {
+			jw.writeString(",")
+		}
+		jw.writeValue(m.name, reflect.ValueOf(m.name))
+		jw.writeString(":")
+		jw.writeValue(m.name, m.value)
+	}
+	jw.writeString("}")
+	return jw.maxEncodedSize, jw.err
+}
+
+// jsonStreamWriter writes JSON to w encoding lists element by element. The first error encountered is kept in err and
+// makes all subsequent writes no-ops.
+type jsonStreamWriter struct {
+	w              io.Writer
+	maxEncodedSize int64
+	err            error
+}
+
+func (jw *jsonStreamWriter) writeString(s string) {
+	if jw.err != nil {
+		return
+	}
+	_, jw.err = io.WriteString(jw.w, s)
+}
+
+// writeValue writes the JSON encoding of v. Slices are written element by element unless they are encoded by
+// encoding/json in a special way (nil slices, byte slices, and types implementing json.Marshaler).
+func (jw *jsonStreamWriter) writeValue(name string, v reflect.Value) {
+	if jw.err != nil {
+		return
+	}
+	if !isStreamableSlice(v) {
+		jw.writeEncoded(name, v.Interface())
+		return
+	}
+	jw.writeString("[")
+	for i := range v.Len() {
+		if i > 0 {
+			jw.writeString(",")
+		}
+		// Slice elements are addressable, so encoding/json would use MarshalJSON implemented with a pointer receiver.
+		// Encode them through a pointer to do the same.
+		jw.writeEncoded(name, v.Index(i).Addr().Interface())
+	}
+	jw.writeString("]")
+}
+
+func (jw *jsonStreamWriter) writeEncoded(name string, v any) {
+	if jw.err != nil {
+		return
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		jw.err = fmt.Errorf("marshaling %s: %w", name, err)
+		return
+	}
+	jw.maxEncodedSize = max(jw.maxEncodedSize, int64(len(b)))
+	_, jw.err = jw.w.Write(b)
+}
+
+var jsonMarshalerType = reflect.TypeFor[json.Marshaler]()
+
+// isStreamableSlice tells whether v is a slice encoded by encoding/json as a plain JSON array of its elements.
+func isStreamableSlice(v reflect.Value) bool {
+	if v.Kind() != reflect.Slice || v.IsNil() || v.Type().Elem().Kind() == reflect.Uint8 {
+		return false
+	}
+	return !v.Type().Implements(jsonMarshalerType) && !reflect.PointerTo(v.Type()).Implements(jsonMarshalerType)
+}
+
+// jsonObjectMember is a single member of a JSON object.
+type jsonObjectMember struct {
+	name  string
+	value reflect.Value
+}
+
+// jsonObjectMembers returns the members of a JSON object the struct v would be marshaled into by encoding/json,
+// following its rules for field names, embedded structs, and the omitempty option.
+func jsonObjectMembers(v reflect.Value) []jsonObjectMember {
+	var members []jsonObjectMember
+	t := v.Type()
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		fieldValue := v.Field(i)
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			members = append(members, jsonObjectMembers(fieldValue)...)
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if slices.Contains(strings.Split(opts, ","), "omitempty") && isEmptyJSONValue(fieldValue) {
+			continue
+		}
+		members = append(members, jsonObjectMember{name: name, value: fieldValue})
+	}
+	return members
+}
+
+// isEmptyJSONValue tells whether the value is considered empty by encoding/json's omitempty option.
+func isEmptyJSONValue(v reflect.Value) bool {
+	switch v.Kind() { //nolint:exhaustive
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64,
+		reflect.Interface, reflect.Pointer:
+		return v.IsZero()
+	default:
+		return false
+	}
+}
+
+// countingWriter counts bytes written to the underlying writer.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
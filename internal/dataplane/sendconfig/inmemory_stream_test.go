@@ -0,0 +1,63 @@
+package sendconfig
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/kong/go-database-reconciler/pkg/file"
+	"github.com/kong/go-kong/kong"
+	"github.com/stretchr/testify/require"
+)
+
+// writeSizeRecorder records the size of the largest single write.
+type writeSizeRecorder struct {
+	bytes.Buffer
+	largestWrite int
+}
+
+func (r *writeSizeRecorder) Write(p []byte) (int, error) {
+	r.largestWrite = max(r.largestWrite, len(p))
+	return r.Buffer.Write(p)
+}
+
+func TestWriteDBLessConfig(t *testing.T) {
+	config := DBLessConfig{
+		Content: file.Content{FormatVersion: "3.0"},
+		ConsumerGroupConsumerRelationships: []ConsumerGroupConsumerRelationship{
+			{ConsumerGroup: "group", Consumer: "consumer"},
+		},
+	}
+	var largestEntitySize int
+	for i := range 50 {
+		service := file.FService{
+			Service: kong.Service{
+				Name: kong.String(fmt.Sprintf("service-%d", i)),
+				Host: kong.String(fmt.Sprintf("service-%d.default.svc", i)),
+			},
+		}
+		for j := range i % 5 {
+			service.Routes = append(service.Routes, &file.FRoute{
+				Route: kong.Route{
+					Name:  kong.String(fmt.Sprintf("service-%d-route-%d", i, j)),
+					Paths: kong.StringSlice(fmt.Sprintf("/service-%d/route-%d", i, j)),
+				},
+			})
+		}
+		serviceJSON, err := json.Marshal(service)
+		require.NoError(t, err)
+		largestEntitySize = max(largestEntitySize, len(serviceJSON))
+		config.Services = append(config.Services, service)
+	}
+	expectedJSON, err := json.Marshal(config)
+	require.NoError(t, err)
+
+	w := &writeSizeRecorder{}
+	maxEncodedSize, err := writeDBLessConfig(w, config, nil)
+	require.NoError(t, err)
+	require.Equal(t, string(expectedJSON), w.String(), "streamed configuration should match the marshaled one")
+	require.Equal(t, largestEntitySize, w.largestWrite, "only a single entity should be written at once")
+	require.Equal(t, int64(largestEntitySize), maxEncodedSize)
+	require.Less(t, largestEntitySize*10, len(expectedJSON), "sanity check: entity should be a small part of the configuration")
+}
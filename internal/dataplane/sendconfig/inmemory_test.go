@@ -1,14 +1,20 @@
 package sendconfig_test
 
 import (
+	"compress/gzip"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
 	"testing"
 
 	"github.com/go-logr/logr"
 	"github.com/kong/go-database-reconciler/pkg/file"
 	"github.com/kong/go-kong/kong"
+	"github.com/kong/go-kong/kong/custom"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -150,3 +156,294 @@ func TestUpdateStrategyInMemory(t *testing.T) {
 		})
 	}
 }
+
+// adminAPIConfigStandIn is a stand-in for Kong Admin API's `POST /config` endpoint recording received configurations.
+type adminAPIConfigStandIn struct {
+	acceptsGzip bool
+	// ignoresContentEncoding makes the stand-in parse the body as JSON regardless of its Content-Encoding, like gateways
+	// that don't support compressed configuration do.
+	ignoresContentEncoding bool
+
+	lock     sync.Mutex
+	requests []receivedConfigRequest
+}
+
+type receivedConfigRequest struct {
+	contentEncoding string
+	contentLength   int64
+	config          map[string]any
+}
+
+func (a *adminAPIConfigStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || r.URL.Path != "/config" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	req := receivedConfigRequest{
+		contentEncoding: r.Header.Get("Content-Encoding"),
+		contentLength:   r.ContentLength,
+	}
+	defer func() {
+		a.lock.Lock()
+		defer a.lock.Unlock()
+		a.requests = append(a.requests, req)
+	}()
+
+	var body io.Reader = r.Body
+	switch {
+	case req.contentEncoding == "", a.ignoresContentEncoding:
+	case req.contentEncoding == "gzip":
+		if !a.acceptsGzip {
+			w.WriteHeader(http.StatusUnsupportedMediaType)
+			return
+		}
+		gz, err := gzip.NewReader(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		body = gz
+	default:
+		w.WriteHeader(http.StatusUnsupportedMediaType)
+		return
+	}
+	if err := json.NewDecoder(body).Decode(&req.config); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"message":"failed parsing declarative configuration"}`))
+		return
+	}
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (a *adminAPIConfigStandIn) Requests() []receivedConfigRequest {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	return a.requests
+}
+
+func TestUpdateStrategyInMemory_StreamsConfigToAdminAPI(t *testing.T) {
+	content := func() *file.Content {
+		c := &file.Content{FormatVersion: "3.0"}
+		for i := range 100 {
+			c.Services = append(c.Services, file.FService{
+				Service: kong.Service{
+					Name: kong.String(fmt.Sprintf("service-%d", i)),
+					Host: kong.String(fmt.Sprintf("service-%d.default.svc", i)),
+					Port: kong.Int(80),
+				},
+			})
+		}
+		return c
+	}
+	customEntities := sendconfig.CustomEntitiesByType{
+		"degraphql_routes": []custom.Object{{"uri": "/foo", "query": "query{ foo }"}},
+	}
+
+	t.Log("Building the configuration expected to be received by the Admin API")
+	expectedConfigJSON, err := json.Marshal(sendconfig.DefaultContentToDBLessConfigConverter{}.Convert(content()))
+	require.NoError(t, err)
+	var expectedConfig map[string]any
+	require.NoError(t, json.Unmarshal(expectedConfigJSON, &expectedConfig))
+	customEntitiesJSON, err := json.Marshal(customEntities["degraphql_routes"])
+	require.NoError(t, err)
+	var expectedCustomEntities any
+	require.NoError(t, json.Unmarshal(customEntitiesJSON, &expectedCustomEntities))
+	expectedConfig["degraphql_routes"] = expectedCustomEntities
+
+	t.Log("Calculating the size of the largest entity which is the most the configuration should be buffered at once")
+	var largestEntitySize int64
+	expectedServices := sendconfig.DefaultContentToDBLessConfigConverter{}.Convert(content()).Services
+	for _, service := range expectedServices {
+		serviceJSON, err := json.Marshal(service)
+		require.NoError(t, err)
+		largestEntitySize = max(largestEntitySize, int64(len(serviceJSON)))
+	}
+
+	testCases := []struct {
+		name                     string
+		compress                 bool
+		gatewayAcceptsGzip       bool
+		gatewayIgnoresEncoding   bool
+		expectedContentEncodings []string
+	}{
+		{
+			name:                     "compression disabled",
+			expectedContentEncodings: []string{""},
+		},
+		{
+			name:                     "compression enabled, gateway accepts gzip",
+			compress:                 true,
+			gatewayAcceptsGzip:       true,
+			expectedContentEncodings: []string{"gzip"},
+		},
+		{
+			name:                     "compression enabled, gateway does not accept gzip",
+			compress:                 true,
+			expectedContentEncodings: []string{"gzip", ""},
+		},
+		{
+			name:                     "compression enabled, gateway fails to parse gzip",
+			compress:                 true,
+			gatewayIgnoresEncoding:   true,
+			expectedContentEncodings: []string{"gzip", ""},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			adminAPI := &adminAPIConfigStandIn{
+				acceptsGzip:            tc.gatewayAcceptsGzip,
+				ignoresContentEncoding: tc.gatewayIgnoresEncoding,
+			}
+			server := httptest.NewServer(adminAPI)
+			t.Cleanup(server.Close)
+			client, err := kong.NewClient(lo.ToPtr(server.URL), server.Client())
+			require.NoError(t, err)
+
+			s := sendconfig.NewUpdateStrategyInMemory(client, sendconfig.DefaultContentToDBLessConfigConverter{}, logr.Discard())
+			if tc.compress {
+				s = sendconfig.NewUpdateStrategyInMemoryWithCompression(
+					client,
+					sendconfig.NewGzipConfigService(client),
+					sendconfig.DefaultContentToDBLessConfigConverter{},
+					logr.Discard(),
+				)
+			}
+			_, ok := s.LastPayloadStats()
+			require.False(t, ok, "no stats expected before the first update")
+
+			err = s.Update(context.Background(), sendconfig.ContentWithHash{
+				Content:        content(),
+				CustomEntities: customEntities,
+			})
+			require.NoError(t, err)
+
+			requests := adminAPI.Requests()
+			require.Equal(t, tc.expectedContentEncodings, lo.Map(requests, func(r receivedConfigRequest, _ int) string {
+				return r.contentEncoding
+			}))
+			lastRequest := requests[len(requests)-1]
+			require.Equal(t, int64(-1), lastRequest.contentLength, "expected the configuration to be streamed with unknown length")
+			require.Equal(t, expectedConfig, lastRequest.config)
+
+			stats, ok := s.LastPayloadStats()
+			require.True(t, ok)
+			require.Equal(t, tc.gatewayAcceptsGzip, stats.Compressed)
+			require.Equal(t, largestEntitySize, stats.MaxBufferedSize, "only a single entity should be buffered at once")
+			if tc.gatewayAcceptsGzip {
+				require.Less(t, stats.PayloadSize, stats.ConfigSize, "compressed payload should be smaller than the configuration")
+			} else {
+				require.Equal(t, stats.ConfigSize, stats.PayloadSize)
+			}
+		})
+	}
+}
+
+func TestUpdateStrategyInMemory_ParsesResourceErrorsFromStreamedUpload(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(validFlattenedErrorsResponse))
+	}))
+	t.Cleanup(server.Close)
+	client, err := kong.NewClient(lo.ToPtr(server.URL), server.Client())
+	require.NoError(t, err)
+
+	s := sendconfig.NewUpdateStrategyInMemoryWithCompression(
+		client,
+		sendconfig.NewGzipConfigService(client),
+		sendconfig.DefaultContentToDBLessConfigConverter{},
+		logr.Discard(),
+	)
+	err = s.Update(context.Background(), sendconfig.ContentWithHash{Content: &file.Content{FormatVersion: "3.0"}})
+	var updateErr sendconfig.UpdateError
+	require.ErrorAs(t, err, &updateErr)
+	require.Len(t, updateErr.ResourceFailures(), 1)
+}
+
+// gatewayUpdateClient is an UpdateClient of a regular (non-Konnect) gateway.
+type gatewayUpdateClient struct {
+	client *kong.Client
+}
+
+func (c gatewayUpdateClient) IsKonnect() bool              { return false }
+func (c gatewayUpdateClient) KonnectControlPlane() string  { return "" }
+func (c gatewayUpdateClient) AdminAPIClient() *kong.Client { return c.client }
+
+func TestUpdateStrategyInMemory_RemembersGatewaysNotDecodingGzip(t *testing.T) {
+	adminAPI := &adminAPIConfigStandIn{}
+	server := httptest.NewServer(adminAPI)
+	t.Cleanup(server.Close)
+	client, err := kong.NewClient(lo.ToPtr(server.URL), server.Client())
+	require.NoError(t, err)
+
+	resolver := sendconfig.NewDefaultUpdateStrategyResolver(sendconfig.Config{
+		InMemory:             true,
+		CompressDBLessConfig: true,
+	}, logr.Discard())
+	for range 2 {
+		s := resolver.ResolveUpdateStrategy(gatewayUpdateClient{client: client})
+		require.NoError(t, s.Update(context.Background(), sendconfig.ContentWithHash{Content: &file.Content{FormatVersion: "3.0"}}))
+		stats, ok := s.(sendconfig.UpdateStrategyWithPayloadStats).LastPayloadStats()
+		require.True(t, ok)
+		require.False(t, stats.Compressed, "stats of the accepted uncompressed payload expected")
+	}
+
+	require.Equal(t, []string{"gzip", "", ""}, lo.Map(adminAPI.Requests(), func(r receivedConfigRequest, _ int) string {
+		return r.contentEncoding
+	}), "compressed configuration should be sent only until the gateway fails to decode it")
+}
+
+func TestUpdateStrategyInMemory_DoesNotFallBackOnOtherClientErrors(t *testing.T) {
+	testCases := []struct {
+		name   string
+		status int
+		body   string
+	}{
+		{
+			name:   "unauthorized",
+			status: http.StatusUnauthorized,
+			body:   `{"message":"Unauthorized"}`,
+		},
+		{
+			name:   "forbidden",
+			status: http.StatusForbidden,
+			body:   `{"message":"Forbidden"}`,
+		},
+		{
+			name:   "content too large",
+			status: http.StatusRequestEntityTooLarge,
+			body:   `{"message":"Payload too large"}`,
+		},
+		{
+			name:   "bad request not caused by decoding",
+			status: http.StatusBadRequest,
+			body:   `{"message":"declarative config is invalid: {}"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var contentEncodings []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				contentEncodings = append(contentEncodings, r.Header.Get("Content-Encoding"))
+				_, _ = io.Copy(io.Discard, r.Body)
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			t.Cleanup(server.Close)
+			client, err := kong.NewClient(lo.ToPtr(server.URL), server.Client())
+			require.NoError(t, err)
+
+			s := sendconfig.NewUpdateStrategyInMemoryWithCompression(
+				client,
+				sendconfig.NewGzipConfigService(client),
+				sendconfig.DefaultContentToDBLessConfigConverter{},
+				logr.Discard(),
+			)
+			require.Error(t, s.Update(context.Background(), sendconfig.ContentWithHash{Content: &file.Content{FormatVersion: "3.0"}}))
+			require.Equal(t, []string{"gzip"}, contentEncodings)
+			_, ok := s.LastPayloadStats()
+			require.False(t, ok, "no stats expected for a rejected payload")
+		})
+	}
+}
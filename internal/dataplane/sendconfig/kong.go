@@ -1,6 +1,8 @@
 package sendconfig
 
 import (
+	"time"
+
 	"github.com/blang/semver/v4"
 )
 
@@ -14,6 +16,9 @@ type Config struct {
 	// It's not relevant for Konnect client.
 	InMemory bool
 
+	// CompressDBLessConfig tells whether configuration sent to Kong Gateways in DB-less mode should be gzip-compressed.
+	CompressDBLessConfig bool
+
 	// Concurrency defines how many concurrent goroutines should be used when syncing configuration in DB-mode.
 	Concurrency int
 
@@ -41,4 +46,27 @@ type Config struct {
 	// UseLastValidConfigForFallback indicates whether to use the last valid config cache to backfill broken objects
 	// when recovering from a config push failure.
 	UseLastValidConfigForFallback bool
+
+	// StagedRollout configures pushing configuration to a subset of gateways first in DB-less mode.
+	StagedRollout StagedRolloutConfig
+}
+
+// StagedRolloutConfig configures staged (canary) rollouts of configuration in DB-less mode. A new configuration is
+// pushed to CanaryReplicas gateways first. It's pushed to the rest of the gateways only if the canaries stay healthy
+// for SoakPeriod. Otherwise, the canaries are reverted to the last valid configuration.
+type StagedRolloutConfig struct {
+	// CanaryReplicas is the number of gateways receiving a new configuration first. 0 disables staged rollouts.
+	CanaryReplicas int
+
+	// SoakPeriod is how long canaries have to stay healthy before the configuration is pushed to the rest of the gateways.
+	SoakPeriod time.Duration
+
+	// HealthCheckURLs are URLs that have to respond with a status code lower than 500 during the soak period
+	// in addition to the canaries' Admin API /status endpoint.
+	HealthCheckURLs []string
+}
+
+// Enabled returns true if staged rollouts are enabled.
+func (c StagedRolloutConfig) Enabled() bool {
+	return c.CanaryReplicas > 0
 }
@@ -82,6 +82,14 @@ func PerformUpdate(
 	})
 	duration := time.Since(timeStart)
 
+	if withPayloadStats, ok := updateStrategy.(UpdateStrategyWithPayloadStats); ok {
+		if stats, ok := withPayloadStats.LastPayloadStats(); ok {
+			promMetrics.RecordPushPayload(client.BaseRootURL(), isFallback, stats.Compressed, stats.ConfigSize, stats.PayloadSize, stats.MaxBufferedSize)
+			logger.V(logging.DebugLevel).Info("Sent configuration payload",
+				"config_size", stats.ConfigSize, "payload_size", stats.PayloadSize, "compressed", stats.Compressed)
+		}
+	}
+
 	metricsProtocol := updateStrategy.MetricsProtocol()
 	if err != nil {
 		// For UpdateError, record the failure and return the error.
@@ -37,6 +37,26 @@ type UpdateStrategy interface {
 	Type() string
 }
 
+// PayloadStats describes the configuration payload sent to a data-plane in a single update.
+type PayloadStats struct {
+	// ConfigSize is the size of the marshaled configuration in bytes.
+	ConfigSize int64
+	// PayloadSize is the number of bytes sent in the request body (after compression, if any).
+	PayloadSize int64
+	// MaxBufferedSize is the size of the largest part of the marshaled configuration held in memory at once.
+	MaxBufferedSize int64
+	// Compressed tells whether the payload was gzip-compressed.
+	Compressed bool
+}
+
+// UpdateStrategyWithPayloadStats is an UpdateStrategy that can report stats of the payload it sent in the last update.
+type UpdateStrategyWithPayloadStats interface {
+	UpdateStrategy
+
+	// LastPayloadStats returns the stats of the payload sent in the last update, or false if nothing was sent.
+	LastPayloadStats() (PayloadStats, bool)
+}
+
 type UpdateClient interface {
 	IsKonnect() bool
 	KonnectControlPlane() string
@@ -61,12 +81,16 @@ type ResourceError struct {
 type DefaultUpdateStrategyResolver struct {
 	config Config
 	logger logr.Logger
+
+	// gzipSupport remembers gateways that couldn't decode gzip-compressed configuration.
+	gzipSupport *gzipSupportByGateway
 }
 
 func NewDefaultUpdateStrategyResolver(config Config, logger logr.Logger) DefaultUpdateStrategyResolver {
 	return DefaultUpdateStrategyResolver{
-		config: config,
-		logger: logger,
+		config:      config,
+		logger:      logger,
+		gzipSupport: newGzipSupportByGateway(),
 	}
 }
 
@@ -118,6 +142,18 @@ func (r DefaultUpdateStrategyResolver) resolveUpdateStrategy(client UpdateClient
 		)
 	}
 
+	if r.config.CompressDBLessConfig {
+		s := NewUpdateStrategyInMemoryWithCompression(
+			adminAPIClient,
+			NewGzipConfigService(adminAPIClient),
+			DefaultContentToDBLessConfigConverter{},
+			r.logger,
+		)
+		// Strategies are resolved for every update, share the gateway's gzip support between them.
+		s.gzipSupport = r.gzipSupport.get(adminAPIClient.BaseRootURL())
+		return s
+	}
+
 	return NewUpdateStrategyInMemory(
 		adminAPIClient,
 		DefaultContentToDBLessConfigConverter{},
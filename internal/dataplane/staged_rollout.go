@@ -0,0 +1,370 @@
+package dataplane
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/samber/lo"
+	"github.com/sourcegraph/conc/iter"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/adminapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/deckgen"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/sendconfig"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/diagnostics"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/logging"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/metrics"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
+)
+
+const (
+	// DefaultStagedRolloutSoakPeriod is the default period canary gateways have to stay healthy for after
+	// receiving a new configuration before it's pushed to the rest of the gateways.
+	DefaultStagedRolloutSoakPeriod = 30 * time.Second
+
+	// stagedRolloutHealthCheckInterval is the interval of checking canaries' health during a soak period.
+	stagedRolloutHealthCheckInterval = 5 * time.Second
+)
+
+// StagedRolloutHealthChecker checks whether gateways that received a new configuration first in a staged rollout
+// are healthy.
+type StagedRolloutHealthChecker interface {
+	CheckHealth(ctx context.Context, canaries []*adminapi.Client) error
+}
+
+// DefaultStagedRolloutHealthChecker considers canaries healthy when their Admin API /status endpoint responds
+// successfully and all the configured health check URLs respond with a status code lower than 500.
+type DefaultStagedRolloutHealthChecker struct {
+	healthCheckURLs []string
+	httpClient      *http.Client
+}
+
+// NewDefaultStagedRolloutHealthChecker creates a DefaultStagedRolloutHealthChecker checking the given URLs with
+// the given timeout.
+func NewDefaultStagedRolloutHealthChecker(healthCheckURLs []string, timeout time.Duration) *DefaultStagedRolloutHealthChecker {
+	return &DefaultStagedRolloutHealthChecker{
+		healthCheckURLs: healthCheckURLs,
+		httpClient:      &http.Client{Timeout: timeout},
+	}
+}
+
+// CheckHealth returns an error if any of the canaries or health check URLs is not healthy.
+func (h *DefaultStagedRolloutHealthChecker) CheckHealth(ctx context.Context, canaries []*adminapi.Client) error {
+	for _, canary := range canaries {
+		if _, err := canary.AdminAPIClient().Status(ctx); err != nil {
+			return fmt.Errorf("gateway %s status check failed: %w", canary.BaseRootURL(), err)
+		}
+	}
+	for _, url := range h.healthCheckURLs {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return fmt.Errorf("failed to create health check request for %s: %w", url, err)
+		}
+		resp, err := h.httpClient.Do(req)
+		if err != nil {
+			return fmt.Errorf("health check %s failed: %w", url, err)
+		}
+		_ = resp.Body.Close()
+		if resp.StatusCode >= http.StatusInternalServerError {
+			return fmt.Errorf("health check %s failed: unexpected status code %d", url, resp.StatusCode)
+		}
+	}
+	return nil
+}
+
+// StagedRolloutError is returned when canaries turned out to be unhealthy during a staged rollout,
+// and they were reverted to the last valid configuration.
+type StagedRolloutError struct {
+	err error
+}
+
+func (e StagedRolloutError) Error() string {
+	return fmt.Sprintf("staged rollout rolled back: %s", e.err)
+}
+
+func (e StagedRolloutError) Unwrap() error {
+	return e.err
+}
+
+// shouldPerformStagedRollout tells whether the configuration should be pushed to gateways in stages.
+// Staged rollouts are performed only in DB-less mode (in DB mode all the gateways share the configuration),
+// for regular (not fallback) configuration and only when there's a last valid configuration to revert to.
+func (c *KongClient) shouldPerformStagedRollout(gatewayClients []*adminapi.Client, isFallback bool) bool {
+	rolloutConfig := c.kongConfig.StagedRollout
+	if !rolloutConfig.Enabled() || c.dbmode.IsDBBacked() || isFallback {
+		return false
+	}
+	if len(gatewayClients) <= rolloutConfig.CanaryReplicas {
+		return false
+	}
+	_, hasLastValidConfig := c.kongConfigFetcher.LastValidConfig()
+	return hasLastValidConfig
+}
+
+// errStagedRolloutInProgress is returned when the configuration was pushed to the canaries and the rest of the staged
+// rollout continues in the background. It's not a failure: the configuration is recorded as applied by one of the
+// following updates, once the rollout completes.
+var errStagedRolloutInProgress = errors.New("staged rollout in progress")
+
+// stagedRollout is a staged rollout of a configuration running in the background.
+type stagedRollout struct {
+	// configSHA is the SHA of the configuration being rolled out.
+	configSHA string
+	// cancel stops the rollout.
+	cancel context.CancelFunc
+	// done is closed when the rollout finishes.
+	done chan struct{}
+}
+
+// sendOutToGatewayClientsInStages pushes the configuration to the canary gateways first and starts a staged rollout
+// in the background. It returns errStagedRolloutInProgress when the rollout was started or a rollout of the same
+// configuration is already in progress, and StagedRolloutError when the configuration was already rolled back.
+// When the canaries already run the configuration, it's pushed to the rest of the gateways right away.
+// Canaries are the first gateways sorted by their URLs, so they stay the same as long as the set of gateways does.
+func (c *KongClient) sendOutToGatewayClientsInStages(
+	ctx context.Context,
+	gatewayClients []*adminapi.Client,
+	s *kongstate.KongState,
+	config sendconfig.Config,
+) ([]string, error) {
+	gatewayClients = slices.Clone(gatewayClients)
+	sort.Slice(gatewayClients, func(i, j int) bool {
+		return gatewayClients[i].BaseRootURL() < gatewayClients[j].BaseRootURL()
+	})
+	canaries := gatewayClients[:config.StagedRollout.CanaryReplicas]
+	rest := gatewayClients[config.StagedRollout.CanaryReplicas:]
+	canaryURLs := lo.Map(canaries, func(cl *adminapi.Client, _ int) string { return cl.BaseRootURL() })
+	logger := c.logger.WithValues("canaries", canaryURLs)
+
+	_, targetContent, customEntities := generateTargetContent(ctx, logger, canaries[0], s, config)
+	configSHA, err := deckgen.GenerateSHA(targetContent, customEntities)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate SHA for target content: %w", err)
+	}
+	c.stagedRolloutLock.Lock()
+	inProgress, rolledBackConfigSHA := c.stagedRolloutInProgress, c.rolledBackConfigSHA
+	c.stagedRolloutLock.Unlock()
+	if inProgress != nil && inProgress.configSHA == string(configSHA) {
+		logger.V(logging.DebugLevel).Info("Staged rollout of the configuration is already in progress", "sha", string(configSHA))
+		return nil, errStagedRolloutInProgress
+	}
+	if rolledBackConfigSHA == string(configSHA) {
+		return nil, StagedRolloutError{
+			err: fmt.Errorf("configuration %s was rolled back, it won't be pushed to canaries again until it changes", configSHA),
+		}
+	}
+	// The new configuration supersedes the one being rolled out.
+	c.stopStagedRollout()
+
+	// The last valid configuration is taken now as it may change while the rollout runs in the background.
+	lastValidConfig, _ := c.kongConfigFetcher.LastValidConfig()
+
+	previousSHAs := lo.Map(canaries, func(cl *adminapi.Client, _ int) []byte { return cl.LastConfigSHA() })
+	const isFallback = false
+	canarySHAs, err := iter.MapErr(canaries, func(client **adminapi.Client) (string, error) {
+		return c.sendToClient(ctx, *client, s, config, isFallback)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// When the canaries already run this configuration (e.g. its staged rollout has just been completed),
+	// there's nothing to soak.
+	configChanged := lo.SomeBy(lo.Range(len(canaries)), func(i int) bool {
+		return !bytes.Equal(previousSHAs[i], []byte(canarySHAs[i]))
+	})
+	if !configChanged {
+		restSHAs, err := iter.MapErr(rest, func(client **adminapi.Client) (string, error) {
+			return c.sendToClient(ctx, *client, s, config, isFallback)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return append(canarySHAs, restSHAs...), nil
+	}
+
+	rolloutCtx, cancel := context.WithCancel(ctx)
+	rollout := &stagedRollout{
+		configSHA: string(configSHA),
+		cancel:    cancel,
+		done:      make(chan struct{}),
+	}
+	c.stagedRolloutLock.Lock()
+	c.stagedRolloutInProgress = rollout
+	c.rolledBackConfigSHA = ""
+	c.stagedRolloutLock.Unlock()
+
+	startedAt := time.Now()
+	c.prometheusMetrics.RecordStagedRolloutStarted()
+	c.sendStagedRolloutDiagnostics(ctx, diagnostics.StagedRolloutStatus{
+		Phase:     diagnostics.StagedRolloutPhaseSoaking,
+		Canaries:  canaryURLs,
+		StartedAt: &startedAt,
+	})
+	go func() {
+		defer close(rollout.done)
+		defer cancel()
+		c.runStagedRollout(rolloutCtx, logger, rollout, canaries, rest, s, lastValidConfig, config, startedAt)
+	}()
+	return nil, errStagedRolloutInProgress
+}
+
+// runStagedRollout waits for the soak period checking the canaries' health. When they stay healthy, the configuration
+// is pushed to the rest of the gateways. Otherwise, the canaries are reverted to the last valid configuration and
+// the configuration is remembered as rolled back. Once finished, an update is requested so that its result is
+// recorded. It doesn't access fields guarded by the KongClient lock, so it can run without it being held.
+func (c *KongClient) runStagedRollout(
+	ctx context.Context,
+	logger logr.Logger,
+	rollout *stagedRollout,
+	canaries []*adminapi.Client,
+	rest []*adminapi.Client,
+	s *kongstate.KongState,
+	lastValidConfig *kongstate.KongState,
+	config sendconfig.Config,
+	startedAt time.Time,
+) {
+	canaryURLs := lo.Map(canaries, func(cl *adminapi.Client, _ int) string { return cl.BaseRootURL() })
+	phase, rolloutErr := diagnostics.StagedRolloutPhaseCompleted, error(nil)
+	defer func() {
+		c.stagedRolloutLock.Lock()
+		if c.stagedRolloutInProgress == rollout {
+			c.stagedRolloutInProgress = nil
+		}
+		if phase == diagnostics.StagedRolloutPhaseRolledBack {
+			c.rolledBackConfigSHA = rollout.configSHA
+		}
+		c.stagedRolloutLock.Unlock()
+		c.finishStagedRollout(canaryURLs, startedAt, phase, rolloutErr)
+		if phase != diagnostics.StagedRolloutPhaseCanceled {
+			c.configChanges.requestUpdate()
+		}
+	}()
+
+	if err := c.soakCanaries(ctx, canaries, config.StagedRollout.SoakPeriod); err != nil {
+		if ctx.Err() != nil {
+			logger.Info("Staged rollout canceled, the configuration was superseded")
+			phase, rolloutErr = diagnostics.StagedRolloutPhaseCanceled, ctx.Err()
+			return
+		}
+		logger.Error(err, "Canaries are unhealthy after receiving new configuration, reverting them to the last valid configuration")
+		if rollbackErr := c.revertCanaries(ctx, canaries, lastValidConfig, config); rollbackErr != nil {
+			logger.Error(rollbackErr, "Failed to revert canaries")
+		}
+		phase, rolloutErr = diagnostics.StagedRolloutPhaseRolledBack, err
+		return
+	}
+
+	logger.Info("Canaries are healthy after receiving new configuration, pushing it to the rest of gateways")
+	// The rest of gateways are not marked as built from any cache snapshot, so the following update doesn't consider
+	// them in sync and records the configuration as applied.
+	const isFallback = false
+	if _, err := iter.MapErr(rest, func(client **adminapi.Client) (string, error) {
+		return c.sendToClientWithCacheStoresHash(ctx, *client, s, store.SnapshotHashEmpty, config, isFallback)
+	}); err != nil {
+		// The rollout is completed even if some of the rest of gateways failed, as the configuration proved to be
+		// healthy. They are retried by the following updates as any other configuration push error.
+		logger.Error(err, "Failed to push configuration to the rest of gateways")
+	}
+}
+
+// stopStagedRollout cancels the staged rollout in progress, if any, and waits for it to finish.
+func (c *KongClient) stopStagedRollout() {
+	c.stagedRolloutLock.Lock()
+	rollout := c.stagedRolloutInProgress
+	c.stagedRolloutLock.Unlock()
+	if rollout == nil {
+		return
+	}
+	rollout.cancel()
+	<-rollout.done
+}
+
+// soakCanaries checks the canaries' health periodically until the soak period passes. It returns the first
+// health check error.
+func (c *KongClient) soakCanaries(ctx context.Context, canaries []*adminapi.Client, soakPeriod time.Duration) error {
+	ticker := time.NewTicker(min(stagedRolloutHealthCheckInterval, soakPeriod))
+	defer ticker.Stop()
+	soakPeriodEnd := time.NewTimer(soakPeriod)
+	defer soakPeriodEnd.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if err := c.stagedRolloutHealthChecker.CheckHealth(ctx, canaries); err != nil {
+				return err
+			}
+		case <-soakPeriodEnd.C:
+			return c.stagedRolloutHealthChecker.CheckHealth(ctx, canaries)
+		}
+	}
+}
+
+// revertCanaries pushes the last valid configuration to the canaries. They are not marked as built from any cache
+// snapshot, so they're not considered in sync with the current one.
+func (c *KongClient) revertCanaries(
+	ctx context.Context,
+	canaries []*adminapi.Client,
+	lastValidConfig *kongstate.KongState,
+	config sendconfig.Config,
+) error {
+	if lastValidConfig == nil {
+		return errors.New("no last valid configuration to revert canaries to")
+	}
+	const isFallback = true
+	_, err := iter.MapErr(canaries, func(client **adminapi.Client) (string, error) {
+		return c.sendToClientWithCacheStoresHash(ctx, *client, lastValidConfig, store.SnapshotHashEmpty, config, isFallback)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to revert canaries to the last valid configuration: %w", err)
+	}
+	return nil
+}
+
+// finishStagedRollout records the result of a staged rollout in metrics and diagnostics. The error describes why
+// the rollout was rolled back or canceled.
+func (c *KongClient) finishStagedRollout(canaryURLs []string, startedAt time.Time, phase diagnostics.StagedRolloutPhase, err error) {
+	now := time.Now()
+	status := diagnostics.StagedRolloutStatus{
+		Phase:      phase,
+		Canaries:   canaryURLs,
+		StartedAt:  &startedAt,
+		FinishedAt: &now,
+	}
+	if err != nil {
+		status.Error = err.Error()
+	}
+	result := metrics.StagedRolloutResultCompleted
+	switch phase {
+	case diagnostics.StagedRolloutPhaseRolledBack:
+		result = metrics.StagedRolloutResultRolledBack
+	case diagnostics.StagedRolloutPhaseCanceled:
+		result = metrics.StagedRolloutResultCanceled
+	}
+	c.prometheusMetrics.RecordStagedRolloutFinished(result)
+
+	// The context of the rollout may be already done at this point, the status should be recorded anyway.
+	c.sendStagedRolloutDiagnostics(context.Background(), status)
+}
+
+// sendStagedRolloutDiagnostics ships the staged rollout status to the diagnostics server if it's enabled.
+func (c *KongClient) sendStagedRolloutDiagnostics(ctx context.Context, status diagnostics.StagedRolloutStatus) {
+	if ch := c.diagnostic.StagedRollouts; ch != nil {
+		select {
+		case ch <- status:
+			c.logger.V(logging.DebugLevel).Info("Shipping staged rollout status to diagnostics server", "phase", status.Phase)
+		case <-ctx.Done():
+		default:
+			c.logger.Error(nil, "Staged rollout status buffer full, dropping diagnostics")
+		}
+	}
+}
@@ -0,0 +1,340 @@
+package dataplane
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kong/go-database-reconciler/pkg/file"
+	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/adminapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/sendconfig"
+)
+
+// mockStagedRolloutHealthChecker is a mock implementation of StagedRolloutHealthChecker.
+type mockStagedRolloutHealthChecker struct {
+	err error
+
+	lock             sync.Mutex
+	checkedCanaries  [][]string
+	updatesWhenCheck []int
+	updatesCount     func() int
+}
+
+func (m *mockStagedRolloutHealthChecker) CheckHealth(_ context.Context, canaries []*adminapi.Client) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.checkedCanaries = append(m.checkedCanaries, lo.Map(canaries, func(c *adminapi.Client, _ int) string {
+		return c.BaseRootURL()
+	}))
+	m.updatesWhenCheck = append(m.updatesWhenCheck, m.updatesCount())
+	return m.err
+}
+
+// shaConfigurationChangeDetector is a ConfigurationChangeDetector detecting changes by comparing SHAs only.
+type shaConfigurationChangeDetector struct{}
+
+func (shaConfigurationChangeDetector) HasConfigurationChanged(
+	_ context.Context, oldSHA, newSHA []byte, _ *file.Content, _ sendconfig.KonnectAwareClient, _ sendconfig.StatusClient,
+) (bool, error) {
+	return !bytes.Equal(oldSHA, newSHA), nil
+}
+
+// waitForStagedRollout waits for the staged rollout running in the background to finish.
+func waitForStagedRollout(t *testing.T, kongClient *KongClient) {
+	t.Helper()
+	kongClient.stagedRolloutLock.Lock()
+	rollout := kongClient.stagedRolloutInProgress
+	kongClient.stagedRolloutLock.Unlock()
+	require.NotNil(t, rollout, "expected a staged rollout in progress")
+	select {
+	case <-rollout.done:
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "staged rollout did not finish in time")
+	}
+}
+
+func TestKongClientUpdate_StagedRollout(t *testing.T) {
+	var (
+		lastKongState = &kongstate.KongState{
+			Services: []kongstate.Service{
+				{Service: kong.Service{Name: kong.String("last_service")}},
+			},
+		}
+		newKongState = &kongstate.KongState{
+			Services: []kongstate.Service{
+				{Service: kong.Service{Name: kong.String("new_service")}},
+			},
+		}
+	)
+
+	testCases := []struct {
+		name                  string
+		healthCheckErr        error
+		expectCanaryUpdates   int
+		expectRestUpdated     bool
+		expectedLastKongState *kongstate.KongState
+	}{
+		{
+			name:                  "healthy canaries, configuration is pushed to the rest of gateways",
+			expectCanaryUpdates:   1,
+			expectRestUpdated:     true,
+			expectedLastKongState: newKongState,
+		},
+		{
+			name:                  "unhealthy canaries, canaries are reverted and the rest of gateways is not updated",
+			healthCheckErr:        errors.New("canary is unhealthy"),
+			expectCanaryUpdates:   2,
+			expectRestUpdated:     false,
+			expectedLastKongState: lastKongState,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clientsProvider := &mockGatewayClientsProvider{
+				gatewayClients: []*adminapi.Client{
+					mustSampleGatewayClient(t),
+					mustSampleGatewayClient(t),
+					mustSampleGatewayClient(t),
+				},
+			}
+			urls := mapClientsToUrls(clientsProvider)
+			sort.Strings(urls)
+			canaryURL, restURLs := urls[0], urls[1:]
+
+			updateStrategyResolver := newMockUpdateStrategyResolver(t)
+			configBuilder := newMockKongConfigBuilder()
+			configBuilder.kongState = newKongState
+			kongClient := setupTestKongClient(
+				t,
+				updateStrategyResolver,
+				clientsProvider,
+				shaConfigurationChangeDetector{},
+				configBuilder,
+				nil,
+				&mockKongLastValidConfigFetcher{lastKongState: lastKongState},
+			)
+			kongClient.kongConfig.StagedRollout = sendconfig.StagedRolloutConfig{
+				CanaryReplicas: 1,
+				SoakPeriod:     10 * time.Millisecond,
+			}
+			healthChecker := &mockStagedRolloutHealthChecker{
+				err: tc.healthCheckErr,
+				updatesCount: func() int {
+					updateStrategyResolver.lock.RLock()
+					defer updateStrategyResolver.lock.RUnlock()
+					return len(updateStrategyResolver.updateCalledForURLs)
+				},
+			}
+			kongClient.stagedRolloutHealthChecker = healthChecker
+
+			t.Log("Pushing the configuration to the canary, the rest of the rollout continues in the background")
+			ctx := context.Background()
+			require.NoError(t, kongClient.Update(ctx))
+			waitForStagedRollout(t, kongClient)
+
+			t.Log("Updating again to record the result of the rollout")
+			err := kongClient.Update(ctx)
+			if tc.healthCheckErr != nil {
+				require.ErrorAs(t, err, &StagedRolloutError{})
+			} else {
+				require.NoError(t, err)
+			}
+
+			t.Log("Verifying only the canary was checked and it had been the only updated gateway at that time")
+			require.NotEmpty(t, healthChecker.checkedCanaries)
+			for i, checked := range healthChecker.checkedCanaries {
+				require.Equal(t, []string{canaryURL}, checked)
+				require.Equal(t, 1, healthChecker.updatesWhenCheck[i])
+			}
+
+			t.Log("Verifying which gateways were updated")
+			require.Equal(t, canaryURL, updateStrategyResolver.updateCalledForURLs[0])
+			require.Equal(t, tc.expectCanaryUpdates, lo.Count(updateStrategyResolver.updateCalledForURLs, canaryURL))
+			for _, url := range restURLs {
+				require.Equal(t, tc.expectRestUpdated, lo.Contains(updateStrategyResolver.updateCalledForURLs, url))
+			}
+
+			s, _ := kongClient.kongConfigFetcher.LastValidConfig()
+			require.Equal(t, tc.expectedLastKongState, s)
+		})
+	}
+}
+
+func TestKongClientUpdate_StagedRolloutSkipsSoakingWhenConfigurationIsUnchanged(t *testing.T) {
+	clientsProvider := &mockGatewayClientsProvider{
+		gatewayClients: []*adminapi.Client{
+			mustSampleGatewayClient(t),
+			mustSampleGatewayClient(t),
+		},
+	}
+	updateStrategyResolver := newMockUpdateStrategyResolver(t)
+	kongClient := setupTestKongClient(
+		t,
+		updateStrategyResolver,
+		clientsProvider,
+		mockConfigurationChangeDetector{hasConfigurationChanged: true},
+		newMockKongConfigBuilder(),
+		nil,
+		&mockKongLastValidConfigFetcher{lastKongState: &kongstate.KongState{}},
+	)
+	healthChecker := &mockStagedRolloutHealthChecker{updatesCount: func() int { return 0 }}
+	kongClient.stagedRolloutHealthChecker = healthChecker
+
+	t.Log("Pushing the configuration to all gateways with staged rollouts disabled")
+	ctx := context.Background()
+	require.NoError(t, kongClient.Update(ctx))
+
+	t.Log("Pushing the same configuration with staged rollouts enabled, canaries should not be soaked")
+	kongClient.kongConfig.StagedRollout = sendconfig.StagedRolloutConfig{
+		CanaryReplicas: 1,
+		SoakPeriod:     time.Hour,
+	}
+	require.NoError(t, kongClient.Update(ctx))
+	require.Empty(t, healthChecker.checkedCanaries)
+}
+
+func TestKongClientUpdate_StagedRolloutDoesNotPushRolledBackConfigurationAgain(t *testing.T) {
+	clientsProvider := &mockGatewayClientsProvider{
+		gatewayClients: []*adminapi.Client{
+			mustSampleGatewayClient(t),
+			mustSampleGatewayClient(t),
+		},
+	}
+	urls := mapClientsToUrls(clientsProvider)
+	sort.Strings(urls)
+	canaryURL, restURL := urls[0], urls[1]
+
+	updateStrategyResolver := newMockUpdateStrategyResolver(t)
+	configBuilder := newMockKongConfigBuilder()
+	configBuilder.kongState = &kongstate.KongState{
+		Services: []kongstate.Service{
+			{Service: kong.Service{Name: kong.String("broken_service")}},
+		},
+	}
+	kongClient := setupTestKongClient(
+		t,
+		updateStrategyResolver,
+		clientsProvider,
+		shaConfigurationChangeDetector{},
+		configBuilder,
+		nil,
+		&mockKongLastValidConfigFetcher{lastKongState: &kongstate.KongState{}},
+	)
+	kongClient.kongConfig.StagedRollout = sendconfig.StagedRolloutConfig{
+		CanaryReplicas: 1,
+		SoakPeriod:     10 * time.Millisecond,
+	}
+	healthChecker := &mockStagedRolloutHealthChecker{
+		err:          errors.New("canary is unhealthy"),
+		updatesCount: func() int { return 0 },
+	}
+	kongClient.stagedRolloutHealthChecker = healthChecker
+	canaryUpdates := func() int {
+		updateStrategyResolver.lock.RLock()
+		defer updateStrategyResolver.lock.RUnlock()
+		return lo.Count(updateStrategyResolver.updateCalledForURLs, canaryURL)
+	}
+
+	t.Log("Rolling out the configuration, the canary is unhealthy and gets reverted")
+	ctx := context.Background()
+	require.NoError(t, kongClient.Update(ctx))
+	waitForStagedRollout(t, kongClient)
+	require.Equal(t, 2, canaryUpdates())
+
+	t.Log("Updating with the same configuration, it's not pushed to the canary again")
+	for range 3 {
+		err := kongClient.Update(ctx)
+		require.ErrorAs(t, err, &StagedRolloutError{})
+	}
+	require.Equal(t, 2, canaryUpdates())
+	require.NotContains(t, updateStrategyResolver.updateCalledForURLs, restURL)
+
+	t.Log("Changing the configuration, it's rolled out again")
+	configBuilder.kongState = &kongstate.KongState{
+		Services: []kongstate.Service{
+			{Service: kong.Service{Name: kong.String("fixed_service")}},
+		},
+	}
+	healthChecker.lock.Lock()
+	healthChecker.err = nil
+	healthChecker.lock.Unlock()
+	require.NoError(t, kongClient.Update(ctx))
+	waitForStagedRollout(t, kongClient)
+	require.NoError(t, kongClient.Update(ctx))
+	require.Equal(t, 3, canaryUpdates())
+	require.Contains(t, updateStrategyResolver.updateCalledForURLs, restURL)
+	s, _ := kongClient.kongConfigFetcher.LastValidConfig()
+	require.Equal(t, configBuilder.kongState, s)
+}
+
+func TestKongClientUpdate_StagedRolloutIsSupersededByNewConfiguration(t *testing.T) {
+	clientsProvider := &mockGatewayClientsProvider{
+		gatewayClients: []*adminapi.Client{
+			mustSampleGatewayClient(t),
+			mustSampleGatewayClient(t),
+		},
+	}
+	updateStrategyResolver := newMockUpdateStrategyResolver(t)
+	configBuilder := newMockKongConfigBuilder()
+	configBuilder.kongState = &kongstate.KongState{
+		Services: []kongstate.Service{
+			{Service: kong.Service{Name: kong.String("first_service")}},
+		},
+	}
+	kongClient := setupTestKongClient(
+		t,
+		updateStrategyResolver,
+		clientsProvider,
+		shaConfigurationChangeDetector{},
+		configBuilder,
+		nil,
+		&mockKongLastValidConfigFetcher{lastKongState: &kongstate.KongState{}},
+	)
+	kongClient.kongConfig.StagedRollout = sendconfig.StagedRolloutConfig{
+		CanaryReplicas: 1,
+		SoakPeriod:     time.Hour,
+	}
+	kongClient.stagedRolloutHealthChecker = &mockStagedRolloutHealthChecker{updatesCount: func() int { return 0 }}
+	t.Cleanup(kongClient.stopStagedRollout)
+
+	t.Log("Rolling out the configuration, Update returns without waiting for the soak period")
+	ctx := context.Background()
+	require.NoError(t, kongClient.Update(ctx))
+	kongClient.stagedRolloutLock.Lock()
+	first := kongClient.stagedRolloutInProgress
+	kongClient.stagedRolloutLock.Unlock()
+	require.NotNil(t, first)
+
+	t.Log("Updating with the same configuration, the rollout in progress is kept")
+	require.NoError(t, kongClient.Update(ctx))
+	kongClient.stagedRolloutLock.Lock()
+	require.Same(t, first, kongClient.stagedRolloutInProgress)
+	kongClient.stagedRolloutLock.Unlock()
+
+	t.Log("Changing the configuration, the rollout in progress is canceled and a new one is started")
+	configBuilder.kongState = &kongstate.KongState{
+		Services: []kongstate.Service{
+			{Service: kong.Service{Name: kong.String("second_service")}},
+		},
+	}
+	require.NoError(t, kongClient.Update(ctx))
+	<-first.done
+	kongClient.stagedRolloutLock.Lock()
+	second := kongClient.stagedRolloutInProgress
+	kongClient.stagedRolloutLock.Unlock()
+	require.NotNil(t, second)
+	require.NotEqual(t, first.configSHA, second.configSHA)
+
+	s, _ := kongClient.kongConfigFetcher.LastValidConfig()
+	require.Equal(t, &kongstate.KongState{}, s, "the last valid configuration must not change before the rollout completes")
+}
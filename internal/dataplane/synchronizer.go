@@ -29,6 +29,14 @@ const (
 	DefaultSyncSeconds float32 = 3.0
 
 	DefaultCacheSyncWaitDuration = 5 * time.Second
+
+	// DefaultSyncDebounce is the default period without configuration changes after which the changes
+	// are applied to the data-plane.
+	DefaultSyncDebounce = time.Second
+
+	// DefaultSyncMaxDelay is the default maximum period configuration changes can be delayed for by
+	// debouncing when they keep coming.
+	DefaultSyncMaxDelay = 5 * time.Second
 )
 
 // -----------------------------------------------------------------------------
@@ -36,7 +44,7 @@ const (
 // -----------------------------------------------------------------------------
 
 // Synchronizer is a threadsafe object which starts a goroutine to updates
-// the data-plane at regular intervals.
+// the data-plane when the configuration changes and at regular intervals.
 type Synchronizer struct {
 	logger logr.Logger
 
@@ -51,6 +59,12 @@ type Synchronizer struct {
 	isServerRunning bool
 	initWaitPeriod  time.Duration
 
+	// syncDebounce is the period without configuration changes after which the data-plane is updated.
+	// Updates are triggered only by syncTicker when it's 0.
+	syncDebounce time.Duration
+	// syncMaxDelay is the maximum period the data-plane update can be delayed for by debouncing.
+	syncMaxDelay time.Duration
+
 	lock sync.RWMutex
 }
 
@@ -70,6 +84,16 @@ func WithInitCacheSyncDuration(period time.Duration) SynchronizerOption {
 	}
 }
 
+// WithSyncDebounce returns a SynchronizerOption which sets the period without configuration changes
+// after which the data-plane is updated and the maximum delay of the update caused by debouncing.
+// Setting debounce to 0 disables updates triggered by configuration changes.
+func WithSyncDebounce(debounce, maxDelay time.Duration) SynchronizerOption {
+	return func(s *Synchronizer) {
+		s.syncDebounce = debounce
+		s.syncMaxDelay = maxDelay
+	}
+}
+
 // NewSynchronizer will provide a new Synchronizer object with a specified
 // stagger time for data-plane updates to occur. Note that this starts some
 // background goroutines and the caller is resonsible for marking the provided
@@ -79,6 +103,8 @@ func NewSynchronizer(logger logr.Logger, client Client, opts ...SynchronizerOpti
 		logger:          logger,
 		stagger:         time.Duration(DefaultSyncSeconds),
 		initWaitPeriod:  DefaultCacheSyncWaitDuration,
+		syncDebounce:    DefaultSyncDebounce,
+		syncMaxDelay:    DefaultSyncMaxDelay,
 		dataplaneClient: client,
 		configApplied:   false,
 		dbMode:          client.DBMode(),
@@ -96,8 +122,9 @@ func NewSynchronizer(logger logr.Logger, client Client, opts ...SynchronizerOpti
 // -----------------------------------------------------------------------------
 
 // Start starts the goroutine synchronization server that will perform an
-// Update() on the provided dataplane.Client according to the provided stagger
-// time, or using the DefaultSyncSeconds if not otherwise provided.
+// Update() on the provided dataplane.Client whenever it notifies about configuration
+// changes (debounced) and periodically according to the provided stagger time,
+// or using the DefaultSyncSeconds if not otherwise provided.
 //
 // To stop the server, the provided context must be Done().
 func (p *Synchronizer) Start(ctx context.Context) error {
@@ -160,9 +187,30 @@ func (p *Synchronizer) NeedLeaderElection() bool {
 // -----------------------------------------------------------------------------
 
 // startUpdateServer runs a server in a background goroutine that is responsible for
-// updating the kong proxy backend at regular intervals.
+// updating the kong proxy backend on configuration changes and at regular intervals.
+// Configuration changes are debounced: the update happens after syncDebounce passes without
+// further changes, but no later than syncMaxDelay after the first of them.
 func (p *Synchronizer) startUpdateServer(ctx context.Context) {
-	var initialConfig sync.Once
+	var (
+		initialConfig sync.Once
+		// changes stays nil (blocking forever) when updates on changes are not enabled.
+		changes <-chan struct{}
+		// debounceC and maxDelayC are set only when there are changes waiting for an update.
+		debounceC <-chan time.Time
+		maxDelayC <-chan time.Time
+	)
+	if notifier, ok := p.dataplaneClient.(ConfigChangesNotifier); ok && p.syncDebounce > 0 {
+		changes = notifier.ConfigChanges()
+	}
+	update := func() {
+		debounceC, maxDelayC = nil, nil
+		if err := p.dataplaneClient.Update(ctx); err != nil {
+			p.logger.Error(err, "Could not update kong admin")
+			return
+		}
+		initialConfig.Do(p.markConfigApplied)
+	}
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -179,12 +227,22 @@ func (p *Synchronizer) startUpdateServer(ctx context.Context) {
 
 			return
 
-		case <-p.syncTicker.C:
-			if err := p.dataplaneClient.Update(ctx); err != nil {
-				p.logger.Error(err, "Could not update kong admin")
-				continue
+		case <-changes:
+			debounceC = time.After(p.syncDebounce)
+			if maxDelayC == nil {
+				maxDelayC = time.After(p.syncMaxDelay)
 			}
-			initialConfig.Do(p.markConfigApplied)
+
+		case <-debounceC:
+			update()
+
+		case <-maxDelayC:
+			update()
+
+		// The ticker is kept as a periodic resync ensuring the data-plane converges also when there
+		// are no changes (e.g. after a failed update or a restart of Kong).
+		case <-p.syncTicker.C:
+			update()
 		}
 	}
 }
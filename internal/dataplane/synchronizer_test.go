@@ -135,6 +135,107 @@ func TestSynchronizer_IsReadyDoesntBlockWhenDataPlaneIsBlocked(t *testing.T) {
 	}
 }
 
+func TestSynchronizer_UpdatesOnConfigChanges(t *testing.T) {
+	const (
+		// The ticker is effectively disabled so that only configuration changes trigger updates.
+		stagger  = time.Hour
+		debounce = 50 * time.Millisecond
+		maxDelay = 200 * time.Millisecond
+	)
+
+	newStartedSynchronizer := func(t *testing.T) *fakeNotifyingDataplaneClient {
+		c := &fakeNotifyingDataplaneClient{
+			fakeDataplaneClient: fakeDataplaneClient{dbmode: dpconf.DBModeOff, t: t},
+			changes:             make(chan struct{}, 1),
+		}
+		s, err := NewSynchronizer(
+			zapr.NewLogger(zap.NewNop()),
+			c,
+			WithStagger(stagger),
+			WithInitCacheSyncDuration(testSynchronizerTick),
+			WithSyncDebounce(debounce, maxDelay),
+		)
+		require.NoError(t, err)
+		ctx, cancel := context.WithCancel(context.Background())
+		t.Cleanup(cancel)
+		require.NoError(t, s.Start(ctx))
+		return c
+	}
+
+	t.Run("a change triggers an update after the debounce period", func(t *testing.T) {
+		c := newStartedSynchronizer(t)
+		c.notify()
+		require.Eventually(t, func() bool { return c.totalUpdates() == 1 }, time.Second, testSynchronizerTick)
+		assert.True(t, c.lastUpdateSinceChange() >= debounce, "update should wait for the debounce period")
+	})
+
+	t.Run("changes within the debounce period are coalesced into a single update", func(t *testing.T) {
+		c := newStartedSynchronizer(t)
+		for i := 0; i < 3; i++ {
+			c.notify()
+			time.Sleep(debounce / 5)
+		}
+		require.Eventually(t, func() bool { return c.totalUpdates() == 1 }, time.Second, testSynchronizerTick)
+		require.Never(t, func() bool { return c.totalUpdates() > 1 }, debounce*3, testSynchronizerTick)
+	})
+
+	t.Run("constantly incoming changes don't delay an update for longer than the max delay", func(t *testing.T) {
+		c := newStartedSynchronizer(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go func() {
+			ticker := time.NewTicker(debounce / 5)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					c.notify()
+				}
+			}
+		}()
+		require.Eventually(t, func() bool { return c.totalUpdates() >= 1 }, maxDelay*3, testSynchronizerTick)
+	})
+}
+
+// fakeNotifyingDataplaneClient is a fakeDataplaneClient which also implements ConfigChangesNotifier.
+type fakeNotifyingDataplaneClient struct {
+	fakeDataplaneClient
+	changes chan struct{}
+
+	changeLock   sync.Mutex
+	lastChangeAt time.Time
+	lastUpdateAt time.Time
+}
+
+func (c *fakeNotifyingDataplaneClient) ConfigChanges() <-chan struct{} {
+	return c.changes
+}
+
+func (c *fakeNotifyingDataplaneClient) Update(ctx context.Context) error {
+	c.changeLock.Lock()
+	c.lastUpdateAt = time.Now()
+	c.changeLock.Unlock()
+	return c.fakeDataplaneClient.Update(ctx)
+}
+
+func (c *fakeNotifyingDataplaneClient) notify() {
+	c.changeLock.Lock()
+	c.lastChangeAt = time.Now()
+	c.changeLock.Unlock()
+	select {
+	case c.changes <- struct{}{}:
+	default:
+	}
+}
+
+func (c *fakeNotifyingDataplaneClient) lastUpdateSinceChange() time.Duration {
+	c.changeLock.Lock()
+	defer c.changeLock.Unlock()
+	return c.lastUpdateAt.Sub(c.lastChangeAt)
+}
+
 // fakeDataplaneClient fakes the dataplane.Client interface so that we can
 // unit test the dataplane.Synchronizer.
 type fakeDataplaneClient struct {
@@ -0,0 +1,288 @@
+package atc
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// maxParsedClauses is the maximum number of clauses of the disjunctive normal form of a parsed expression.
+const maxParsedClauses = 1024
+
+// ErrExpressionTooComplex is returned when the disjunctive normal form of an expression has too many clauses.
+var ErrExpressionTooComplex = errors.New("expression has too many alternatives")
+
+// ParsedPredicate is a predicate of a parsed expression, possibly negated.
+type ParsedPredicate struct {
+	// Field is the left hand side of the predicate, including its transformations (e.g. lower(http.path)).
+	Field string
+	// Op is the operator of the predicate.
+	Op BinaryOperator
+	// Value is the right hand side of the predicate, unquoted when it's a string literal.
+	Value string
+	// StringValue tells whether the value is a string literal.
+	StringValue bool
+	// Negated tells whether the predicate is negated.
+	Negated bool
+}
+
+var _ Matcher = ParsedPredicate{}
+
+// Expression returns a string representation of the ParsedPredicate.
+func (p ParsedPredicate) Expression() string {
+	value := p.Value
+	if p.StringValue {
+		value = StringLiteral(p.Value).String()
+	}
+	expression := fmt.Sprintf("%s %s %s", p.Field, p.Op, value)
+	if p.Negated {
+		return fmt.Sprintf("!(%s)", expression)
+	}
+	return expression
+}
+
+// IsEmpty returns true if the ParsedPredicate has no field.
+func (p ParsedPredicate) IsEmpty() bool {
+	return p.Field == ""
+}
+
+// ParseDisjunctiveNormalForm parses a route expression into its disjunctive normal form: each of the returned
+// clauses is a conjunction of (possibly negated) predicates and the expression matches a request when any of
+// the clauses does.
+func ParseDisjunctiveNormalForm(expression string) ([][]ParsedPredicate, error) {
+	p := &expressionParser{expression: expression}
+	clauses, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpaces()
+	if p.pos != len(p.expression) {
+		return nil, p.errorf("unexpected %q", p.expression[p.pos:])
+	}
+	return clauses, nil
+}
+
+// expressionParser is a recursive descent parser of route expressions.
+type expressionParser struct {
+	expression string
+	pos        int
+}
+
+func (p *expressionParser) parseOr() ([][]ParsedPredicate, error) {
+	clauses, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.consume("||") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		clauses = append(clauses, right...)
+		if len(clauses) > maxParsedClauses {
+			return nil, ErrExpressionTooComplex
+		}
+	}
+	return clauses, nil
+}
+
+func (p *expressionParser) parseAnd() ([][]ParsedPredicate, error) {
+	clauses, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.consume("&&") {
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		if clauses, err = conjunction(clauses, right); err != nil {
+			return nil, err
+		}
+	}
+	return clauses, nil
+}
+
+func (p *expressionParser) parseUnary() ([][]ParsedPredicate, error) {
+	if p.peek("!") && !p.peek("!=") {
+		p.consume("!")
+		inner, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return negation(inner)
+	}
+	if p.consume("(") {
+		clauses, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.consume(")") {
+			return nil, p.errorf("missing closing parenthesis")
+		}
+		return clauses, nil
+	}
+	predicate, err := p.parsePredicate()
+	if err != nil {
+		return nil, err
+	}
+	return [][]ParsedPredicate{{predicate}}, nil
+}
+
+// operators are the binary operators in the order they're tried in, so no operator is mistaken for its prefix.
+var operators = []BinaryOperator{
+	OpNotIn, OpContains, OpIn, OpEqual, OpNotEqual, OpPrefixMatch, OpSuffixMatch,
+	OpLessEqual, OpGreaterEqual, OpRegexMatch, OpLessThan, OpGreaterThan,
+}
+
+func (p *expressionParser) parsePredicate() (ParsedPredicate, error) {
+	field, err := p.parseIdentifier()
+	if err != nil {
+		return ParsedPredicate{}, err
+	}
+	// Transformations, e.g. lower(http.path).
+	if p.consume("(") {
+		inner, err := p.parseIdentifier()
+		if err != nil {
+			return ParsedPredicate{}, err
+		}
+		if !p.consume(")") {
+			return ParsedPredicate{}, p.errorf("missing closing parenthesis of %s", field)
+		}
+		field = fmt.Sprintf("%s(%s)", field, inner)
+	}
+
+	predicate := ParsedPredicate{Field: field}
+	for _, op := range operators {
+		if p.consume(string(op)) {
+			predicate.Op = op
+			break
+		}
+	}
+	if predicate.Op == "" {
+		return ParsedPredicate{}, p.errorf("missing operator of %s", field)
+	}
+
+	p.skipSpaces()
+	if p.peek(`"`) {
+		value, err := p.parseStringLiteral()
+		if err != nil {
+			return ParsedPredicate{}, err
+		}
+		predicate.Value, predicate.StringValue = value, true
+		return predicate, nil
+	}
+	start := p.pos
+	for p.pos < len(p.expression) && !strings.ContainsRune(" \t\n()", rune(p.expression[p.pos])) {
+		p.pos++
+	}
+	if p.pos == start {
+		return ParsedPredicate{}, p.errorf("missing value of %s", field)
+	}
+	predicate.Value = p.expression[start:p.pos]
+	return predicate, nil
+}
+
+func (p *expressionParser) parseIdentifier() (string, error) {
+	p.skipSpaces()
+	start := p.pos
+	for p.pos < len(p.expression) {
+		c := p.expression[p.pos]
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '.') {
+			break
+		}
+		p.pos++
+	}
+	if p.pos == start {
+		return "", p.errorf("missing field")
+	}
+	return p.expression[start:p.pos], nil
+}
+
+// parseStringLiteral parses a string literal escaped the way StringLiteral.String escapes it.
+func (p *expressionParser) parseStringLiteral() (string, error) {
+	p.pos++ // Opening quote.
+	var value strings.Builder
+	for p.pos < len(p.expression) {
+		c := p.expression[p.pos]
+		p.pos++
+		switch c {
+		case '"':
+			return value.String(), nil
+		case '\\':
+			if p.pos == len(p.expression) {
+				return "", p.errorf("unterminated string literal")
+			}
+			escaped := p.expression[p.pos]
+			p.pos++
+			switch escaped {
+			case 'n':
+				value.WriteByte('\n')
+			case 'r':
+				value.WriteByte('\r')
+			case 't':
+				value.WriteByte('\t')
+			default:
+				value.WriteByte(escaped)
+			}
+		default:
+			value.WriteByte(c)
+		}
+	}
+	return "", p.errorf("unterminated string literal")
+}
+
+func (p *expressionParser) skipSpaces() {
+	for p.pos < len(p.expression) && strings.ContainsRune(" \t\n", rune(p.expression[p.pos])) {
+		p.pos++
+	}
+}
+
+func (p *expressionParser) peek(token string) bool {
+	p.skipSpaces()
+	return strings.HasPrefix(p.expression[p.pos:], token)
+}
+
+func (p *expressionParser) consume(token string) bool {
+	if !p.peek(token) {
+		return false
+	}
+	p.pos += len(token)
+	return true
+}
+
+func (p *expressionParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("invalid expression at position %d: %s", p.pos, fmt.Sprintf(format, args...))
+}
+
+// conjunction returns the disjunctive normal form of the conjunction of two expressions in disjunctive normal form.
+func conjunction(left, right [][]ParsedPredicate) ([][]ParsedPredicate, error) {
+	if len(left)*len(right) > maxParsedClauses {
+		return nil, ErrExpressionTooComplex
+	}
+	clauses := make([][]ParsedPredicate, 0, len(left)*len(right))
+	for _, l := range left {
+		for _, r := range right {
+			clause := make([]ParsedPredicate, 0, len(l)+len(r))
+			clauses = append(clauses, append(append(clause, l...), r...))
+		}
+	}
+	return clauses, nil
+}
+
+// negation returns the disjunctive normal form of the negation of an expression in disjunctive normal form.
+func negation(clauses [][]ParsedPredicate) ([][]ParsedPredicate, error) {
+	negated := [][]ParsedPredicate{{}}
+	for _, clause := range clauses {
+		alternatives := make([][]ParsedPredicate, 0, len(clause))
+		for _, predicate := range clause {
+			predicate.Negated = !predicate.Negated
+			alternatives = append(alternatives, []ParsedPredicate{predicate})
+		}
+		var err error
+		if negated, err = conjunction(negated, alternatives); err != nil {
+			return nil, err
+		}
+	}
+	return negated, nil
+}
@@ -0,0 +1,91 @@
+package atc
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDisjunctiveNormalForm(t *testing.T) {
+	testCases := []struct {
+		name          string
+		expression    string
+		expected      [][]string
+		expectedError bool
+	}{
+		{
+			name:       "single predicate",
+			expression: `http.path == "/foo"`,
+			expected:   [][]string{{`http.path == "/foo"`}},
+		},
+		{
+			name: "conjunction of disjunctions",
+			expression: And(
+				Or(NewPrediacteHTTPHost(OpEqual, "a.example.com"), NewPrediacteHTTPHost(OpSuffixMatch, ".example.net")),
+				Or(NewPredicateHTTPPath(OpEqual, "/foo"), NewPredicateHTTPPath(OpPrefixMatch, "/foo/")),
+			).Expression(),
+			expected: [][]string{
+				{`http.host == "a.example.com"`, `http.path == "/foo"`},
+				{`http.host == "a.example.com"`, `http.path ^= "/foo/"`},
+				{`http.host =^ ".example.net"`, `http.path == "/foo"`},
+				{`http.host =^ ".example.net"`, `http.path ^= "/foo/"`},
+			},
+		},
+		{
+			name: "negation",
+			expression: And(
+				NewPredicateHTTPPath(OpPrefixMatch, "/"),
+				Not(Or(NewPrediacteHTTPHost(OpEqual, "a.example.com"), NewPredicateHTTPMethod(OpEqual, "GET"))),
+			).Expression(),
+			expected: [][]string{
+				{`http.path ^= "/"`, `!(http.host == "a.example.com")`, `!(http.method == "GET")`},
+			},
+		},
+		{
+			name:       "transformations, escaped strings and non-string literals",
+			expression: `(lower(http.path) ~ "^/foo/\\d{3}\"") && (net.dst.port >= 1024) && (net.src.ip in 10.0.0.0/8)`,
+			expected: [][]string{
+				{`lower(http.path) ~ "^/foo/\\d{3}\""`, `net.dst.port >= 1024`, `net.src.ip in 10.0.0.0/8`},
+			},
+		},
+		{
+			name:          "missing closing parenthesis",
+			expression:    `(http.path == "/foo"`,
+			expectedError: true,
+		},
+		{
+			name:          "missing operator",
+			expression:    `http.path "/foo"`,
+			expectedError: true,
+		},
+		{
+			name:          "unterminated string literal",
+			expression:    `http.path == "/foo`,
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clauses, err := ParseDisjunctiveNormalForm(tc.expression)
+			if tc.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, lo.Map(clauses, func(clause []ParsedPredicate, _ int) []string {
+				return lo.Map(clause, func(p ParsedPredicate, _ int) string { return p.Expression() })
+			}))
+		})
+	}
+
+	t.Run("too many alternatives", func(t *testing.T) {
+		m := And()
+		for i := 0; i < 11; i++ {
+			m = m.And(Or(NewPredicateHTTPMethod(OpEqual, "GET"), NewPredicateHTTPMethod(OpEqual, "POST")))
+		}
+		_, err := ParseDisjunctiveNormalForm(m.Expression())
+		require.ErrorIs(t, err, ErrExpressionTooComplex)
+	})
+}
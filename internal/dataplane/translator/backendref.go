@@ -11,6 +11,8 @@ import (
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
+	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
+	incubatorv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/incubator/v1alpha1"
 )
 
 // backendRefsToKongStateBackends takes a list of BackendRefs and returns a list of ServiceBackends.
@@ -18,7 +20,9 @@ import (
 // not included in the returned list:
 // - If a BackendRef is not permitted by the provided ReferenceGrantTo set,
 // - If a BackendRef is not found,
-// - If a BackendRef Group & Kind pair is not supported (currently only Service is supported),
+// - If a BackendRef Group & Kind pair is not supported (currently Service, KongServiceFacade and KongExternalBackend
+// are supported, KongServiceFacade only for HTTPRoute and GRPCRoute and KongExternalBackend only for HTTPRoute
+// and TCPRoute),
 // - If a BackendRef is missing a port.
 // The provided client is used to retrieve the Backend referenced by the BackendRef
 // to check if it exists.
@@ -30,6 +34,7 @@ func backendRefsToKongStateBackends(
 	allowed map[gatewayapi.Namespace][]gatewayapi.ReferenceGrantTo,
 ) kongstate.ServiceBackends {
 	backends := kongstate.ServiceBackends{}
+	routeKind := gatewayAPIRouteKind(route)
 
 	for _, backendRef := range backendRefs {
 		logger := loggerForBackendRef(logger, route, backendRef)
@@ -48,12 +53,29 @@ func backendRefsToKongStateBackends(
 			continue
 		}
 
-		var err error
+		var (
+			err             error
+			serviceFacade   *incubatorv1alpha1.KongServiceFacade
+			externalBackend *kongv1alpha1.KongExternalBackend
+		)
 		switch *backendRef.Kind {
 		case "Service":
 			_, err = storer.GetService(nn.Namespace, nn.Name)
+		case incubatorv1alpha1.KongServiceFacadeKind:
+			if !util.IsBackendRefGroupKindSupported(routeKind, backendRef.Group, backendRef.Kind) {
+				err = fmt.Errorf("kind %q is only supported for HTTPRoute and GRPCRoute", *backendRef.Kind)
+				break
+			}
+			serviceFacade, err = storer.GetKongServiceFacade(nn.Namespace, nn.Name)
+		case kongv1alpha1.KongExternalBackendKind:
+			if !util.IsBackendRefGroupKindSupported(routeKind, backendRef.Group, backendRef.Kind) {
+				err = fmt.Errorf("kind %q is only supported for HTTPRoute and TCPRoute", *backendRef.Kind)
+				break
+			}
+			externalBackend, err = storer.GetKongExternalBackend(nn.Namespace, nn.Name)
 		default:
-			err = fmt.Errorf("unsupported kind %q, only 'Service' is supported", *backendRef.Kind)
+			err = fmt.Errorf("unsupported kind %q, only 'Service', %q and %q are supported",
+				*backendRef.Kind, incubatorv1alpha1.KongServiceFacadeKind, kongv1alpha1.KongExternalBackendKind)
 		}
 		if err != nil {
 			if errors.As(err, &store.NotFoundError{}) {
@@ -64,7 +86,7 @@ func backendRefsToKongStateBackends(
 			continue
 		}
 
-		if !util.IsBackendRefGroupKindSupported(backendRef.Group, backendRef.Kind) ||
+		if !util.IsBackendRefGroupKindSupported(routeKind, backendRef.Group, backendRef.Kind) ||
 			!gatewayapi.NewRefCheckerForRoute(logger, route, backendRef).IsRefAllowedByGrant(allowed) {
 			// we log impermissible refs rather than failing the entire rule. while we cannot actually route to
 			// these, we do not want a single impermissible ref to take the entire rule offline. in the case of edits,
@@ -74,6 +96,36 @@ func backendRefsToKongStateBackends(
 			continue
 		}
 
+		if serviceFacade != nil {
+			// KongServiceFacade defines the port of its backing Service on its own, so the backendRef's port
+			// and weight are not used. It is always translated to a dedicated Kong Service.
+			backend, err := kongstate.NewServiceBackendForServiceFacade(
+				nn,
+				kongstate.PortDef{
+					Mode:   kongstate.PortModeByNumber,
+					Number: serviceFacade.Spec.Backend.Port,
+				},
+			)
+			if err != nil {
+				logger.Error(err, "failed to create ServiceBackend for backendRef")
+				continue
+			}
+			backends = append(backends, backend)
+			continue
+		}
+
+		if externalBackend != nil {
+			// KongExternalBackend defines its targets and their weights on its own, so the backendRef's port
+			// and weight are not used. It is always translated to a dedicated Kong Service.
+			backend, err := kongstate.NewServiceBackendForExternalBackend(nn)
+			if err != nil {
+				logger.Error(err, "failed to create ServiceBackend for backendRef")
+				continue
+			}
+			backends = append(backends, backend)
+			continue
+		}
+
 		port := int32(-1)
 		if backendRef.Port != nil {
 			port = int32(*backendRef.Port)
@@ -98,6 +150,25 @@ func backendRefsToKongStateBackends(
 	return backends
 }
 
+// gatewayAPIRouteKind returns the kind of the Gateway API route, which determines the kinds of backends
+// it supports. Objects in the cache may have no GVK set, so the kind is determined by the Go type.
+func gatewayAPIRouteKind(route client.Object) string {
+	switch route.(type) {
+	case *gatewayapi.HTTPRoute:
+		return gatewayapi.V1HTTPRouteTypeMeta.Kind
+	case *gatewayapi.GRPCRoute:
+		return gatewayapi.GRPCRouteTypeMeta.Kind
+	case *gatewayapi.TCPRoute:
+		return gatewayapi.TCPRouteTypeMeta.Kind
+	case *gatewayapi.UDPRoute:
+		return gatewayapi.UDPRouteTypeMeta.Kind
+	case *gatewayapi.TLSRoute:
+		return gatewayapi.TLSRouteTypeMeta.Kind
+	default:
+		return route.GetObjectKind().GroupVersionKind().Kind
+	}
+}
+
 func loggerForBackendRef(logger logr.Logger, route client.Object, backendRef gatewayapi.BackendRef) logr.Logger {
 	var (
 		namespace = route.GetNamespace()
@@ -15,6 +15,8 @@ import (
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/builder"
+	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
+	incubatorv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/incubator/v1alpha1"
 )
 
 func TestBackendRefsToKongStateBackends(t *testing.T) {
@@ -153,6 +155,242 @@ func TestBackendRefsToKongStateBackends(t *testing.T) {
 			},
 			expected: kongstate.ServiceBackends{},
 		},
+		{
+			name: "an existing KongServiceFacade as backendRef returns a KongStateBackend with the KongServiceFacade",
+			route: &gatewayapi.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "basic-httproute",
+					Namespace: corev1.NamespaceDefault,
+				},
+			},
+			backendRefs: []gatewayapi.BackendRef{
+				builder.NewBackendRef("fake-facade").
+					WithGroup(incubatorv1alpha1.GroupVersion.Group).
+					WithKind(incubatorv1alpha1.KongServiceFacadeKind).
+					Build(),
+			},
+			objects: store.FakeObjects{
+				KongServiceFacades: []*incubatorv1alpha1.KongServiceFacade{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-facade",
+							Namespace: corev1.NamespaceDefault,
+						},
+						Spec: incubatorv1alpha1.KongServiceFacadeSpec{
+							Backend: incubatorv1alpha1.KongServiceFacadeBackend{
+								Name: "fake-service",
+								Port: 8080,
+							},
+						},
+					},
+				},
+			},
+			expected: func() kongstate.ServiceBackends {
+				svcBackend, err := kongstate.NewServiceBackendForServiceFacade(
+					k8stypes.NamespacedName{Namespace: corev1.NamespaceDefault, Name: "fake-facade"},
+					kongstate.PortDef{
+						Mode:   kongstate.PortModeByNumber,
+						Number: 8080,
+					},
+				)
+				require.NoError(t, err)
+				return kongstate.ServiceBackends{svcBackend}
+			}(),
+		},
+		{
+			name: "KongServiceFacade in another namespace without ReferenceGrant doesn't return a KongStateBackend",
+			route: &gatewayapi.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "basic-httproute",
+					Namespace: corev1.NamespaceDefault,
+				},
+			},
+			backendRefs: []gatewayapi.BackendRef{
+				builder.NewBackendRef("fake-facade").
+					WithGroup(incubatorv1alpha1.GroupVersion.Group).
+					WithKind(incubatorv1alpha1.KongServiceFacadeKind).
+					WithNamespace("other-namespace").
+					Build(),
+			},
+			allowed: map[gatewayapi.Namespace][]gatewayapi.ReferenceGrantTo{
+				gatewayapi.Namespace("other-namespace"): {
+					{
+						Group: "",
+						Kind:  "Service",
+					},
+				},
+			},
+			objects: store.FakeObjects{
+				KongServiceFacades: []*incubatorv1alpha1.KongServiceFacade{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-facade",
+							Namespace: "other-namespace",
+						},
+						Spec: incubatorv1alpha1.KongServiceFacadeSpec{
+							Backend: incubatorv1alpha1.KongServiceFacadeBackend{
+								Name: "fake-service",
+								Port: 8080,
+							},
+						},
+					},
+				},
+			},
+			expected: kongstate.ServiceBackends{},
+		},
+		{
+			name: "KongServiceFacade in another namespace with ReferenceGrant returns a KongStateBackend",
+			route: &gatewayapi.GRPCRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "basic-grpcroute",
+					Namespace: corev1.NamespaceDefault,
+				},
+			},
+			backendRefs: []gatewayapi.BackendRef{
+				builder.NewBackendRef("fake-facade").
+					WithGroup(incubatorv1alpha1.GroupVersion.Group).
+					WithKind(incubatorv1alpha1.KongServiceFacadeKind).
+					WithNamespace("other-namespace").
+					Build(),
+			},
+			allowed: map[gatewayapi.Namespace][]gatewayapi.ReferenceGrantTo{
+				gatewayapi.Namespace("other-namespace"): {
+					{
+						Group: gatewayapi.Group(incubatorv1alpha1.GroupVersion.Group),
+						Kind:  incubatorv1alpha1.KongServiceFacadeKind,
+						Name:  lo.ToPtr(gatewayapi.ObjectName("fake-facade")),
+					},
+				},
+			},
+			objects: store.FakeObjects{
+				KongServiceFacades: []*incubatorv1alpha1.KongServiceFacade{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-facade",
+							Namespace: "other-namespace",
+						},
+						Spec: incubatorv1alpha1.KongServiceFacadeSpec{
+							Backend: incubatorv1alpha1.KongServiceFacadeBackend{
+								Name: "fake-service",
+								Port: 8080,
+							},
+						},
+					},
+				},
+			},
+			expected: func() kongstate.ServiceBackends {
+				svcBackend, err := kongstate.NewServiceBackendForServiceFacade(
+					k8stypes.NamespacedName{Namespace: "other-namespace", Name: "fake-facade"},
+					kongstate.PortDef{
+						Mode:   kongstate.PortModeByNumber,
+						Number: 8080,
+					},
+				)
+				require.NoError(t, err)
+				return kongstate.ServiceBackends{svcBackend}
+			}(),
+		},
+		{
+			name: "KongServiceFacade as backendRef of TCPRoute doesn't return a KongStateBackend",
+			route: &gatewayapi.TCPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "basic-tcproute",
+					Namespace: corev1.NamespaceDefault,
+				},
+			},
+			backendRefs: []gatewayapi.BackendRef{
+				builder.NewBackendRef("fake-facade").
+					WithGroup(incubatorv1alpha1.GroupVersion.Group).
+					WithKind(incubatorv1alpha1.KongServiceFacadeKind).
+					Build(),
+			},
+			objects: store.FakeObjects{
+				KongServiceFacades: []*incubatorv1alpha1.KongServiceFacade{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-facade",
+							Namespace: corev1.NamespaceDefault,
+						},
+						Spec: incubatorv1alpha1.KongServiceFacadeSpec{
+							Backend: incubatorv1alpha1.KongServiceFacadeBackend{
+								Name: "fake-service",
+								Port: 8080,
+							},
+						},
+					},
+				},
+			},
+			expected: kongstate.ServiceBackends{},
+		},
+		{
+			name: "an existing KongExternalBackend as backendRef of TCPRoute returns a KongStateBackend with the KongExternalBackend",
+			route: &gatewayapi.TCPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "basic-tcproute",
+					Namespace: corev1.NamespaceDefault,
+				},
+			},
+			backendRefs: []gatewayapi.BackendRef{
+				builder.NewBackendRef("fake-external").
+					WithGroup(kongv1alpha1.GroupVersion.Group).
+					WithKind(kongv1alpha1.KongExternalBackendKind).
+					WithPort(8080).
+					Build(),
+			},
+			objects: store.FakeObjects{
+				KongExternalBackends: []*kongv1alpha1.KongExternalBackend{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-external",
+							Namespace: corev1.NamespaceDefault,
+						},
+						Spec: kongv1alpha1.KongExternalBackendSpec{
+							Targets: []kongv1alpha1.KongExternalBackendTarget{
+								{Host: "api.example.com", Port: 443},
+							},
+						},
+					},
+				},
+			},
+			expected: func() kongstate.ServiceBackends {
+				backend, err := kongstate.NewServiceBackendForExternalBackend(
+					k8stypes.NamespacedName{Namespace: corev1.NamespaceDefault, Name: "fake-external"},
+				)
+				require.NoError(t, err)
+				return kongstate.ServiceBackends{backend}
+			}(),
+		},
+		{
+			name: "KongExternalBackend as backendRef of GRPCRoute doesn't return a KongStateBackend",
+			route: &gatewayapi.GRPCRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "basic-grpcroute",
+					Namespace: corev1.NamespaceDefault,
+				},
+			},
+			backendRefs: []gatewayapi.BackendRef{
+				builder.NewBackendRef("fake-external").
+					WithGroup(kongv1alpha1.GroupVersion.Group).
+					WithKind(kongv1alpha1.KongExternalBackendKind).
+					Build(),
+			},
+			objects: store.FakeObjects{
+				KongExternalBackends: []*kongv1alpha1.KongExternalBackend{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-external",
+							Namespace: corev1.NamespaceDefault,
+						},
+						Spec: kongv1alpha1.KongExternalBackendSpec{
+							Targets: []kongv1alpha1.KongExternalBackendTarget{
+								{Host: "api.example.com", Port: 443},
+							},
+						},
+					},
+				},
+			},
+			expected: kongstate.ServiceBackends{},
+		},
 	}
 
 	for _, tc := range testcases {
@@ -0,0 +1,185 @@
+package translator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/samber/lo"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/logging"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
+	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
+)
+
+// getGatewayClassParameters returns the GatewayClassParameters referenced by the class of the Gateway.
+// It returns false when the class or its parameters cannot be found, the class doesn't reference any parameters,
+// or the class is not accepted because of its invalid parameters (e.g. requiring another router flavor).
+func (t *Translator) getGatewayClassParameters(gateway *gatewayapi.Gateway) (*kongv1alpha1.GatewayClassParameters, bool) {
+	gatewayClass, err := t.storer.GetGatewayClass(string(gateway.Spec.GatewayClassName))
+	if err != nil {
+		return nil, false
+	}
+	if gatewayClass.Spec.ParametersRef == nil {
+		return nil, false
+	}
+	if gatewayapi.GatewayClassHasInvalidParameters(gatewayClass) {
+		t.logger.V(logging.DebugLevel).Info("GatewayClass has invalid GatewayClassParameters, ignoring them",
+			"gatewayclass", gatewayClass.Name)
+		return nil, false
+	}
+	params, err := t.storer.GetGatewayClassParameters(gatewayClass)
+	if err != nil {
+		t.logger.V(logging.DebugLevel).Info("Could not retrieve GatewayClassParameters, ignoring them",
+			"gatewayclass", gatewayClass.Name, "error", err.Error())
+		return nil, false
+	}
+	return params, true
+}
+
+// gatewayClassDefaultCertificateRefs returns the default certificate of the listeners of the Gateway
+// defined in its GatewayClassParameters, if any. A certificate from another namespace than the Gateway's
+// is only returned when a ReferenceGrant permits the Gateway to reference it.
+func (t *Translator) gatewayClassDefaultCertificateRefs(gateway *gatewayapi.Gateway) []gatewayapi.SecretObjectReference {
+	params, ok := t.getGatewayClassParameters(gateway)
+	if !ok || params.Spec.ListenerTLS == nil || params.Spec.ListenerTLS.CertificateRef == nil {
+		return nil
+	}
+	certRef := params.Spec.ListenerTLS.CertificateRef
+	ref := gatewayapi.SecretObjectReference{
+		Group:     lo.ToPtr(gatewayapi.Group(corev1.GroupName)),
+		Kind:      lo.ToPtr(gatewayapi.Kind("Secret")),
+		Name:      gatewayapi.ObjectName(certRef.Name),
+		Namespace: lo.ToPtr(gatewayapi.Namespace(certRef.Namespace)),
+	}
+	if certRef.Namespace == gateway.Namespace {
+		return []gatewayapi.SecretObjectReference{ref}
+	}
+
+	grants, err := t.storer.ListReferenceGrants()
+	if err != nil {
+		t.logger.Error(err, "Failed to list ReferenceGrants")
+		return nil
+	}
+	allowed := gatewayapi.GetPermittedForReferenceGrantFrom(
+		t.logger,
+		gatewayapi.ReferenceGrantFrom{
+			Group:     gatewayapi.V1Group,
+			Kind:      "Gateway",
+			Namespace: gatewayapi.Namespace(gateway.Namespace),
+		},
+		grants,
+	)
+	if !gatewayapi.NewRefCheckerForRoute(t.logger, gateway, ref).IsRefAllowedByGrant(allowed) {
+		t.registerTranslationFailure(
+			fmt.Sprintf("default certificate Secret %s/%s of the GatewayClass is not permitted by any ReferenceGrant",
+				certRef.Namespace, certRef.Name),
+			gateway,
+		)
+		return nil
+	}
+	return []gatewayapi.SecretObjectReference{ref}
+}
+
+// applyGatewayClassDefaultPlugins attaches the default plugins defined in GatewayClassParameters to the routes
+// translated from HTTPRoutes and GRPCRoutes whose parent Gateways belong to the class. Routes that reference
+// plugins on their own (with the plugins annotation or ExtensionRef filters) are left intact.
+// When a route has parent Gateways of multiple classes, the default plugins of all the classes are attached.
+func (t *Translator) applyGatewayClassDefaultPlugins(rules *ingressRules) {
+	const pluginsKey = annotations.AnnotationPrefix + annotations.PluginsKey
+
+	pluginsByRoute := make(map[string][]string)
+	for _, service := range rules.ServiceNameToServices {
+		for i, route := range service.Routes {
+			kind := route.Ingress.GroupVersionKind.Kind
+			if kind != "HTTPRoute" && kind != "GRPCRoute" {
+				continue
+			}
+			if _, ok := route.Ingress.Annotations[pluginsKey]; ok {
+				continue
+			}
+
+			key := kind + "/" + route.Ingress.Namespace + "/" + route.Ingress.Name
+			plugins, ok := pluginsByRoute[key]
+			if !ok {
+				plugins = t.gatewayClassDefaultPluginsForRoute(route.Ingress.Namespace, t.routeParentRefs(route.Ingress))
+				pluginsByRoute[key] = plugins
+			}
+			if len(plugins) == 0 {
+				continue
+			}
+
+			// Annotations may be shared with the Kubernetes object, so they're copied before being modified.
+			anns := make(map[string]string, len(route.Ingress.Annotations)+1)
+			for k, v := range route.Ingress.Annotations {
+				anns[k] = v
+			}
+			anns[pluginsKey] = strings.Join(plugins, ",")
+			service.Routes[i].Ingress.Annotations = anns
+		}
+	}
+}
+
+// routeParentRefs returns parent references of the HTTPRoute or GRPCRoute a Kong route was translated from.
+func (t *Translator) routeParentRefs(info util.K8sObjectInfo) []gatewayapi.ParentReference {
+	obj, ok := t.routeSourceObject(info)
+	if !ok {
+		return nil
+	}
+	switch route := obj.(type) {
+	case *gatewayapi.HTTPRoute:
+		return route.Spec.ParentRefs
+	case *gatewayapi.GRPCRoute:
+		return route.Spec.ParentRefs
+	default:
+		return nil
+	}
+}
+
+// gatewayClassDefaultPluginsForRoute returns the default plugins of the classes of the route's parent Gateways.
+func (t *Translator) gatewayClassDefaultPluginsForRoute(routeNamespace string, parentRefs []gatewayapi.ParentReference) []string {
+	var plugins []string
+	seenGateways := make(map[types.NamespacedName]struct{})
+	for _, parentRef := range parentRefs {
+		nn, ok := parentRefGatewayNamespacedName(routeNamespace, parentRef)
+		if !ok {
+			continue
+		}
+		if _, ok := seenGateways[nn]; ok {
+			continue
+		}
+		seenGateways[nn] = struct{}{}
+
+		gateway, err := t.storer.GetGateway(nn.Namespace, nn.Name)
+		if err != nil {
+			continue
+		}
+		params, ok := t.getGatewayClassParameters(gateway)
+		if !ok {
+			continue
+		}
+		for _, plugin := range params.Spec.Plugins {
+			if !lo.Contains(plugins, plugin) {
+				plugins = append(plugins, plugin)
+			}
+		}
+	}
+	return plugins
+}
+
+// parentRefGatewayNamespacedName returns the namespaced name of the Gateway referenced by the parent reference
+// of a route in the given namespace. It returns false when the parent reference doesn't point to a Gateway.
+func parentRefGatewayNamespacedName(routeNamespace string, parentRef gatewayapi.ParentReference) (types.NamespacedName, bool) {
+	if (parentRef.Group != nil && *parentRef.Group != gatewayapi.V1Group) ||
+		(parentRef.Kind != nil && *parentRef.Kind != "Gateway") {
+		return types.NamespacedName{}, false
+	}
+	nn := types.NamespacedName{Namespace: routeNamespace, Name: string(parentRef.Name)}
+	if parentRef.Namespace != nil {
+		nn.Namespace = string(*parentRef.Namespace)
+	}
+	return nn, true
+}
@@ -0,0 +1,218 @@
+package translator
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/builder"
+	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
+	"github.com/kong/kubernetes-ingress-controller/v3/test/helpers/certificate"
+)
+
+func gatewayClassWithParameters(name string, paramsNamespace, paramsName string) *gatewayapi.GatewayClass {
+	return &gatewayapi.GatewayClass{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: gatewayapi.GatewayClassSpec{
+			ControllerName: "konghq.com/kic-gateway-controller",
+			ParametersRef: &gatewayapi.ParametersReference{
+				Group:     gatewayapi.Group(kongv1alpha1.GroupVersion.Group),
+				Kind:      kongv1alpha1.GatewayClassParametersKind,
+				Namespace: lo.ToPtr(gatewayapi.Namespace(paramsNamespace)),
+				Name:      paramsName,
+			},
+		},
+	}
+}
+
+func TestTranslator_GatewayClassDefaultPlugins(t *testing.T) {
+	params := &kongv1alpha1.GatewayClassParameters{
+		ObjectMeta: metav1.ObjectMeta{Name: "params", Namespace: "kong"},
+		Spec: kongv1alpha1.GatewayClassParametersSpec{
+			Plugins: []string{"rate-limiting", "cors"},
+		},
+	}
+	gateways := []*gatewayapi.Gateway{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "with-params", Namespace: "default"},
+			Spec:       gatewayapi.GatewaySpec{GatewayClassName: "with-params"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "without-params", Namespace: "default"},
+			Spec:       gatewayapi.GatewaySpec{GatewayClassName: "without-params"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "invalid-params", Namespace: "default"},
+			Spec:       gatewayapi.GatewaySpec{GatewayClassName: "invalid-params"},
+		},
+	}
+	invalidParamsClass := gatewayClassWithParameters("invalid-params", "kong", "params")
+	invalidParamsClass.Status.Conditions = []metav1.Condition{{
+		Type:   string(gatewayapi.GatewayClassConditionStatusAccepted),
+		Status: metav1.ConditionFalse,
+		Reason: string(gatewayapi.GatewayClassReasonInvalidParameters),
+	}}
+	gatewayClasses := []*gatewayapi.GatewayClass{
+		gatewayClassWithParameters("with-params", "kong", "params"),
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "without-params"},
+			Spec:       gatewayapi.GatewayClassSpec{ControllerName: "konghq.com/kic-gateway-controller"},
+		},
+		invalidParamsClass,
+	}
+	httpRoute := func(name, gateway string, anns map[string]string) *gatewayapi.HTTPRoute {
+		route := &gatewayapi.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Annotations: anns},
+			Spec: gatewayapi.HTTPRouteSpec{
+				CommonRouteSpec: gatewayapi.CommonRouteSpec{
+					ParentRefs: []gatewayapi.ParentReference{{Name: gatewayapi.ObjectName(gateway)}},
+				},
+				Rules: []gatewayapi.HTTPRouteRule{{
+					Matches: []gatewayapi.HTTPRouteMatch{
+						builder.NewHTTPRouteMatch().WithPathPrefix("/" + name).Build(),
+					},
+					BackendRefs: []gatewayapi.HTTPBackendRef{
+						builder.NewHTTPBackendRef("svc").WithPort(80).Build(),
+					},
+				}},
+			},
+		}
+		route.SetGroupVersionKind(httprouteGVK)
+		return route
+	}
+	routeWithOwnPlugins := httpRoute("own-plugins", "with-params", map[string]string{"konghq.com/plugins": "key-auth"})
+	routeWithoutPlugins := httpRoute("no-plugins", "with-params", map[string]string{"konghq.com/strip-path": "true"})
+
+	s, err := store.NewFakeStore(store.FakeObjects{
+		Services: []*corev1.Service{{
+			ObjectMeta: metav1.ObjectMeta{Name: "svc", Namespace: "default"},
+			Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Port: 80}}},
+		}},
+		HTTPRoutes: []*gatewayapi.HTTPRoute{
+			routeWithoutPlugins,
+			routeWithOwnPlugins,
+			httpRoute("other-class", "without-params", nil),
+			httpRoute("invalid-class", "invalid-params", nil),
+		},
+		Gateways:               gateways,
+		GatewayClasses:         gatewayClasses,
+		GatewayClassParameters: []*kongv1alpha1.GatewayClassParameters{params},
+	})
+	require.NoError(t, err)
+	p := mustNewTranslator(t, s)
+
+	rules := p.ingressRulesFromHTTPRoutes()
+	p.applyGatewayClassDefaultPlugins(&rules)
+
+	pluginsByRoute := make(map[string]string)
+	for _, service := range rules.ServiceNameToServices {
+		for _, route := range service.Routes {
+			pluginsByRoute[route.Ingress.Name] = route.Ingress.Annotations["konghq.com/plugins"]
+		}
+	}
+	require.Equal(t, map[string]string{
+		"no-plugins":    "rate-limiting,cors",
+		"own-plugins":   "key-auth",
+		"other-class":   "",
+		"invalid-class": "",
+	}, pluginsByRoute, "defaults of classes with invalid parameters must not be applied")
+	require.Equal(t, map[string]string{"konghq.com/strip-path": "true"}, routeWithoutPlugins.Annotations,
+		"HTTPRoute in the store must not be modified")
+}
+
+func TestTranslator_GatewayClassDefaultCertificate(t *testing.T) {
+	cert, key := certificate.MustGenerateSelfSignedCertPEMFormat()
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "default-cert", Namespace: "kong", UID: "secret-uid"},
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       cert,
+			corev1.TLSPrivateKeyKey: key,
+		},
+	}
+	params := &kongv1alpha1.GatewayClassParameters{
+		ObjectMeta: metav1.ObjectMeta{Name: "params", Namespace: "kong"},
+		Spec: kongv1alpha1.GatewayClassParametersSpec{
+			ListenerTLS: &kongv1alpha1.GatewayClassListenerTLS{
+				CertificateRef: &kongv1alpha1.GatewayClassSecretReference{Name: "default-cert", Namespace: "kong"},
+			},
+		},
+	}
+	programmed := []metav1.Condition{{
+		Type:   string(gatewayapi.ListenerConditionProgrammed),
+		Status: metav1.ConditionTrue,
+		Reason: string(gatewayapi.ListenerReasonProgrammed),
+	}}
+	gateway := &gatewayapi.Gateway{
+		TypeMeta:   gatewayapi.V1GatewayTypeMeta,
+		ObjectMeta: metav1.ObjectMeta{Name: "gateway", Namespace: "default"},
+		Spec: gatewayapi.GatewaySpec{
+			GatewayClassName: "kong",
+			Listeners: []gatewayapi.Listener{
+				builder.NewListener("https").HTTPS().WithPort(443).WithHostname("foo.com").
+					WithTLSConfig(&gatewayapi.GatewayTLSConfig{Mode: lo.ToPtr(gatewayapi.TLSModeTerminate)}).Build(),
+				builder.NewListener("passthrough").TLS().WithPort(8443).WithHostname("bar.com").
+					WithTLSConfig(&gatewayapi.GatewayTLSConfig{Mode: lo.ToPtr(gatewayapi.TLSModePassthrough)}).Build(),
+			},
+		},
+		Status: gatewayapi.GatewayStatus{
+			Listeners: []gatewayapi.ListenerStatus{
+				{Name: "https", Conditions: programmed},
+				{Name: "passthrough", Conditions: programmed},
+			},
+		},
+	}
+
+	grant := &gatewayapi.ReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{Name: "default-cert", Namespace: "kong"},
+		Spec: gatewayapi.ReferenceGrantSpec{
+			From: []gatewayapi.ReferenceGrantFrom{{
+				Group:     gatewayapi.V1Group,
+				Kind:      "Gateway",
+				Namespace: "default",
+			}},
+			To: []gatewayapi.ReferenceGrantTo{{
+				Group: "",
+				Kind:  "Secret",
+			}},
+		},
+	}
+
+	t.Run("certificate permitted by a ReferenceGrant", func(t *testing.T) {
+		s, err := store.NewFakeStore(store.FakeObjects{
+			Gateways:               []*gatewayapi.Gateway{gateway},
+			GatewayClasses:         []*gatewayapi.GatewayClass{gatewayClassWithParameters("kong", "kong", "params")},
+			GatewayClassParameters: []*kongv1alpha1.GatewayClassParameters{params},
+			Secrets:                []*corev1.Secret{secret},
+			ReferenceGrants:        []*gatewayapi.ReferenceGrant{grant},
+		})
+		require.NoError(t, err)
+		p := mustNewTranslator(t, s)
+
+		certs := p.getGatewayCerts()
+		require.Len(t, certs, 1, "only the TLS-terminating listener should use the default certificate")
+		require.Equal(t, "secret-uid", *certs[0].cert.ID)
+		require.Equal(t, []string{"foo.com"}, certs[0].snis)
+		require.Empty(t, p.failuresCollector.PopResourceFailures())
+	})
+
+	t.Run("certificate from another namespace without a ReferenceGrant", func(t *testing.T) {
+		s, err := store.NewFakeStore(store.FakeObjects{
+			Gateways:               []*gatewayapi.Gateway{gateway},
+			GatewayClasses:         []*gatewayapi.GatewayClass{gatewayClassWithParameters("kong", "kong", "params")},
+			GatewayClassParameters: []*kongv1alpha1.GatewayClassParameters{params},
+			Secrets:                []*corev1.Secret{secret},
+		})
+		require.NoError(t, err)
+		p := mustNewTranslator(t, s)
+
+		require.Empty(t, p.getGatewayCerts(), "the default certificate must not be used without a ReferenceGrant")
+		require.Equal(t, []string{
+			"Gateway default/gateway: default certificate Secret kong/default-cert of the GatewayClass is not permitted by any ReferenceGrant",
+		}, resourceFailureMessages(p.failuresCollector.PopResourceFailures()))
+	})
+}
@@ -0,0 +1,305 @@
+package translator
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
+	netv1 "k8s.io/api/networking/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
+	kongv1beta1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1beta1"
+)
+
+// CheckHostnameAllowedByPolicies returns an error if the given KongHostnamePolicies do not allow routing
+// traffic for the hostname from the namespace.
+// The hostname is owned by the namespaces of the policies with the most specific hostname entry matching it.
+// Wildcard hostnames (e.g. "*.example.com") and empty hostnames (matching all hosts) also match hosts of the
+// entries they cover (e.g. "api.example.com"), so they're allowed only if all of these are allowed as well.
+// Hostnames not matched by any policy and not covering any policy entry are not restricted.
+func CheckHostnameAllowedByPolicies(policies []*kongv1alpha1.KongHostnamePolicy, namespace, hostname string) error {
+	hostname = strings.ToLower(hostname)
+	if owningPolicies, allowed := hostnameOwningPolicies(policies, namespace, hostname); !allowed {
+		return fmt.Errorf("hostname %q is not allowed in namespace %s by KongHostnamePolicy %s",
+			hostname, namespace, strings.Join(owningPolicies, ", "))
+	}
+
+	if hostname != "" && !strings.HasPrefix(hostname, "*") {
+		return nil
+	}
+	var coveredEntries []string
+	for _, policy := range policies {
+		for _, entry := range policy.Spec.Hostnames {
+			entry = strings.ToLower(entry)
+			if entry != hostname && (hostname == "" || hostnamePolicyEntryMatches(hostname, entry)) {
+				coveredEntries = append(coveredEntries, entry)
+			}
+		}
+	}
+	coveredEntries = lo.Uniq(coveredEntries)
+	slices.Sort(coveredEntries)
+	for _, entry := range coveredEntries {
+		if owningPolicies, allowed := hostnameOwningPolicies(policies, namespace, entry); !allowed {
+			hostnameDescription := fmt.Sprintf("hostname %q", hostname)
+			if hostname == "" {
+				hostnameDescription = "empty hostname (matching all hosts)"
+			}
+			return fmt.Errorf("%s covers hostname %q which is not allowed in namespace %s by KongHostnamePolicy %s",
+				hostnameDescription, entry, namespace, strings.Join(owningPolicies, ", "))
+		}
+	}
+	return nil
+}
+
+// hostnameOwningPolicies returns the sorted names of the KongHostnamePolicies owning the hostname along with
+// whether any of them allows the namespace. A hostname not owned by any policy is allowed in all namespaces.
+func hostnameOwningPolicies(
+	policies []*kongv1alpha1.KongHostnamePolicy, namespace, hostname string,
+) (owningPolicies []string, allowed bool) {
+	bestSpecificity := -1
+	for _, policy := range policies {
+		for _, policyHostname := range policy.Spec.Hostnames {
+			policyHostname = strings.ToLower(policyHostname)
+			if !hostnamePolicyEntryMatches(policyHostname, hostname) {
+				continue
+			}
+			// All the entries matching the hostname with the same specificity are the same hostname.
+			specificity := hostnamePolicyEntrySpecificity(policyHostname)
+			if specificity < bestSpecificity {
+				continue
+			}
+			if specificity > bestSpecificity {
+				bestSpecificity, owningPolicies, allowed = specificity, nil, false
+			}
+			owningPolicies = append(owningPolicies, policy.Name)
+			allowed = allowed || slices.Contains(policy.Spec.Namespaces, namespace)
+		}
+	}
+	if len(owningPolicies) == 0 {
+		return nil, true
+	}
+	owningPolicies = lo.Uniq(owningPolicies)
+	slices.Sort(owningPolicies)
+	return owningPolicies, allowed
+}
+
+// hostnamePolicyEntryMatches tells whether the hostname entry of a KongHostnamePolicy matches the hostname.
+// A wildcard entry (e.g. "*.example.com") matches all subdomains of its suffix, including wildcard hostnames
+// of deeper subdomains (e.g. "*.api.example.com"), but not the suffix itself.
+func hostnamePolicyEntryMatches(entry, hostname string) bool {
+	if suffix, ok := strings.CutPrefix(entry, "*"); ok {
+		return len(hostname) > len(suffix) && strings.HasSuffix(hostname, suffix)
+	}
+	return entry == hostname
+}
+
+// hostnamePolicyEntrySpecificity returns the specificity of a KongHostnamePolicy hostname entry. Exact entries
+// are more specific than wildcard ones, and longer wildcard entries are more specific than shorter ones.
+func hostnamePolicyEntrySpecificity(entry string) int {
+	if strings.HasPrefix(entry, "*") {
+		return len(entry)
+	}
+	return math.MaxInt
+}
+
+// enforceHostnamePoliciesOnHTTPRoute returns the HTTPRoute without the hostnames KongHostnamePolicies do not
+// allow in its namespace (see allowedRouteHostnames). The original HTTPRoute is never modified. It returns false
+// when the HTTPRoute must not be translated at all.
+func (t *Translator) enforceHostnamePoliciesOnHTTPRoute(httproute *gatewayapi.HTTPRoute) (*gatewayapi.HTTPRoute, bool) {
+	hostnames, ok := t.allowedRouteHostnames(httproute, httproute.Spec.Hostnames)
+	if !ok {
+		return nil, false
+	}
+	if len(hostnames) == len(httproute.Spec.Hostnames) {
+		return httproute, true
+	}
+	httproute = httproute.DeepCopy()
+	httproute.Spec.Hostnames = hostnames
+	return httproute, true
+}
+
+// enforceHostnamePoliciesOnGRPCRoute returns the GRPCRoute without the hostnames KongHostnamePolicies do not
+// allow in its namespace (see allowedRouteHostnames). The original GRPCRoute is never modified. It returns false
+// when the GRPCRoute must not be translated at all.
+func (t *Translator) enforceHostnamePoliciesOnGRPCRoute(grpcroute *gatewayapi.GRPCRoute) (*gatewayapi.GRPCRoute, bool) {
+	hostnames, ok := t.allowedRouteHostnames(grpcroute, grpcroute.Spec.Hostnames)
+	if !ok {
+		return nil, false
+	}
+	if len(hostnames) == len(grpcroute.Spec.Hostnames) {
+		return grpcroute, true
+	}
+	grpcroute = grpcroute.DeepCopy()
+	grpcroute.Spec.Hostnames = hostnames
+	return grpcroute, true
+}
+
+// enforceHostnamePoliciesOnTLSRoute returns the TLSRoute without the hostnames KongHostnamePolicies do not
+// allow in its namespace (see allowedRouteHostnames). The original TLSRoute is never modified. It returns false
+// when the TLSRoute must not be translated at all.
+func (t *Translator) enforceHostnamePoliciesOnTLSRoute(tlsroute *gatewayapi.TLSRoute) (*gatewayapi.TLSRoute, bool) {
+	hostnames, ok := t.allowedRouteHostnames(tlsroute, tlsroute.Spec.Hostnames)
+	if !ok {
+		return nil, false
+	}
+	if len(hostnames) == len(tlsroute.Spec.Hostnames) {
+		return tlsroute, true
+	}
+	tlsroute = tlsroute.DeepCopy()
+	tlsroute.Spec.Hostnames = hostnames
+	return tlsroute, true
+}
+
+// allowedRouteHostnames returns the route's hostnames KongHostnamePolicies allow in its namespace, registering
+// a translation failure for each rejected one. It returns false when none of the route's hostnames is allowed,
+// as translating it without hostnames would make it match all hosts, and when the route has no hostnames and
+// matching all hosts is not allowed in its namespace.
+func (t *Translator) allowedRouteHostnames(
+	route client.Object, hostnames []gatewayapi.Hostname,
+) ([]gatewayapi.Hostname, bool) {
+	policies := t.storer.ListKongHostnamePolicies()
+	if len(policies) == 0 {
+		return hostnames, true
+	}
+
+	if len(hostnames) == 0 {
+		rejected := t.rejectedHostnames(policies, route, []string{""})
+		return hostnames, len(rejected) == 0
+	}
+	rejected := t.rejectedHostnames(policies, route, lo.Map(hostnames, func(h gatewayapi.Hostname, _ int) string {
+		return string(h)
+	}))
+	allowedHostnames := lo.Filter(hostnames, func(hostname gatewayapi.Hostname, _ int) bool {
+		_, ok := rejected[string(hostname)]
+		return !ok
+	})
+	return allowedHostnames, len(allowedHostnames) > 0
+}
+
+// enforceHostnamePoliciesOnIngresses returns the Ingresses without the rules, TLS hosts and default backends
+// KongHostnamePolicies do not allow in their namespaces, registering a translation failure for each rejected
+// hostname. Rules without a host and default backends match all hosts. The original Ingresses are never modified.
+func (t *Translator) enforceHostnamePoliciesOnIngresses(ingresses []*netv1.Ingress) []*netv1.Ingress {
+	policies := t.storer.ListKongHostnamePolicies()
+	if len(policies) == 0 {
+		return ingresses
+	}
+
+	result := make([]*netv1.Ingress, 0, len(ingresses))
+	for _, ingress := range ingresses {
+		hosts := lo.Map(ingress.Spec.Rules, func(rule netv1.IngressRule, _ int) string { return rule.Host })
+		for _, ingressTLS := range ingress.Spec.TLS {
+			hosts = append(hosts, ingressTLS.Hosts...)
+		}
+		if ingress.Spec.DefaultBackend != nil {
+			hosts = append(hosts, "")
+		}
+		rejected := t.rejectedHostnames(policies, ingress, hosts)
+		if len(rejected) == 0 {
+			result = append(result, ingress)
+			continue
+		}
+
+		isAllowed := func(host string) bool {
+			_, ok := rejected[host]
+			return !ok
+		}
+		ingress = ingress.DeepCopy()
+		if !isAllowed("") {
+			ingress.Spec.DefaultBackend = nil
+		}
+		ingress.Spec.Rules = lo.Filter(ingress.Spec.Rules, func(rule netv1.IngressRule, _ int) bool {
+			return isAllowed(rule.Host)
+		})
+		ingress.Spec.TLS = filterIngressTLSHosts(ingress.Spec.TLS, isAllowed)
+		result = append(result, ingress)
+	}
+	return result
+}
+
+// enforceHostnamePoliciesOnTCPIngresses returns the TCPIngresses without the rules and TLS hosts
+// KongHostnamePolicies do not allow in their namespaces, registering a translation failure for each rejected
+// hostname. Rules without a host match all hosts. The original TCPIngresses are never modified.
+func (t *Translator) enforceHostnamePoliciesOnTCPIngresses(ingresses []*kongv1beta1.TCPIngress) []*kongv1beta1.TCPIngress {
+	policies := t.storer.ListKongHostnamePolicies()
+	if len(policies) == 0 {
+		return ingresses
+	}
+
+	result := make([]*kongv1beta1.TCPIngress, 0, len(ingresses))
+	for _, ingress := range ingresses {
+		hosts := lo.Map(ingress.Spec.Rules, func(rule kongv1beta1.IngressRule, _ int) string { return rule.Host })
+		for _, ingressTLS := range ingress.Spec.TLS {
+			hosts = append(hosts, ingressTLS.Hosts...)
+		}
+		rejected := t.rejectedHostnames(policies, ingress, hosts)
+		if len(rejected) == 0 {
+			result = append(result, ingress)
+			continue
+		}
+
+		isAllowed := func(host string) bool {
+			_, ok := rejected[host]
+			return !ok
+		}
+		ingress = ingress.DeepCopy()
+		ingress.Spec.Rules = lo.Filter(ingress.Spec.Rules, func(rule kongv1beta1.IngressRule, _ int) bool {
+			return isAllowed(rule.Host)
+		})
+		tls := filterIngressTLSHosts(tcpIngressToNetworkingTLS(ingress.Spec.TLS), isAllowed)
+		ingress.Spec.TLS = lo.Map(tls, func(ingressTLS netv1.IngressTLS, _ int) kongv1beta1.IngressTLS {
+			return kongv1beta1.IngressTLS{Hosts: ingressTLS.Hosts, SecretName: ingressTLS.SecretName}
+		})
+		result = append(result, ingress)
+	}
+	return result
+}
+
+// enforceHostnamePoliciesOnUDPIngresses returns the UDPIngresses KongHostnamePolicies allow in their namespaces,
+// registering a translation failure for each rejected one. UDPIngress rules have no hosts, so they match all hosts.
+func (t *Translator) enforceHostnamePoliciesOnUDPIngresses(ingresses []*kongv1beta1.UDPIngress) []*kongv1beta1.UDPIngress {
+	policies := t.storer.ListKongHostnamePolicies()
+	if len(policies) == 0 {
+		return ingresses
+	}
+
+	return lo.Filter(ingresses, func(ingress *kongv1beta1.UDPIngress, _ int) bool {
+		return len(ingress.Spec.Rules) == 0 || len(t.rejectedHostnames(policies, ingress, []string{""})) == 0
+	})
+}
+
+// rejectedHostnames returns the hosts of the object KongHostnamePolicies do not allow in its namespace. It registers
+// a translation failure for each of them and a hostname policy violation of the object if there's any.
+func (t *Translator) rejectedHostnames(
+	policies []*kongv1alpha1.KongHostnamePolicy, obj client.Object, hosts []string,
+) map[string]struct{} {
+	rejected := make(map[string]struct{})
+	for _, host := range lo.Uniq(hosts) {
+		if err := CheckHostnameAllowedByPolicies(policies, obj.GetNamespace(), host); err != nil {
+			rejected[host] = struct{}{}
+			t.registerTranslationFailure(err.Error(), obj)
+		}
+	}
+	if len(rejected) > 0 {
+		t.registerHostnamePolicyViolation(obj)
+	}
+	return rejected
+}
+
+// filterIngressTLSHosts returns the TLS sections with only the allowed hosts. The sections left without hosts
+// would apply to all hosts, so they're dropped altogether.
+func filterIngressTLSHosts(tls []netv1.IngressTLS, isAllowed func(string) bool) []netv1.IngressTLS {
+	tls = lo.Filter(tls, func(ingressTLS netv1.IngressTLS, _ int) bool {
+		return len(ingressTLS.Hosts) == 0 || lo.SomeBy(ingressTLS.Hosts, isAllowed)
+	})
+	for i := range tls {
+		tls[i].Hosts = lo.Filter(tls[i].Hosts, func(host string, _ int) bool {
+			return isAllowed(host)
+		})
+	}
+	return tls
+}
@@ -0,0 +1,475 @@
+package translator
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/failures"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/builder"
+	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
+	kongv1beta1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1beta1"
+)
+
+func TestCheckHostnameAllowedByPolicies(t *testing.T) {
+	policies := []*kongv1alpha1.KongHostnamePolicy{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "platform"},
+			Spec: kongv1alpha1.KongHostnamePolicySpec{
+				Hostnames:  []string{"*.example.com", "example.com"},
+				Namespaces: []string{"platform"},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "api"},
+			Spec: kongv1alpha1.KongHostnamePolicySpec{
+				Hostnames:  []string{"api.example.com", "*.api.example.com"},
+				Namespaces: []string{"team-a"},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "api-shared"},
+			Spec: kongv1alpha1.KongHostnamePolicySpec{
+				Hostnames:  []string{"api.example.com"},
+				Namespaces: []string{"team-b"},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		namespace     string
+		hostname      string
+		expectedError string
+	}{
+		{
+			name:      "hostname not selected by any policy",
+			namespace: "team-c",
+			hostname:  "example.org",
+		},
+		{
+			name:          "empty hostname covers hostnames owned by other namespaces",
+			namespace:     "team-c",
+			expectedError: `empty hostname (matching all hosts) covers hostname "*.api.example.com" which is not allowed in namespace team-c by KongHostnamePolicy api`,
+		},
+		{
+			name:      "wildcard hostname not covering any policy entry",
+			namespace: "team-c",
+			hostname:  "*.example.org",
+		},
+		{
+			name:      "wildcard hostname covering only entries owned by the namespace",
+			namespace: "team-a",
+			hostname:  "*.api.example.com",
+		},
+		{
+			name:          "wildcard hostname owned by the namespace covering hostnames owned by other namespaces",
+			namespace:     "platform",
+			hostname:      "*.example.com",
+			expectedError: `hostname "*.example.com" covers hostname "*.api.example.com" which is not allowed in namespace platform by KongHostnamePolicy api`,
+		},
+		{
+			name:          "wildcard hostname not matched by any entry covering hostnames owned by other namespaces",
+			namespace:     "team-c",
+			hostname:      "*.com",
+			expectedError: `hostname "*.com" covers hostname "*.api.example.com" which is not allowed in namespace team-c by KongHostnamePolicy api`,
+		},
+		{
+			name:      "hostname owned by the namespace through a wildcard",
+			namespace: "platform",
+			hostname:  "www.example.com",
+		},
+		{
+			name:          "hostname owned by another namespace through a wildcard",
+			namespace:     "team-a",
+			hostname:      "www.example.com",
+			expectedError: `hostname "www.example.com" is not allowed in namespace team-a by KongHostnamePolicy platform`,
+		},
+		{
+			name:          "bare domain owned by another namespace through an exact entry",
+			namespace:     "team-a",
+			hostname:      "example.com",
+			expectedError: `hostname "example.com" is not allowed in namespace team-a by KongHostnamePolicy platform`,
+		},
+		{
+			name:      "exact hostname takes precedence over a wildcard",
+			namespace: "team-a",
+			hostname:  "API.example.com",
+		},
+		{
+			name:      "exact hostname owned by multiple policies",
+			namespace: "team-b",
+			hostname:  "api.example.com",
+		},
+		{
+			name:          "wildcard owner cannot use a more specific hostname owned by another namespace",
+			namespace:     "platform",
+			hostname:      "api.example.com",
+			expectedError: `hostname "api.example.com" is not allowed in namespace platform by KongHostnamePolicy api, api-shared`,
+		},
+		{
+			name:      "longer wildcard takes precedence over a shorter one",
+			namespace: "team-a",
+			hostname:  "v1.api.example.com",
+		},
+		{
+			name:          "wildcard hostname is matched by wildcard entries",
+			namespace:     "team-b",
+			hostname:      "*.api.example.com",
+			expectedError: `hostname "*.api.example.com" is not allowed in namespace team-b by KongHostnamePolicy api`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := CheckHostnameAllowedByPolicies(policies, tc.namespace, tc.hostname)
+			if tc.expectedError == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tc.expectedError)
+		})
+	}
+}
+
+func TestTranslator_HostnamePolicies(t *testing.T) {
+	const (
+		ownerNamespace    = "owner"
+		intruderNamespace = "intruder"
+	)
+	policy := &kongv1alpha1.KongHostnamePolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "api"},
+		Spec: kongv1alpha1.KongHostnamePolicySpec{
+			Hostnames:  []string{"api.example.com"},
+			Namespaces: []string{ownerNamespace},
+		},
+	}
+	services := lo.Map([]string{ownerNamespace, intruderNamespace}, func(namespace string, _ int) *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "svc", Namespace: namespace},
+			Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Port: 80}}},
+		}
+	})
+	httpRoute := func(namespace, name string, hostnames ...gatewayapi.Hostname) *gatewayapi.HTTPRoute {
+		route := &gatewayapi.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: gatewayapi.HTTPRouteSpec{
+				Hostnames: hostnames,
+				Rules: []gatewayapi.HTTPRouteRule{{
+					Matches: []gatewayapi.HTTPRouteMatch{
+						builder.NewHTTPRouteMatch().WithPathPrefix("/").Build(),
+					},
+					BackendRefs: []gatewayapi.HTTPBackendRef{
+						builder.NewHTTPBackendRef("svc").WithPort(80).Build(),
+					},
+				}},
+			},
+		}
+		route.SetGroupVersionKind(httprouteGVK)
+		return route
+	}
+	ingress := func(namespace, name string, hosts ...string) *netv1.Ingress {
+		return &netv1.Ingress{
+			TypeMeta:   metav1.TypeMeta{Kind: "Ingress", APIVersion: netv1.SchemeGroupVersion.String()},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: netv1.IngressSpec{
+				IngressClassName: lo.ToPtr("kong"),
+				Rules: lo.Map(hosts, func(host string, _ int) netv1.IngressRule {
+					return netv1.IngressRule{
+						Host: host,
+						IngressRuleValue: netv1.IngressRuleValue{
+							HTTP: &netv1.HTTPIngressRuleValue{
+								Paths: []netv1.HTTPIngressPath{{
+									Path:     "/",
+									PathType: lo.ToPtr(netv1.PathTypePrefix),
+									Backend: netv1.IngressBackend{
+										Service: &netv1.IngressServiceBackend{
+											Name: "svc",
+											Port: netv1.ServiceBackendPort{Number: 80},
+										},
+									},
+								}},
+							},
+						},
+					}
+				}),
+				TLS: []netv1.IngressTLS{{Hosts: hosts, SecretName: "cert"}},
+			},
+		}
+	}
+
+	intruderIngress := ingress(intruderNamespace, "intruder-ingress", "api.example.com", "intruder.example.com")
+	s, err := store.NewFakeStore(store.FakeObjects{
+		Services: services,
+		HTTPRoutes: []*gatewayapi.HTTPRoute{
+			httpRoute(ownerNamespace, "owner-route", "api.example.com"),
+			httpRoute(intruderNamespace, "intruder-route", "api.example.com"),
+			httpRoute(intruderNamespace, "intruder-partial-route", "api.example.com", "intruder.example.com"),
+		},
+		IngressesV1: []*netv1.Ingress{
+			ingress(ownerNamespace, "owner-ingress", "api.example.com"),
+			intruderIngress,
+		},
+		KongHostnamePolicies: []*kongv1alpha1.KongHostnamePolicy{policy},
+	})
+	require.NoError(t, err)
+	p := mustNewTranslator(t, s)
+
+	t.Log("Translating HTTPRoutes, routes from the intruder namespace cannot use the owned hostname")
+	httpRouteRules := p.ingressRulesFromHTTPRoutes()
+	require.ElementsMatch(t, []string{
+		"owner/api.example.com",
+		"intruder/intruder.example.com",
+	}, routeHosts(httpRouteRules))
+
+	t.Log("Translating Ingresses, rules and TLS hosts from the intruder namespace cannot use the owned hostname")
+	ingressRules := p.ingressRulesFromIngressV1()
+	require.ElementsMatch(t, []string{
+		"owner/api.example.com",
+		"intruder/intruder.example.com",
+	}, routeHosts(ingressRules))
+	require.Equal(t, []string{"intruder.example.com"}, ingressRules.SecretNameToSNIs.Hosts(intruderNamespace+"/cert"))
+	require.Equal(t, []string{"api.example.com", "intruder.example.com"}, intruderIngress.Spec.TLS[0].Hosts,
+		"Ingress in the store must not be modified")
+
+	const expectedFailure = `hostname "api.example.com" is not allowed in namespace intruder by KongHostnamePolicy api`
+	require.ElementsMatch(t, []string{
+		"HTTPRoute intruder/intruder-route: " + expectedFailure,
+		"HTTPRoute intruder/intruder-partial-route: " + expectedFailure,
+		"Ingress intruder/intruder-ingress: " + expectedFailure,
+	}, resourceFailureMessages(p.failuresCollector.PopResourceFailures()))
+}
+
+func TestTranslator_HostnamePoliciesWildcardAndEmptyHostnames(t *testing.T) {
+	policy := &kongv1alpha1.KongHostnamePolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "api"},
+		Spec: kongv1alpha1.KongHostnamePolicySpec{
+			Hostnames:  []string{"api.example.com"},
+			Namespaces: []string{"owner"},
+		},
+	}
+	httpRoute := func(name string, hostnames ...gatewayapi.Hostname) *gatewayapi.HTTPRoute {
+		route := &gatewayapi.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "intruder"},
+			Spec: gatewayapi.HTTPRouteSpec{
+				Hostnames: hostnames,
+				Rules: []gatewayapi.HTTPRouteRule{{
+					BackendRefs: []gatewayapi.HTTPBackendRef{
+						builder.NewHTTPBackendRef("svc").WithPort(80).Build(),
+					},
+				}},
+			},
+		}
+		route.SetGroupVersionKind(httprouteGVK)
+		return route
+	}
+	ingressBackend := netv1.IngressBackend{
+		Service: &netv1.IngressServiceBackend{Name: "svc", Port: netv1.ServiceBackendPort{Number: 80}},
+	}
+	ingress := &netv1.Ingress{
+		TypeMeta:   metav1.TypeMeta{Kind: "Ingress", APIVersion: netv1.SchemeGroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{Name: "intruder-ingress", Namespace: "intruder"},
+		Spec: netv1.IngressSpec{
+			IngressClassName: lo.ToPtr("kong"),
+			DefaultBackend:   &ingressBackend,
+			Rules: lo.Map([]string{"", "*.example.com", "intruder.example.com"}, func(host string, _ int) netv1.IngressRule {
+				return netv1.IngressRule{
+					Host: host,
+					IngressRuleValue: netv1.IngressRuleValue{
+						HTTP: &netv1.HTTPIngressRuleValue{
+							Paths: []netv1.HTTPIngressPath{{
+								Path:     "/",
+								PathType: lo.ToPtr(netv1.PathTypePrefix),
+								Backend:  ingressBackend,
+							}},
+						},
+					},
+				}
+			}),
+		},
+	}
+	s, err := store.NewFakeStore(store.FakeObjects{
+		Services: []*corev1.Service{{
+			ObjectMeta: metav1.ObjectMeta{Name: "svc", Namespace: "intruder"},
+			Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Port: 80}}},
+		}},
+		HTTPRoutes: []*gatewayapi.HTTPRoute{
+			httpRoute("hostless-route"),
+			httpRoute("wildcard-route", "*.example.com"),
+			httpRoute("partial-wildcard-route", "*.example.com", "intruder.example.com"),
+		},
+		IngressesV1:          []*netv1.Ingress{ingress},
+		KongHostnamePolicies: []*kongv1alpha1.KongHostnamePolicy{policy},
+	})
+	require.NoError(t, err)
+	p := mustNewTranslator(t, s)
+
+	t.Log("Translating HTTPRoutes, hostless and wildcard routes cannot match the owned hostname")
+	require.ElementsMatch(t, []string{"intruder/intruder.example.com"}, routeHosts(p.ingressRulesFromHTTPRoutes()))
+
+	t.Log("Translating Ingresses, hostless and wildcard rules and the default backend cannot match the owned hostname")
+	ingressRules := p.ingressRulesFromIngressV1()
+	require.ElementsMatch(t, []string{"intruder/intruder.example.com"}, routeHosts(ingressRules))
+	for _, service := range ingressRules.ServiceNameToServices {
+		for _, route := range service.Routes {
+			require.NotEmpty(t, route.Hosts, "no route matching all hosts is expected")
+		}
+	}
+	require.NotNil(t, ingress.Spec.DefaultBackend, "Ingress in the store must not be modified")
+
+	const (
+		emptyHostnameFailure    = `empty hostname (matching all hosts) covers hostname "api.example.com" which is not allowed in namespace intruder by KongHostnamePolicy api`
+		wildcardHostnameFailure = `hostname "*.example.com" covers hostname "api.example.com" which is not allowed in namespace intruder by KongHostnamePolicy api`
+	)
+	require.ElementsMatch(t, []string{
+		"HTTPRoute intruder/hostless-route: " + emptyHostnameFailure,
+		"HTTPRoute intruder/wildcard-route: " + wildcardHostnameFailure,
+		"HTTPRoute intruder/partial-wildcard-route: " + wildcardHostnameFailure,
+		"Ingress intruder/intruder-ingress: " + emptyHostnameFailure,
+		"Ingress intruder/intruder-ingress: " + wildcardHostnameFailure,
+	}, resourceFailureMessages(p.failuresCollector.PopResourceFailures()))
+	require.ElementsMatch(t, []string{
+		"intruder/hostless-route",
+		"intruder/wildcard-route",
+		"intruder/partial-wildcard-route",
+		"intruder/intruder-ingress",
+	}, lo.Map(p.popHostnamePolicyViolations(), func(obj client.Object, _ int) string {
+		return client.ObjectKeyFromObject(obj).String()
+	}))
+}
+
+func TestTranslator_HostnamePoliciesOnGRPCRoutesTLSRoutesAndL4Ingresses(t *testing.T) {
+	policy := &kongv1alpha1.KongHostnamePolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "api"},
+		Spec: kongv1alpha1.KongHostnamePolicySpec{
+			Hostnames:  []string{"api.example.com"},
+			Namespaces: []string{"owner"},
+		},
+	}
+	ingressClassAnnotations := map[string]string{annotations.IngressClassKey: annotations.DefaultIngressClass}
+	tcpIngress := func(namespace string, hosts ...string) *kongv1beta1.TCPIngress {
+		ingress := &kongv1beta1.TCPIngress{
+			ObjectMeta: metav1.ObjectMeta{Name: "tcp", Namespace: namespace, Annotations: ingressClassAnnotations},
+			Spec: kongv1beta1.TCPIngressSpec{
+				Rules: lo.Map(hosts, func(host string, i int) kongv1beta1.IngressRule {
+					return kongv1beta1.IngressRule{
+						Host:    host,
+						Port:    9000 + i,
+						Backend: kongv1beta1.IngressBackend{ServiceName: "svc", ServicePort: 80},
+					}
+				}),
+				TLS: []kongv1beta1.IngressTLS{{Hosts: lo.Compact(hosts), SecretName: "cert"}},
+			},
+		}
+		ingress.SetGroupVersionKind(kongv1beta1.SchemeGroupVersion.WithKind("TCPIngress"))
+		return ingress
+	}
+	udpIngress := func(namespace string) *kongv1beta1.UDPIngress {
+		ingress := &kongv1beta1.UDPIngress{
+			ObjectMeta: metav1.ObjectMeta{Name: "udp", Namespace: namespace, Annotations: ingressClassAnnotations},
+			Spec: kongv1beta1.UDPIngressSpec{
+				Rules: []kongv1beta1.UDPIngressRule{{
+					Port:    9000,
+					Backend: kongv1beta1.IngressBackend{ServiceName: "svc", ServicePort: 80},
+				}},
+			},
+		}
+		ingress.SetGroupVersionKind(kongv1beta1.SchemeGroupVersion.WithKind("UDPIngress"))
+		return ingress
+	}
+	s, err := store.NewFakeStore(store.FakeObjects{
+		TCPIngresses: []*kongv1beta1.TCPIngress{
+			tcpIngress("owner", "api.example.com"),
+			tcpIngress("intruder", "api.example.com", "intruder.example.com", ""),
+		},
+		UDPIngresses: []*kongv1beta1.UDPIngress{
+			udpIngress("owner"),
+			udpIngress("intruder"),
+		},
+		KongHostnamePolicies: []*kongv1alpha1.KongHostnamePolicy{policy},
+	})
+	require.NoError(t, err)
+	p := mustNewTranslator(t, s)
+
+	t.Log("Enforcing policies on a GRPCRoute, the owned hostname is dropped")
+	grpcRoute := &gatewayapi.GRPCRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "grpc", Namespace: "intruder"},
+		Spec: gatewayapi.GRPCRouteSpec{
+			Hostnames: []gatewayapi.Hostname{"api.example.com", "intruder.example.com"},
+		},
+	}
+	grpcRoute.SetGroupVersionKind(schema.GroupVersionKind{Group: gatewayapi.GroupVersion.Group, Version: gatewayapi.GroupVersion.Version, Kind: "GRPCRoute"})
+	enforcedGRPCRoute, ok := p.enforceHostnamePoliciesOnGRPCRoute(grpcRoute)
+	require.True(t, ok)
+	require.Equal(t, []gatewayapi.Hostname{"intruder.example.com"}, enforcedGRPCRoute.Spec.Hostnames)
+	require.Len(t, grpcRoute.Spec.Hostnames, 2, "GRPCRoute must not be modified")
+
+	t.Log("Enforcing policies on a TLSRoute, a wildcard hostname covering the owned one is dropped with the whole route")
+	tlsRoute := &gatewayapi.TLSRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "tls", Namespace: "intruder"},
+		Spec: gatewayapi.TLSRouteSpec{
+			Hostnames: []gatewayapi.Hostname{"*.example.com"},
+		},
+	}
+	tlsRoute.SetGroupVersionKind(schema.GroupVersionKind{Group: gatewayapi.GroupVersion.Group, Version: "v1alpha2", Kind: "TLSRoute"})
+	_, ok = p.enforceHostnamePoliciesOnTLSRoute(tlsRoute)
+	require.False(t, ok)
+
+	t.Log("Translating TCPIngresses, rules with the owned hostname or without a host are dropped in the intruder namespace")
+	tcpIngressRules := p.ingressRulesFromTCPIngressV1beta1()
+	var snis []string
+	for _, service := range tcpIngressRules.ServiceNameToServices {
+		for _, route := range service.Routes {
+			require.NotEmpty(t, route.SNIs, "no route matching all hosts is expected")
+			snis = append(snis, service.Namespace+"/"+*route.SNIs[0])
+		}
+	}
+	require.ElementsMatch(t, []string{"owner/api.example.com", "intruder/intruder.example.com"}, snis)
+	require.Equal(t, []string{"intruder.example.com"}, tcpIngressRules.SecretNameToSNIs.Hosts("intruder/cert"))
+
+	t.Log("Translating UDPIngresses, the one in the intruder namespace matching all hosts is dropped")
+	udpIngressRules := p.ingressRulesFromUDPIngressV1beta1()
+	require.Equal(t, []string{"owner"}, lo.Uniq(lo.MapToSlice(udpIngressRules.ServiceNameToServices,
+		func(_ string, service kongstate.Service) string { return service.Namespace },
+	)))
+
+	require.ElementsMatch(t, []string{
+		"GRPCRoute intruder/grpc",
+		"TLSRoute intruder/tls",
+		"TCPIngress intruder/tcp",
+		"UDPIngress intruder/udp",
+	}, lo.Map(p.popHostnamePolicyViolations(), func(obj client.Object, _ int) string {
+		return obj.GetObjectKind().GroupVersionKind().Kind + " " + client.ObjectKeyFromObject(obj).String()
+	}))
+}
+
+// resourceFailureMessages returns the messages of the resource failures prefixed with their causing object.
+func resourceFailureMessages(resourceFailures []failures.ResourceFailure) []string {
+	return lo.Map(resourceFailures, func(f failures.ResourceFailure, _ int) string {
+		obj := f.CausingObjects()[0]
+		return obj.GetObjectKind().GroupVersionKind().Kind + " " + obj.GetNamespace() + "/" + obj.GetName() + ": " + f.Message()
+	})
+}
+
+// routeHosts returns the hosts of all the routes in the ingress rules prefixed with the namespace of their service.
+func routeHosts(rules ingressRules) []string {
+	var hosts []string
+	for _, service := range rules.ServiceNameToServices {
+		for _, route := range service.Routes {
+			hosts = append(hosts, lo.Map(route.Hosts, func(host *string, _ int) string {
+				return service.Namespace + "/" + *host
+			})...)
+		}
+	}
+	return hosts
+}
@@ -0,0 +1,91 @@
+package translator
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/kong/go-kong/kong"
+	netv1 "k8s.io/api/networking/v1"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
+)
+
+// ingressClassDefaultAnnotations returns the Ingress annotations equivalent to the Route defaults of the
+// IngressClassParameters.
+func ingressClassDefaultAnnotations(defaults *kongv1alpha1.IngressClassParametersDefaults) map[string]string {
+	if defaults == nil {
+		return nil
+	}
+
+	anns := make(map[string]string)
+	if len(defaults.Protocols) > 0 {
+		anns[annotations.AnnotationPrefix+annotations.ProtocolsKey] = strings.Join(defaults.Protocols, ",")
+	}
+	if defaults.StripPath != nil {
+		anns[annotations.AnnotationPrefix+annotations.StripPathKey] = strconv.FormatBool(*defaults.StripPath)
+	}
+	if defaults.PreserveHost != nil {
+		anns[annotations.AnnotationPrefix+annotations.PreserveHostKey] = strconv.FormatBool(*defaults.PreserveHost)
+	}
+	if defaults.PathHandling != nil {
+		anns[annotations.AnnotationPrefix+annotations.PathHandlingKey] = *defaults.PathHandling
+	}
+	if defaults.HTTPSRedirectStatusCode != nil {
+		anns[annotations.AnnotationPrefix+annotations.HTTPSRedirectCodeKey] = strconv.Itoa(*defaults.HTTPSRedirectStatusCode)
+	}
+	if len(defaults.Plugins) > 0 {
+		anns[annotations.AnnotationPrefix+annotations.PluginsKey] = strings.Join(defaults.Plugins, ",")
+	}
+	return anns
+}
+
+// applyIngressClassDefaultsToIngresses returns the Ingresses with the Route defaults of the IngressClassParameters
+// added to their annotations. An annotation set on an Ingress takes precedence over the class default, e.g. an
+// Ingress with `konghq.com/plugins` gets only the plugins listed in the annotation, not the default ones.
+// Ingresses are shallow-copied so the objects in the store are not modified.
+func applyIngressClassDefaultsToIngresses(
+	ingresses []*netv1.Ingress,
+	defaults *kongv1alpha1.IngressClassParametersDefaults,
+) []*netv1.Ingress {
+	defaultAnns := ingressClassDefaultAnnotations(defaults)
+	if len(defaultAnns) == 0 {
+		return ingresses
+	}
+
+	result := make([]*netv1.Ingress, 0, len(ingresses))
+	for _, ingress := range ingresses {
+		anns := make(map[string]string, len(defaultAnns)+len(ingress.Annotations))
+		for k, v := range defaultAnns {
+			anns[k] = v
+		}
+		for k, v := range ingress.Annotations {
+			anns[k] = v
+		}
+		ingressWithDefaults := *ingress
+		ingressWithDefaults.Annotations = anns
+		result = append(result, &ingressWithDefaults)
+	}
+	return result
+}
+
+// applyIngressClassDefaultsToService sets the Service defaults of the IngressClassParameters on a Kong Service
+// translated from Ingresses. The annotations of Kubernetes Services applied later take precedence over them.
+func applyIngressClassDefaultsToService(service *kongstate.Service, defaults *kongv1alpha1.IngressClassParametersDefaults) {
+	if defaults == nil {
+		return
+	}
+	if defaults.ConnectTimeout != nil {
+		service.ConnectTimeout = kong.Int(*defaults.ConnectTimeout)
+	}
+	if defaults.ReadTimeout != nil {
+		service.ReadTimeout = kong.Int(*defaults.ReadTimeout)
+	}
+	if defaults.WriteTimeout != nil {
+		service.WriteTimeout = kong.Int(*defaults.WriteTimeout)
+	}
+	if defaults.Retries != nil {
+		service.Retries = kong.Int(*defaults.Retries)
+	}
+}
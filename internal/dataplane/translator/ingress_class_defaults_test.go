@@ -0,0 +1,89 @@
+package translator
+
+import (
+	"testing"
+
+	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
+)
+
+func TestApplyIngressClassDefaultsToIngresses(t *testing.T) {
+	defaults := &kongv1alpha1.IngressClassParametersDefaults{
+		Protocols:               []string{"https"},
+		StripPath:               lo.ToPtr(false),
+		PreserveHost:            lo.ToPtr(true),
+		PathHandling:            lo.ToPtr("v1"),
+		HTTPSRedirectStatusCode: lo.ToPtr(308),
+		Plugins:                 []string{"rate-limiting", "cors"},
+	}
+	withoutAnnotations := &netv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Name: "without-annotations", Namespace: "default"},
+	}
+	withAnnotations := &netv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "with-annotations",
+			Namespace: "default",
+			Annotations: map[string]string{
+				"konghq.com/strip-path": "true",
+				"konghq.com/plugins":    "key-auth",
+			},
+		},
+	}
+
+	ingresses := applyIngressClassDefaultsToIngresses([]*netv1.Ingress{withoutAnnotations, withAnnotations}, defaults)
+	require.Len(t, ingresses, 2)
+	require.Equal(t, map[string]string{
+		"konghq.com/protocols":                  "https",
+		"konghq.com/strip-path":                 "false",
+		"konghq.com/preserve-host":              "true",
+		"konghq.com/path-handling":              "v1",
+		"konghq.com/https-redirect-status-code": "308",
+		"konghq.com/plugins":                    "rate-limiting,cors",
+	}, ingresses[0].Annotations)
+	require.Equal(t, map[string]string{
+		"konghq.com/protocols":                  "https",
+		"konghq.com/strip-path":                 "true",
+		"konghq.com/preserve-host":              "true",
+		"konghq.com/path-handling":              "v1",
+		"konghq.com/https-redirect-status-code": "308",
+		"konghq.com/plugins":                    "key-auth",
+	}, ingresses[1].Annotations, "annotations of the Ingress should take precedence over the defaults")
+
+	require.Nil(t, withoutAnnotations.Annotations, "the original Ingress should not be modified")
+	require.Len(t, withAnnotations.Annotations, 2, "the original Ingress should not be modified")
+
+	t.Run("without defaults the Ingresses are returned as they are", func(t *testing.T) {
+		input := []*netv1.Ingress{withAnnotations}
+		require.Equal(t, input, applyIngressClassDefaultsToIngresses(input, nil))
+		require.Equal(t, input, applyIngressClassDefaultsToIngresses(input, &kongv1alpha1.IngressClassParametersDefaults{}))
+	})
+}
+
+func TestApplyIngressClassDefaultsToService(t *testing.T) {
+	service := kongstate.Service{
+		Service: kong.Service{
+			ConnectTimeout: kong.Int(60000),
+			ReadTimeout:    kong.Int(60000),
+			WriteTimeout:   kong.Int(60000),
+			Retries:        kong.Int(5),
+		},
+	}
+
+	applyIngressClassDefaultsToService(&service, nil)
+	require.Equal(t, 60000, *service.ReadTimeout)
+
+	applyIngressClassDefaultsToService(&service, &kongv1alpha1.IngressClassParametersDefaults{
+		ReadTimeout: lo.ToPtr(10000),
+		Retries:     lo.ToPtr(0),
+	})
+	require.Equal(t, 60000, *service.ConnectTimeout)
+	require.Equal(t, 10000, *service.ReadTimeout)
+	require.Equal(t, 60000, *service.WriteTimeout)
+	require.Equal(t, 0, *service.Retries)
+}
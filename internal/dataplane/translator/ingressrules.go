@@ -2,6 +2,7 @@ package translator
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/go-logr/logr"
@@ -9,6 +10,7 @@ import (
 	"github.com/samber/lo"
 	corev1 "k8s.io/api/core/v1"
 	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8stypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -19,6 +21,7 @@ import (
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/logging"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
+	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
 )
 
 func getClientCertIncompatibleProtocols() []string {
@@ -41,19 +44,47 @@ func newIngressRules() ingressRules {
 
 func mergeIngressRules(objs ...ingressRules) ingressRules {
 	result := newIngressRules()
-
 	for _, obj := range objs {
-		result.SecretNameToSNIs.merge(obj.SecretNameToSNIs)
-		for k, v := range obj.ServiceNameToServices {
-			result.ServiceNameToServices[k] = v
-		}
-		for k, v := range obj.ServiceNameToParent {
-			result.ServiceNameToParent[k] = v
-		}
+		result.merge(obj)
 	}
 	return result
 }
 
+// merge merges the other ingress rules into the ingress rules.
+func (ir *ingressRules) merge(other ingressRules) {
+	ir.SecretNameToSNIs.merge(other.SecretNameToSNIs)
+	for k, v := range other.ServiceNameToServices {
+		// Services of KongServiceFacades and KongExternalBackends are shared by all the objects using them
+		// as backends (e.g. Ingresses and HTTPRoutes), so their routes have to be merged.
+		if existing, ok := ir.ServiceNameToServices[k]; ok && isDedicatedBackendService(existing) && isDedicatedBackendService(v) {
+			v.Routes = append(slices.Clone(existing.Routes), v.Routes...)
+		}
+		ir.ServiceNameToServices[k] = v
+	}
+	for k, v := range other.ServiceNameToParent {
+		ir.ServiceNameToParent[k] = v
+	}
+}
+
+// deepCopy returns a copy of the ingress rules that can be modified without affecting the original.
+func (ir ingressRules) deepCopy() ingressRules {
+	out := newIngressRules()
+	out.SecretNameToSNIs.merge(ir.SecretNameToSNIs)
+	for k, v := range ir.ServiceNameToServices {
+		out.ServiceNameToServices[k] = v.DeepCopy()
+	}
+	for k, v := range ir.ServiceNameToParent {
+		out.ServiceNameToParent[k] = v
+	}
+	return out
+}
+
+// isDedicatedBackendService returns true if the service is backed by a single KongServiceFacade
+// or KongExternalBackend. Such services are named after their backend and use it as their parent.
+func isDedicatedBackendService(service kongstate.Service) bool {
+	return len(service.Backends) == 1 && (service.Backends[0].IsServiceFacade() || service.Backends[0].IsExternalBackend())
+}
+
 // populateServices populates the ServiceNameToServices map with additional information
 // and returns a map of services to be skipped.
 func (ir *ingressRules) populateServices(
@@ -147,8 +178,9 @@ func (ir *ingressRules) generateKongServiceTags(
 
 	// For single-backend Services we ...
 	if len(k8sServices) == 1 {
-		// ... either use the parent object of the Service when its backend is a KongServiceFacade ...
-		if len(service.Backends) == 1 && service.Backends[0].IsServiceFacade() {
+		// ... either use the parent object of the Service when its backend is a KongServiceFacade
+		// or a KongExternalBackend ...
+		if isDedicatedBackendService(service) {
 			return util.GenerateTagsForObject(service.Parent)
 		}
 		// ... or use the backing Kubernetes Service.
@@ -323,6 +355,21 @@ func resolveKubernetesServiceForBackend(
 	backend kongstate.ServiceBackend,
 	translatedObjectsCollector *ObjectsCollector,
 ) (*corev1.Service, error) {
+	// In case of KongExternalBackend, there's no Kubernetes Service backing it. We use a Service standing in for
+	// the KongExternalBackend instead, so its annotations (e.g. plugins or upstream policy) are handled the same
+	// way as for Kubernetes Services and all the problems related to them are reported for the KongExternalBackend.
+	if backend.IsExternalBackend() {
+		externalBackend, err := storer.GetKongExternalBackend(backend.Namespace(), backend.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch KongExternalBackend %s/%s: %w", backend.Namespace(), backend.Name(), err)
+		}
+
+		// After KongExternalBackend is fetched successfully, we can consider it a translated object.
+		translatedObjectsCollector.Add(externalBackend)
+
+		return serviceForExternalBackend(externalBackend), nil
+	}
+
 	// In case of KongServiceFacade, we need to fetch it to determine the Kubernetes Service backing it.
 	// We also want to use its annotations as they override the annotations of the Kubernetes Service.
 	if backend.IsServiceFacade() {
@@ -366,6 +413,18 @@ func resolveKubernetesServiceForBackend(
 	return k8sService, nil
 }
 
+// serviceForExternalBackend returns a Kubernetes Service standing in for the KongExternalBackend. It has the same
+// metadata as the KongExternalBackend, and its TypeMeta points to the KongExternalBackend kind.
+func serviceForExternalBackend(externalBackend *kongv1alpha1.KongExternalBackend) *corev1.Service {
+	return &corev1.Service{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: kongv1alpha1.GroupVersion.String(),
+			Kind:       kongv1alpha1.KongExternalBackendKind,
+		},
+		ObjectMeta: *externalBackend.ObjectMeta.DeepCopy(),
+	}
+}
+
 // collectInconsistentAnnotations takes a list of services and annotation+value pairs and confirms that all services
 // have those annotations with those values. If any service does not have one of the annotation+value pairs, push
 // a resource failure to the provided collector for all services indicating the problem annotation.
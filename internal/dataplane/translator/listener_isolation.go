@@ -0,0 +1,402 @@
+package translator
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator/atc"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator/subtranslator"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
+)
+
+// listenerIsolationRouteNameSuffix is appended to the names of routes generated in the traditional router flavors
+// to reject requests for hostnames of listeners the original route is not attached to.
+const listenerIsolationRouteNameSuffix = ".listener-isolation"
+
+// applyListenerHostnameIsolation implements listener isolation required by Gateway API: when a Gateway has
+// multiple HTTP or HTTPS listeners on the same port with overlapping hostnames (e.g. `*.example.com` and
+// `foo.example.com`), requests are served only by routes attached to the most specific matching listener.
+//
+// Routes translated from HTTPRoutes and GRPCRoutes attached to a less specific listener are modified so that
+// they don't match hostnames of the more specific listeners they're not attached to:
+//   - with the expressions router, the expression of the route gets a negative match of these hostnames,
+//   - with the traditional routers, these hostnames are removed from the route's hosts and, as negative matches
+//     aren't supported, requests for them that would match the route's wildcard hosts are rejected with 404
+//     by an additional route with the same matches. Paths for which routes of other objects with the exact
+//     hostname have an equal or less specific prefix are left out of such a route, as it would take precedence
+//     over these routes (which in turn take precedence over the route's wildcard hosts anyway).
+func (t *Translator) applyListenerHostnameIsolation(rules *ingressRules) {
+	// Routes are indexed by their hosts before any of them is modified, so the result doesn't depend on the order
+	// the services are isolated in. Isolation replaces the routes slices of services, so a shallow copy of
+	// the services is enough.
+	originalServices := maps.Clone(rules.ServiceNameToServices)
+	var routesByHost map[string][]kongstate.Route
+	uncoveredPaths := func(route kongstate.Route, hostname string) ([]*string, bool) {
+		if routesByHost == nil {
+			routesByHost = traditionalRoutesByHost(originalServices)
+		}
+		owner := routeOwnerKey(route)
+		others := lo.Filter(routesByHost[strings.ToLower(hostname)], func(r kongstate.Route, _ int) bool {
+			return routeOwnerKey(r) != owner
+		})
+		isCovered := func(path string) bool {
+			return lo.SomeBy(others, func(r kongstate.Route) bool { return routeCoversPath(r, route, path) })
+		}
+		if len(route.Paths) == 0 {
+			return nil, !isCovered("/")
+		}
+		paths := lo.Filter(route.Paths, func(path *string, _ int) bool { return path != nil && !isCovered(*path) })
+		return paths, len(paths) > 0
+	}
+
+	excludedByRoute := make(map[string][]string)
+	for serviceName, service := range rules.ServiceNameToServices {
+		isolatedRoutes := make([]kongstate.Route, 0, len(service.Routes))
+		modified := false
+		for _, route := range service.Routes {
+			kind := route.Ingress.GroupVersionKind.Kind
+			if kind != "HTTPRoute" && kind != "GRPCRoute" {
+				isolatedRoutes = append(isolatedRoutes, route)
+				continue
+			}
+
+			key := routeOwnerKey(route)
+			excluded, ok := excludedByRoute[key]
+			if !ok {
+				excluded = t.listenerIsolationExcludedHostnames(route.Ingress)
+				excludedByRoute[key] = excluded
+			}
+			if len(excluded) == 0 {
+				isolatedRoutes = append(isolatedRoutes, route)
+				continue
+			}
+
+			modified = true
+			if route.ExpressionRoutes {
+				isolatedRoutes = append(isolatedRoutes, isolateExpressionRoute(route, excluded))
+			} else {
+				isolatedRoutes = append(isolatedRoutes, isolateTraditionalRoute(route, excluded, func(hostname string) ([]*string, bool) {
+					return uncoveredPaths(route, hostname)
+				})...)
+			}
+		}
+		if modified {
+			service.Routes = isolatedRoutes
+			rules.ServiceNameToServices[serviceName] = service
+		}
+	}
+}
+
+// traditionalRoutesByHost returns the traditional routes of the services indexed by their (lowercase) hosts.
+func traditionalRoutesByHost(services map[string]kongstate.Service) map[string][]kongstate.Route {
+	routes := make(map[string][]kongstate.Route)
+	for _, service := range services {
+		for _, route := range service.Routes {
+			if route.ExpressionRoutes {
+				continue
+			}
+			for _, host := range lo.Uniq(derefStrings(route.Hosts)) {
+				host = strings.ToLower(host)
+				routes[host] = append(routes[host], route)
+			}
+		}
+	}
+	return routes
+}
+
+// routeCoversPath tells whether the covering route matches all the requests for the path the route matches
+// with an equal or less specific path, so Kong prefers it over a route having the path with the same host.
+// Protocols, methods and headers of the covering route must not be more restrictive than the route's.
+func routeCoversPath(covering, route kongstate.Route, path string) bool {
+	protocols := func(r kongstate.Route) []string {
+		return orDefault(derefStrings(r.Protocols), []string{"http", "https"})
+	}
+	if !lo.Every(protocols(covering), protocols(route)) {
+		return false
+	}
+	if len(covering.Methods) > 0 &&
+		(len(route.Methods) == 0 || !lo.Every(derefStrings(covering.Methods), derefStrings(route.Methods))) {
+		return false
+	}
+	if len(covering.Headers) > 0 && describeRouteHeaders(covering.Headers) != describeRouteHeaders(route.Headers) {
+		return false
+	}
+	if len(covering.Paths) == 0 {
+		return true
+	}
+	return lo.ContainsBy(derefStrings(covering.Paths), func(p string) bool { return pathCoversPath(p, path) })
+}
+
+// pathCoversPath tells whether the Kong route path covering matches all the requests the path matches. A regex
+// path only covers the same regex, a prefix path covers the paths (or the literal beginnings of regex paths)
+// it's a prefix of.
+func pathCoversPath(covering, path string) bool {
+	if strings.HasPrefix(covering, subtranslator.KongPathRegexPrefix) {
+		return covering == path
+	}
+	if regex, ok := strings.CutPrefix(path, subtranslator.KongPathRegexPrefix); ok {
+		if i := strings.IndexAny(regex, `\.^$*+?()[]{}|`); i >= 0 {
+			regex = regex[:i]
+		}
+		path = regex
+	}
+	return strings.HasPrefix(path, covering)
+}
+
+func orDefault(values, defaults []string) []string {
+	if len(values) == 0 {
+		return defaults
+	}
+	return values
+}
+
+// routeOwnerKey returns the key of the Kubernetes object the route was translated from.
+func routeOwnerKey(route kongstate.Route) string {
+	return route.Ingress.GroupVersionKind.Kind + "/" + route.Ingress.Namespace + "/" + route.Ingress.Name
+}
+
+// isListenerIsolationRoute tells whether the route rejects requests for hostnames of listeners its source
+// object is not attached to.
+func isListenerIsolationRoute(route kongstate.Route) bool {
+	return route.Name != nil && strings.HasSuffix(*route.Name, listenerIsolationRouteNameSuffix)
+}
+
+// listenerIsolationExcludedHostnames returns hostnames the route translated from the given HTTPRoute or GRPCRoute
+// must not match, because they belong to more specific listeners of its parent Gateways on the same ports as
+// the listeners the route is attached to, and the route isn't attached to them.
+func (t *Translator) listenerIsolationExcludedHostnames(info util.K8sObjectInfo) []string {
+	parentRefs, routeHostnames := t.routeParentRefsAndHostnames(info)
+
+	// Collect the listeners the route is attached to, grouped by parent Gateways.
+	gateways := make(map[types.NamespacedName]*gatewayapi.Gateway)
+	attachedListeners := make(map[types.NamespacedName][]gatewayapi.Listener)
+	for _, parentRef := range parentRefs {
+		nn, ok := parentRefGatewayNamespacedName(info.Namespace, parentRef)
+		if !ok {
+			continue
+		}
+		gateway, ok := gateways[nn]
+		if !ok {
+			var err error
+			if gateway, err = t.storer.GetGateway(nn.Namespace, nn.Name); err != nil {
+				continue
+			}
+			gateways[nn] = gateway
+		}
+		for _, listener := range gateway.Spec.Listeners {
+			if !isHTTPOrHTTPSListener(listener) ||
+				(parentRef.SectionName != nil && *parentRef.SectionName != listener.Name) ||
+				(parentRef.Port != nil && *parentRef.Port != listener.Port) ||
+				!listenerHostnameIntersectsWithHostnames(listener, routeHostnames) {
+				continue
+			}
+			if !lo.ContainsBy(attachedListeners[nn], func(l gatewayapi.Listener) bool { return l.Name == listener.Name }) {
+				attachedListeners[nn] = append(attachedListeners[nn], listener)
+			}
+		}
+	}
+
+	var excluded []string
+	for nn, attached := range attachedListeners {
+		for _, listener := range gateways[nn].Spec.Listeners {
+			if !isHTTPOrHTTPSListener(listener) || listener.Hostname == nil ||
+				lo.ContainsBy(attached, func(l gatewayapi.Listener) bool { return l.Name == listener.Name }) {
+				continue
+			}
+			hostname := string(*listener.Hostname)
+			if lo.Contains(excluded, hostname) ||
+				!listenerHostnameIntersectsWithHostnames(listener, routeHostnames) ||
+				!isListenerIsolatedFrom(listener, attached) {
+				continue
+			}
+			excluded = append(excluded, hostname)
+		}
+	}
+	return excluded
+}
+
+// routeParentRefsAndHostnames returns parent references and hostnames of the HTTPRoute or GRPCRoute
+// a Kong route was translated from.
+func (t *Translator) routeParentRefsAndHostnames(info util.K8sObjectInfo) ([]gatewayapi.ParentReference, []gatewayapi.Hostname) {
+	obj, ok := t.routeSourceObject(info)
+	if !ok {
+		return nil, nil
+	}
+	switch route := obj.(type) {
+	case *gatewayapi.HTTPRoute:
+		return route.Spec.ParentRefs, route.Spec.Hostnames
+	case *gatewayapi.GRPCRoute:
+		return route.Spec.ParentRefs, route.Spec.Hostnames
+	default:
+		return nil, nil
+	}
+}
+
+// isHTTPOrHTTPSListener returns true if the listener serves HTTP or HTTPS requests.
+func isHTTPOrHTTPSListener(listener gatewayapi.Listener) bool {
+	return listener.Protocol == gatewayapi.HTTPProtocolType || listener.Protocol == gatewayapi.HTTPSProtocolType
+}
+
+// listenerHostnameIntersectsWithHostnames returns true if the listener accepts requests for any of the hostnames.
+// Empty hostnames (of a route) match any hostname, as does a listener without a hostname.
+func listenerHostnameIntersectsWithHostnames(listener gatewayapi.Listener, hostnames []gatewayapi.Hostname) bool {
+	if listener.Hostname == nil || len(hostnames) == 0 {
+		return true
+	}
+	return lo.ContainsBy(hostnames, func(h gatewayapi.Hostname) bool {
+		return util.HostnamesIntersect(*listener.Hostname, h)
+	})
+}
+
+// isListenerIsolatedFrom returns true if requests for the hostname of the listener would match any of the
+// attached listeners on the same port, but must be served by the listener as its hostname is more specific.
+// A wildcard listener hostname matching the hostname of any attached listener on the same port is not isolated,
+// as its exclusion would also exclude the more specific hostname the route is attached to.
+func isListenerIsolatedFrom(listener gatewayapi.Listener, attached []gatewayapi.Listener) bool {
+	hostname := string(*listener.Hostname)
+	isolated := false
+	for _, a := range attached {
+		if a.Port != listener.Port {
+			continue
+		}
+		if a.Hostname == nil {
+			isolated = true
+			continue
+		}
+		attachedHostname := string(*a.Hostname)
+		if attachedHostname == hostname || util.HostnamesMatch(hostname, attachedHostname) {
+			return false
+		}
+		if util.HostnamesMatch(attachedHostname, hostname) {
+			isolated = true
+		}
+	}
+	return isolated
+}
+
+// isolateExpressionRoute extends the expression of the route with a negative match of the excluded hostnames.
+func isolateExpressionRoute(route kongstate.Route, excluded []string) kongstate.Route {
+	if route.Expression == nil {
+		return route
+	}
+	route.Expression = kong.String(atc.And(
+		expressionMatcher(*route.Expression),
+		atc.Not(hostMatcherFromListenerHostnames(excluded)),
+	).Expression())
+	return route
+}
+
+// isolateTraditionalRoute removes the excluded hostnames from the hosts of the route. When the remaining hosts
+// (or the route not specifying any hosts) still match some of the excluded hostnames, routes with the same matches
+// rejecting requests for these hostnames with 404 are returned along with the route. They only have the paths
+// uncoveredPaths returns for their hostnames (nil for a route without paths) and none is returned for hostnames
+// whose all paths are covered (by routes of other objects). When the route matched only the excluded hostnames,
+// no route is returned.
+func isolateTraditionalRoute(
+	route kongstate.Route, excluded []string, uncoveredPaths func(hostname string) ([]*string, bool),
+) []kongstate.Route {
+	hosts := make([]string, 0, len(route.Hosts))
+	for _, host := range route.Hosts {
+		if host == nil {
+			continue
+		}
+		if lo.ContainsBy(excluded, func(e string) bool { return e == *host || util.HostnamesMatch(e, *host) }) {
+			continue
+		}
+		hosts = append(hosts, *host)
+	}
+	if len(route.Hosts) > 0 && len(hosts) == 0 {
+		return nil
+	}
+	route.Hosts = kong.StringSlice(hosts...)
+
+	// Rejected hostnames are grouped by their uncovered paths, each group gets its own isolation route.
+	var (
+		groups       [][]string
+		groupPaths   [][]*string
+		groupsByPath = make(map[string]int)
+	)
+	for _, e := range excluded {
+		if len(hosts) > 0 && !lo.ContainsBy(hosts, func(h string) bool { return h != e && util.HostnamesMatch(h, e) }) {
+			continue
+		}
+		paths, ok := uncoveredPaths(e)
+		if !ok {
+			continue
+		}
+		key := strings.Join(derefStrings(paths), "\n")
+		i, ok := groupsByPath[key]
+		if !ok {
+			i = len(groups)
+			groupsByPath[key] = i
+			groups = append(groups, nil)
+			groupPaths = append(groupPaths, paths)
+		}
+		groups[i] = append(groups[i], e)
+	}
+	if len(groups) == 0 {
+		return []kongstate.Route{route}
+	}
+
+	// Plugins referenced by the original route must not run for requests it doesn't serve.
+	anns := make(map[string]string, len(route.Ingress.Annotations))
+	for k, v := range route.Ingress.Annotations {
+		if k != annotations.AnnotationPrefix+annotations.PluginsKey {
+			anns[k] = v
+		}
+	}
+	routes := []kongstate.Route{route}
+	for i, rejected := range groups {
+		isolation := route.DeepCopy()
+		isolation.Name = kong.String(*route.Name + listenerIsolationRouteNameSuffix)
+		if len(groups) > 1 {
+			isolation.Name = kong.String(fmt.Sprintf("%s.%d%s", *route.Name, i, listenerIsolationRouteNameSuffix))
+		}
+		isolation.Hosts = kong.StringSlice(rejected...)
+		if len(route.Paths) > 0 {
+			isolation.Paths = kong.StringSlice(derefStrings(groupPaths[i])...)
+		}
+		isolation.Ingress.Annotations = anns
+		isolation.Plugins = []kong.Plugin{{
+			Name: kong.String("request-termination"),
+			Config: kong.Configuration{
+				"status_code": 404,
+				"message":     "no Route matched with those values",
+			},
+		}}
+		routes = append(routes, isolation)
+	}
+	return routes
+}
+
+// hostMatcherFromListenerHostnames returns a matcher matching any of the listener hostnames.
+func hostMatcherFromListenerHostnames(hostnames []string) atc.Matcher {
+	matchers := make([]atc.Matcher, 0, len(hostnames))
+	for _, hostname := range hostnames {
+		if strings.HasPrefix(hostname, "*") {
+			matchers = append(matchers, atc.NewPrediacteHTTPHost(atc.OpSuffixMatch, strings.TrimPrefix(hostname, "*")))
+		} else {
+			matchers = append(matchers, atc.NewPrediacteHTTPHost(atc.OpEqual, hostname))
+		}
+	}
+	return atc.Or(matchers...)
+}
+
+// expressionMatcher is a matcher of an already generated route expression.
+type expressionMatcher string
+
+func (m expressionMatcher) Expression() string {
+	return string(m)
+}
+
+func (m expressionMatcher) IsEmpty() bool {
+	return m == ""
+}
@@ -0,0 +1,157 @@
+package translator
+
+import (
+	"testing"
+
+	"github.com/kong/go-kong/kong"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/builder"
+)
+
+func TestIsListenerIsolatedFrom(t *testing.T) {
+	wildcard := builder.NewListener("wildcard").HTTPS().WithPort(443).WithHostname("*.example.com").Build()
+	anyHost := builder.NewListener("any").HTTPS().WithPort(443).Build()
+	foo := builder.NewListener("foo").HTTPS().WithPort(443).WithHostname("foo.example.com").Build()
+	fooOtherPort := builder.NewListener("foo-8443").HTTPS().WithPort(8443).WithHostname("foo.example.com").Build()
+	subWildcard := builder.NewListener("sub-wildcard").HTTPS().WithPort(443).WithHostname("*.foo.example.com").Build()
+	bar := builder.NewListener("bar").HTTPS().WithPort(443).WithHostname("bar.foo.example.com").Build()
+
+	testCases := []struct {
+		name     string
+		listener gatewayapi.Listener
+		attached []gatewayapi.Listener
+		expected bool
+	}{
+		{
+			name:     "more specific hostname than attached wildcard listener",
+			listener: foo,
+			attached: []gatewayapi.Listener{wildcard},
+			expected: true,
+		},
+		{
+			name:     "any hostname listener attached",
+			listener: foo,
+			attached: []gatewayapi.Listener{anyHost},
+			expected: true,
+		},
+		{
+			name:     "different port",
+			listener: fooOtherPort,
+			attached: []gatewayapi.Listener{wildcard},
+			expected: false,
+		},
+		{
+			name:     "wildcard covering a more specific attached listener",
+			listener: subWildcard,
+			attached: []gatewayapi.Listener{wildcard, bar},
+			expected: false,
+		},
+		{
+			name:     "less specific hostname than attached listener",
+			listener: wildcard,
+			attached: []gatewayapi.Listener{foo},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, isListenerIsolatedFrom(tc.listener, tc.attached))
+		})
+	}
+}
+
+func TestIsolateTraditionalRoute(t *testing.T) {
+	route := func(hosts ...string) kongstate.Route {
+		return kongstate.Route{
+			Route: kong.Route{
+				Name:  kong.String("httproute.default.route.0.0"),
+				Hosts: kong.StringSlice(hosts...),
+				Paths: kong.StringSlice("/"),
+			},
+		}
+	}
+
+	notCovered := func(string) ([]*string, bool) { return kong.StringSlice("/"), true }
+
+	t.Run("exact hosts matching only excluded hostnames drop the route", func(t *testing.T) {
+		require.Empty(t, isolateTraditionalRoute(route("foo.example.com"), []string{"foo.example.com"}, notCovered))
+	})
+
+	t.Run("exact excluded hosts are removed", func(t *testing.T) {
+		routes := isolateTraditionalRoute(route("foo.example.com", "bar.example.com"), []string{"foo.example.com"}, notCovered)
+		require.Len(t, routes, 1)
+		require.Equal(t, kong.StringSlice("bar.example.com"), routes[0].Hosts)
+	})
+
+	t.Run("wildcard hosts get a route rejecting excluded hostnames", func(t *testing.T) {
+		routes := isolateTraditionalRoute(route("*.example.com"), []string{"foo.example.com"}, notCovered)
+		require.Len(t, routes, 2)
+		require.Equal(t, kong.StringSlice("*.example.com"), routes[0].Hosts)
+		require.Empty(t, routes[0].Plugins)
+		require.Equal(t, "httproute.default.route.0.0.listener-isolation", *routes[1].Name)
+		require.Equal(t, kong.StringSlice("foo.example.com"), routes[1].Hosts)
+		require.Equal(t, kong.StringSlice("/"), routes[1].Paths)
+		require.Len(t, routes[1].Plugins, 1)
+		require.Equal(t, "request-termination", *routes[1].Plugins[0].Name)
+		require.Equal(t, 404, routes[1].Plugins[0].Config["status_code"])
+	})
+
+	t.Run("no route rejecting excluded hostnames whose paths are covered by other routes", func(t *testing.T) {
+		uncovered := func(hostname string) ([]*string, bool) {
+			if hostname == "foo.example.com" {
+				return nil, false
+			}
+			return kong.StringSlice("/"), true
+		}
+		routes := isolateTraditionalRoute(route("*.example.com"), []string{"foo.example.com", "bar.example.com"}, uncovered)
+		require.Len(t, routes, 2)
+		require.Equal(t, kong.StringSlice("*.example.com"), routes[0].Hosts)
+		require.Equal(t, kong.StringSlice("bar.example.com"), routes[1].Hosts)
+	})
+
+	t.Run("routes rejecting excluded hostnames only have their uncovered paths", func(t *testing.T) {
+		r := route("*.example.com")
+		r.Paths = kong.StringSlice("/a", "/b")
+		uncovered := func(hostname string) ([]*string, bool) {
+			if hostname == "foo.example.com" {
+				return kong.StringSlice("/b"), true
+			}
+			return kong.StringSlice("/a", "/b"), true
+		}
+		routes := isolateTraditionalRoute(r, []string{"foo.example.com", "bar.example.com"}, uncovered)
+		require.Len(t, routes, 3)
+		require.Equal(t, kong.StringSlice("/a", "/b"), routes[0].Paths)
+		require.Equal(t, "httproute.default.route.0.0.0.listener-isolation", *routes[1].Name)
+		require.Equal(t, kong.StringSlice("foo.example.com"), routes[1].Hosts)
+		require.Equal(t, kong.StringSlice("/b"), routes[1].Paths)
+		require.Equal(t, "httproute.default.route.0.0.1.listener-isolation", *routes[2].Name)
+		require.Equal(t, kong.StringSlice("bar.example.com"), routes[2].Hosts)
+		require.Equal(t, kong.StringSlice("/a", "/b"), routes[2].Paths)
+	})
+}
+
+func TestPathCoversPath(t *testing.T) {
+	testCases := []struct {
+		covering string
+		path     string
+		expected bool
+	}{
+		{covering: "/", path: "/shared/", expected: true},
+		{covering: "/", path: "~/shared$", expected: true},
+		{covering: "/shared", path: "/shared/", expected: true},
+		{covering: "/shared/", path: "~/shared$", expected: false},
+		{covering: "/shared/", path: "/shared/", expected: true},
+		{covering: "/foo", path: "/shared/", expected: false},
+		{covering: "~/shared$", path: "~/shared$", expected: true},
+		{covering: "~/.*", path: "/shared/", expected: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.covering+" "+tc.path, func(t *testing.T) {
+			require.Equal(t, tc.expected, pathCoversPath(tc.covering, tc.path))
+		})
+	}
+}
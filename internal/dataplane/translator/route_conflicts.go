@@ -0,0 +1,505 @@
+package translator
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator/atc"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
+)
+
+// RouteConflictType is the type of conflict between routes of two Kubernetes objects.
+type RouteConflictType string
+
+const (
+	// RouteConflictTypeExact indicates that the routes match exactly the same requests.
+	RouteConflictTypeExact RouteConflictType = "Exact"
+
+	// RouteConflictTypePartial indicates that the routes have some identical matches, so the requests matching them
+	// are routed to only one of the objects.
+	RouteConflictTypePartial RouteConflictType = "Partial"
+
+	// RouteConflictTypeShadowed indicates that some matches of one route are covered by less specific matches of
+	// the other route, so the requests matching them are routed to only one of the objects.
+	RouteConflictTypeShadowed RouteConflictType = "Shadowed"
+)
+
+// maxRouteConflictMatchesInMessage is the maximum number of conflicting matches listed in a conflict message.
+const maxRouteConflictMatchesInMessage = 3
+
+// RouteConflict describes Kong routes translated from two different Kubernetes objects that match the same requests.
+// Matches (host, path, method, headers, etc.) are compared in a normalized form, so routes of different kinds of
+// objects are compared with both the traditional and the expressions router flavors.
+//
+// Routes having the same matches are conflicting, as Kong silently picks one of them based on its priority and
+// creation order, so the requests may not be routed to the object users expect. Routes are also conflicting when
+// matches of one of them shadow less specific matches of the other one having the same criteria except for
+// the path, e.g. an exact path or a longer prefix path covered by a prefix path: Kong routes the requests
+// matching them to the shadowing route, so they never reach the other object. Matches differing in other criteria
+// (e.g. a wildcard host covering another route's host) are not conflicting.
+type RouteConflict struct {
+	// Type is the type of the conflict.
+	Type RouteConflictType
+
+	// Objects are the Kubernetes objects the conflicting routes were translated from, the older object first.
+	Objects [2]client.Object
+
+	// Routes are the names of the conflicting Kong routes, in the same order as Objects.
+	Routes [2]string
+
+	// Matches are descriptions of the matches both routes have, sorted. For Shadowed conflicts, these are
+	// the shadowing matches.
+	Matches []string
+
+	// Shadowing is the index in Objects of the object whose route has the shadowing matches, for Shadowed conflicts.
+	Shadowing int
+
+	// Rejected indicates that the matches were removed from the route of the newer object (see withoutRouteMatches).
+	// Shadowing matches are only rejected when the newer object has them, as otherwise the older object already
+	// gets the requests they match.
+	Rejected bool
+}
+
+// Message returns a human-readable description of the conflict.
+func (c RouteConflict) Message() string {
+	var msg string
+	if c.Type == RouteConflictTypeShadowed {
+		shadowing, shadowed := c.Shadowing, 1-c.Shadowing
+		msg = fmt.Sprintf("route %s of %s has matches shadowing less specific matches of route %s of %s: %s",
+			c.Routes[shadowing], describeObject(c.Objects[shadowing]), c.Routes[shadowed], describeObject(c.Objects[shadowed]),
+			describeRouteConflictMatches(c.Matches))
+	} else {
+		kind := "partially identical"
+		if c.Type == RouteConflictTypeExact {
+			kind = "identical"
+		}
+		msg = fmt.Sprintf("route %s of %s and route %s of %s have %s matches: %s",
+			c.Routes[0], describeObject(c.Objects[0]), c.Routes[1], describeObject(c.Objects[1]), kind,
+			describeRouteConflictMatches(c.Matches))
+	}
+	if c.Rejected {
+		msg += fmt.Sprintf(", the matches of route %s of the newer object were rejected", c.Routes[1])
+	}
+	return msg
+}
+
+// rejectionMessage describes the matches rejected from the route of the newer object.
+func (c RouteConflict) rejectionMessage() string {
+	kind := "conflicting with"
+	if c.Type == RouteConflictTypeShadowed {
+		kind = "shadowing"
+	}
+	return fmt.Sprintf("matches of route %s %s route %s of %s created earlier rejected: %s",
+		c.Routes[1], kind, c.Routes[0], describeObject(c.Objects[0]), describeRouteConflictMatches(c.Matches))
+}
+
+func describeObject(obj client.Object) string {
+	return fmt.Sprintf("%s %s/%s", obj.GetObjectKind().GroupVersionKind().Kind, obj.GetNamespace(), obj.GetName())
+}
+
+func describeRouteConflictMatches(matches []string) string {
+	if len(matches) <= maxRouteConflictMatchesInMessage {
+		return strings.Join(matches, ", ")
+	}
+	return fmt.Sprintf("%s and %d more", strings.Join(matches[:maxRouteConflictMatchesInMessage], ", "),
+		len(matches)-maxRouteConflictMatchesInMessage)
+}
+
+// routeConflictSource is a Kong route taken into account when detecting conflicts.
+type routeConflictSource struct {
+	serviceName string
+	name        string
+	object      client.Object
+	matches     []routeMatch
+	// descriptions are the descriptions of the matches.
+	descriptions map[string]struct{}
+}
+
+// detectRouteConflicts finds routes translated from different Ingresses, HTTPRoutes, GRPCRoutes and TCPIngresses
+// that have the same or shadowing matches. When RejectConflictingRoutes is enabled, the conflicting matches are
+// removed from the route of the newer object (see withoutRouteMatches) and a single translation failure listing all
+// of its rejected matches is registered for that object.
+func (t *Translator) detectRouteConflicts(rules *ingressRules) []RouteConflict {
+	var sources []routeConflictSource
+	serviceNames := lo.Keys(rules.ServiceNameToServices)
+	sort.Strings(serviceNames)
+	for _, serviceName := range serviceNames {
+		for _, route := range rules.ServiceNameToServices[serviceName].Routes {
+			// Routes rejecting requests for hostnames of other listeners are not served by their source objects.
+			if route.Name == nil || isListenerIsolationRoute(route) {
+				continue
+			}
+			obj, ok := t.routeSourceObject(route.Ingress)
+			if !ok {
+				continue
+			}
+			matches := normalizedRouteMatches(route)
+			sources = append(sources, routeConflictSource{
+				serviceName: serviceName,
+				name:        *route.Name,
+				object:      obj,
+				matches:     matches,
+				descriptions: lo.SliceToMap(matches, func(m routeMatch) (string, struct{}) {
+					return m.String(), struct{}{}
+				}),
+			})
+		}
+	}
+
+	// orderedPair orders the sources of a pair by the creation of their objects, the older first.
+	orderedPair := func(a, b int) [2]int {
+		if objectCreatedBefore(sources[b].object, sources[a].object) {
+			return [2]int{b, a}
+		}
+		return [2]int{a, b}
+	}
+
+	// Index the routes by their matches, so only the routes sharing a match are compared.
+	routesByMatch := make(map[string][]int)
+	for i, source := range sources {
+		for match := range source.descriptions {
+			routesByMatch[match] = append(routesByMatch[match], i)
+		}
+	}
+	sharedMatches := make(map[[2]int][]string)
+	for match, routes := range routesByMatch {
+		for i, a := range routes {
+			for _, b := range routes[i+1:] {
+				if sameObject(sources[a].object, sources[b].object) {
+					continue
+				}
+				pair := orderedPair(a, b)
+				sharedMatches[pair] = append(sharedMatches[pair], match)
+			}
+		}
+	}
+
+	// Index the routes by the matches that can shadow other matches: matches without a path by their criteria
+	// and matches with a prefix path also by the path, so the routes whose matches could be shadowed look up only
+	// the prefixes of their paths.
+	routesByCoveringMatch := make(map[string][]int)
+	for i, source := range sources {
+		for _, match := range source.matches {
+			if key, ok := coveringMatchKey(match); ok {
+				routesByCoveringMatch[key] = lo.Uniq(append(routesByCoveringMatch[key], i))
+			}
+		}
+	}
+	// Shadowing matches are keyed by the pair of the shadowing and the shadowed route.
+	shadowingMatches := make(map[[2]int][]string)
+	for b, source := range sources {
+		for _, match := range source.matches {
+			description := match.String()
+			for _, key := range coveredMatchKeys(match) {
+				for _, a := range routesByCoveringMatch[key] {
+					if sameObject(sources[a].object, source.object) {
+						continue
+					}
+					// Identical matches are already conflicting.
+					if _, ok := sources[a].descriptions[description]; ok {
+						continue
+					}
+					if !lo.SomeBy(sources[a].matches, func(m routeMatch) bool { return m.covers(match) }) {
+						continue
+					}
+					pair := [2]int{b, a}
+					if !lo.Contains(shadowingMatches[pair], description) {
+						shadowingMatches[pair] = append(shadowingMatches[pair], description)
+					}
+				}
+			}
+		}
+	}
+
+	// Conflicts are paired with the source of the newer route, so its matches can be rejected.
+	type detectedConflict struct {
+		RouteConflict
+		newer int
+	}
+	detected := make([]detectedConflict, 0, len(sharedMatches)+len(shadowingMatches))
+	for pair, matches := range sharedMatches {
+		older, newer := sources[pair[0]], sources[pair[1]]
+		sort.Strings(matches)
+		conflict := RouteConflict{
+			Type:     RouteConflictTypePartial,
+			Objects:  [2]client.Object{older.object, newer.object},
+			Routes:   [2]string{older.name, newer.name},
+			Matches:  matches,
+			Rejected: t.featureFlags.RejectConflictingRoutes,
+		}
+		if len(matches) == len(older.descriptions) && len(matches) == len(newer.descriptions) {
+			conflict.Type = RouteConflictTypeExact
+		}
+		detected = append(detected, detectedConflict{RouteConflict: conflict, newer: pair[1]})
+	}
+	for shadowingPair, matches := range shadowingMatches {
+		pair := orderedPair(shadowingPair[0], shadowingPair[1])
+		older, newer := sources[pair[0]], sources[pair[1]]
+		sort.Strings(matches)
+		shadowing := 0
+		if pair[1] == shadowingPair[0] {
+			shadowing = 1
+		}
+		detected = append(detected, detectedConflict{
+			RouteConflict: RouteConflict{
+				Type:      RouteConflictTypeShadowed,
+				Objects:   [2]client.Object{older.object, newer.object},
+				Routes:    [2]string{older.name, newer.name},
+				Matches:   matches,
+				Shadowing: shadowing,
+				Rejected:  t.featureFlags.RejectConflictingRoutes && shadowing == 1,
+			},
+			newer: pair[1],
+		})
+	}
+	slices.SortFunc(detected, func(a, b detectedConflict) int {
+		return cmp.Or(
+			cmp.Compare(a.Routes[0], b.Routes[0]),
+			cmp.Compare(a.Routes[1], b.Routes[1]),
+			cmp.Compare(a.Type, b.Type),
+		)
+	})
+	conflicts := lo.Map(detected, func(c detectedConflict, _ int) RouteConflict { return c.RouteConflict })
+
+	if !t.featureFlags.RejectConflictingRoutes {
+		return conflicts
+	}
+
+	var (
+		rejectedMatches   = make(map[int]map[string]struct{})
+		rejectedObjects   []client.Object
+		rejectionMessages = make(map[string][]string)
+	)
+	for _, conflict := range detected {
+		if !conflict.Rejected {
+			continue
+		}
+		newer := conflict.newer
+		if rejectedMatches[newer] == nil {
+			rejectedMatches[newer] = make(map[string]struct{})
+		}
+		for _, match := range conflict.Matches {
+			rejectedMatches[newer][match] = struct{}{}
+		}
+		key := describeObject(conflict.Objects[1])
+		if _, ok := rejectionMessages[key]; !ok {
+			rejectedObjects = append(rejectedObjects, conflict.Objects[1])
+		}
+		rejectionMessages[key] = append(rejectionMessages[key], conflict.rejectionMessage())
+	}
+	for _, obj := range rejectedObjects {
+		t.registerTranslationFailure(strings.Join(rejectionMessages[describeObject(obj)], "; "), obj)
+	}
+
+	for i, matches := range rejectedMatches {
+		source := sources[i]
+		service := rules.ServiceNameToServices[source.serviceName]
+		service.Routes = lo.FlatMap(service.Routes, func(route kongstate.Route, _ int) []kongstate.Route {
+			if route.Name == nil || *route.Name != source.name {
+				return []kongstate.Route{route}
+			}
+			return withoutRouteMatches(route, matches)
+		})
+		rules.ServiceNameToServices[source.serviceName] = service
+	}
+	return conflicts
+}
+
+// withoutRouteMatches returns the routes matching what the route matches except the rejected matches. First, the
+// values of the route's criteria (hosts, SNIs, paths, methods, etc.) whose all matches are rejected are removed. When
+// some of the rejected matches remain (e.g. they combine a host and a path which are also used by other matches), the
+// route is split into routes having a single value of one of its criteria, which are handled the same way. Routes
+// having only rejected matches are dropped. Expressions of expression routes get a negative match of the rejected
+// matches instead.
+func withoutRouteMatches(route kongstate.Route, rejected map[string]struct{}) []kongstate.Route {
+	isRejected := func(match string) bool {
+		_, ok := rejected[match]
+		return ok
+	}
+	if route.Expression != nil {
+		matches := normalizedRouteMatches(route)
+		rejectedMatches := lo.Filter(matches, func(m routeMatch, _ int) bool { return isRejected(m.String()) })
+		switch {
+		case len(rejectedMatches) == 0:
+			return []kongstate.Route{route}
+		case len(rejectedMatches) == len(matches) || lo.SomeBy(rejectedMatches, func(m routeMatch) bool { return m.unparsed }):
+			return nil
+		}
+		route.Expression = kong.String(atc.And(
+			expressionMatcher(*route.Expression),
+			atc.Not(atc.Or(lo.Map(rejectedMatches, func(m routeMatch, _ int) atc.Matcher { return m.matcher })...)),
+		).Expression())
+		return []kongstate.Route{route}
+	}
+	for _, criterion := range routeCriteria {
+		criterion.prune(&route, isRejected)
+	}
+	if !lo.SomeBy(routeMatches(route), isRejected) {
+		return []kongstate.Route{route}
+	}
+	for _, criterion := range routeCriteria {
+		if parts := criterion.split(route); len(parts) > 1 {
+			return lo.FlatMap(parts, func(part kongstate.Route, _ int) []kongstate.Route {
+				return withoutRouteMatches(part, rejected)
+			})
+		}
+	}
+	return nil
+}
+
+// routeCriterion allows removing values of one of the route's criteria. The values are always cloned, so the original
+// route, shared with the translation cache, is never modified.
+type routeCriterion struct {
+	// prune removes the values whose all matches are rejected. The last value is always kept, as a criterion without
+	// values matches anything.
+	prune func(route *kongstate.Route, isRejected func(string) bool)
+	// split returns a copy of the route for each of the criterion's values, having only that value.
+	split func(route kongstate.Route) []kongstate.Route
+}
+
+// routeCriteria are the route's criteria in the order the routes are split in when their rejected matches cannot be
+// removed otherwise.
+var routeCriteria = []routeCriterion{
+	newRouteCriterion(func(r *kongstate.Route) *[]*string { return &r.Hosts }),
+	newRouteCriterion(func(r *kongstate.Route) *[]*string { return &r.SNIs }),
+	newRouteCriterion(func(r *kongstate.Route) *[]*string { return &r.Paths }),
+	newRouteCriterion(func(r *kongstate.Route) *[]*string { return &r.Methods }),
+	newRouteCriterion(func(r *kongstate.Route) *[]*kong.CIDRPort { return &r.Destinations }),
+	newRouteCriterion(func(r *kongstate.Route) *[]*kong.CIDRPort { return &r.Sources }),
+	newRouteCriterion(func(r *kongstate.Route) *[]*string { return &r.Protocols }),
+}
+
+func newRouteCriterion[T any](values func(*kongstate.Route) *[]T) routeCriterion {
+	return routeCriterion{
+		prune: func(route *kongstate.Route, isRejected func(string) bool) {
+			vs := values(route)
+			for i := 0; i < len(*vs) && len(*vs) > 1; {
+				original := *vs
+				before := routeMatches(*route)
+				*vs = slices.Delete(slices.Clone(original), i, i+1)
+				after := lo.SliceToMap(routeMatches(*route), func(match string) (string, struct{}) {
+					return match, struct{}{}
+				})
+				removed := lo.Reject(before, func(match string, _ int) bool {
+					_, ok := after[match]
+					return ok
+				})
+				if lo.EveryBy(removed, isRejected) {
+					continue
+				}
+				*vs = original
+				i++
+			}
+		},
+		split: func(route kongstate.Route) []kongstate.Route {
+			vs := *values(&route)
+			if len(vs) < 2 {
+				return nil
+			}
+			return lo.Map(vs, func(v T, i int) kongstate.Route {
+				part := route
+				part.Name = kong.String(fmt.Sprintf("%s.split-%d", *route.Name, i))
+				*values(&part) = []T{v}
+				return part
+			})
+		},
+	}
+}
+
+// routeSourceObject returns the Kubernetes object a route was translated from if it's a kind taken into account when
+// detecting conflicts.
+func (t *Translator) routeSourceObject(info util.K8sObjectInfo) (client.Object, bool) {
+	cacheStores := t.storer.CacheStores()
+	var s cache.Store
+	switch info.GroupVersionKind.Kind {
+	case "Ingress":
+		s = cacheStores.IngressV1
+	case "HTTPRoute":
+		s = cacheStores.HTTPRoute
+	case "GRPCRoute":
+		s = cacheStores.GRPCRoute
+	case "TCPIngress":
+		s = cacheStores.TCPIngress
+	default:
+		return nil, false
+	}
+	if s == nil {
+		return nil, false
+	}
+	item, exists, err := s.GetByKey(info.Namespace + "/" + info.Name)
+	if err != nil || !exists {
+		return nil, false
+	}
+	obj, ok := item.(client.Object)
+	if !ok {
+		return nil, false
+	}
+	// Objects in the cache may miss their type meta, which is required to report failures and events.
+	obj = obj.DeepCopyObject().(client.Object)
+	obj.GetObjectKind().SetGroupVersionKind(info.GroupVersionKind)
+	return obj, true
+}
+
+func sameObject(a, b client.Object) bool {
+	return a.GetObjectKind().GroupVersionKind() == b.GetObjectKind().GroupVersionKind() &&
+		a.GetNamespace() == b.GetNamespace() && a.GetName() == b.GetName()
+}
+
+// objectCreatedBefore tells whether a was created before b. Objects created at the same time are ordered by their
+// kind, namespace and name, so the order is deterministic.
+func objectCreatedBefore(a, b client.Object) bool {
+	aCreated, bCreated := a.GetCreationTimestamp(), b.GetCreationTimestamp()
+	if !aCreated.Equal(&bCreated) {
+		return aCreated.Before(&bCreated)
+	}
+	return cmp.Or(
+		cmp.Compare(a.GetObjectKind().GroupVersionKind().Kind, b.GetObjectKind().GroupVersionKind().Kind),
+		cmp.Compare(a.GetNamespace(), b.GetNamespace()),
+		cmp.Compare(a.GetName(), b.GetName()),
+	) < 0
+}
+
+// coveringMatchKey returns the key of a match that can shadow other matches, made of its criteria except for the path
+// and the value of its prefix path.
+func coveringMatchKey(m routeMatch) (string, bool) {
+	if m.unparsed || m.stream {
+		return "", false
+	}
+	switch m.path.typ {
+	case routePathAny:
+		return routeMatchKeyWithoutPath(m), true
+	case routePathPrefix:
+		return routeMatchKeyWithoutPath(m) + " " + m.path.value, true
+	default:
+		return "", false
+	}
+}
+
+// coveredMatchKeys returns the keys (see coveringMatchKey) of the matches that could shadow the match.
+func coveredMatchKeys(m routeMatch) []string {
+	if m.unparsed || m.stream || m.path.typ == routePathAny {
+		return nil
+	}
+	key := routeMatchKeyWithoutPath(m)
+	keys := []string{key}
+	if m.path.typ == routePathRegex {
+		return keys
+	}
+	for i := 1; i <= len(m.path.value); i++ {
+		keys = append(keys, key+" "+m.path.value[:i])
+	}
+	return keys
+}
+
+func routeMatchKeyWithoutPath(m routeMatch) string {
+	return strings.Join([]string{m.protocol, m.host, m.method, m.headers, m.other}, " ")
+}
@@ -0,0 +1,402 @@
+package translator
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/failures"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/builder"
+)
+
+func TestRouteMatches(t *testing.T) {
+	testCases := []struct {
+		name     string
+		route    kongstate.Route
+		expected []string
+	}{
+		{
+			name: "route without criteria matches everything over the default protocols",
+			expected: []string{
+				"http * * * *",
+				"https * * * *",
+			},
+		},
+		{
+			name: "all combinations of hosts, paths and methods",
+			route: kongstate.Route{Route: kong.Route{
+				Protocols: kong.StringSlice("https"),
+				Hosts:     kong.StringSlice("API.example.com", "www.example.com"),
+				Paths:     kong.StringSlice("/v1", "/v2"),
+				Methods:   kong.StringSlice("GET"),
+			}},
+			expected: []string{
+				"https api.example.com /v1 GET *",
+				"https api.example.com /v2 GET *",
+				"https www.example.com /v1 GET *",
+				"https www.example.com /v2 GET *",
+			},
+		},
+		{
+			name: "headers are described regardless of their order",
+			route: kongstate.Route{Route: kong.Route{
+				Protocols: kong.StringSlice("http"),
+				Headers: map[string][]string{
+					"X-Version": {"2", "1"},
+					"Accept":    {"application/json"},
+				},
+			}},
+			expected: []string{
+				"http * * * accept=application/json,x-version=1|2",
+			},
+		},
+		{
+			name: "stream route",
+			route: kongstate.Route{Route: kong.Route{
+				Protocols:    kong.StringSlice("tls"),
+				SNIs:         kong.StringSlice("db.example.com"),
+				Destinations: []*kong.CIDRPort{{Port: kong.Int(5432)}},
+			}},
+			expected: []string{
+				"tls db.example.com *:5432 *",
+			},
+		},
+		{
+			name: "exact and prefix paths",
+			route: kongstate.Route{Route: kong.Route{
+				Protocols: kong.StringSlice("http"),
+				Paths:     kong.StringSlice("~/v1$", "/v1/", "~/v[0-9]+$"),
+			}},
+			expected: []string{
+				"http * =/v1 * *",
+				"http * /v1/ * *",
+				"http * ~/v[0-9]+$ * *",
+			},
+		},
+		{
+			name: "expression route is described like the equivalent traditional route",
+			route: kongstate.Route{Route: kong.Route{
+				Expression: kong.String(`((http.path == "/v1") || (http.path ^= "/v1/")) && ` +
+					`(http.host =^ ".example.com") && (net.protocol == "https") && (http.headers.x_version == "1")`),
+			}},
+			expected: []string{
+				"https *.example.com =/v1 * x_version=1",
+				"https *.example.com /v1/ * x_version=1",
+			},
+		},
+		{
+			name: "expression route without protocols matches the default protocols",
+			route: kongstate.Route{Route: kong.Route{
+				Expression: kong.String(`(http.host == "api.example.com") && !(http.method == "POST")`),
+			}},
+			expected: []string{
+				`http api.example.com * * * !(http.method == "POST")`,
+				`https api.example.com * * * !(http.method == "POST")`,
+			},
+		},
+		{
+			name: "expression route that cannot be parsed",
+			route: kongstate.Route{Route: kong.Route{
+				Expression: kong.String(`http.host == `),
+			}},
+			expected: []string{
+				`expression "http.host == "`,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.ElementsMatch(t, tc.expected, routeMatches(tc.route))
+		})
+	}
+}
+
+func TestTranslator_RouteConflicts(t *testing.T) {
+	var (
+		olderTimestamp = metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+		newerTimestamp = metav1.NewTime(olderTimestamp.Add(time.Hour))
+	)
+	ingress := &netv1.Ingress{
+		TypeMeta: metav1.TypeMeta{Kind: "Ingress", APIVersion: netv1.SchemeGroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "ingress",
+			Namespace:         "default",
+			CreationTimestamp: olderTimestamp,
+		},
+		Spec: netv1.IngressSpec{
+			IngressClassName: lo.ToPtr("kong"),
+			Rules: []netv1.IngressRule{{
+				Host: "www.example.com",
+				IngressRuleValue: netv1.IngressRuleValue{
+					HTTP: &netv1.HTTPIngressRuleValue{
+						Paths: []netv1.HTTPIngressPath{{
+							Path:     "/",
+							PathType: lo.ToPtr(netv1.PathTypePrefix),
+							Backend: netv1.IngressBackend{
+								Service: &netv1.IngressServiceBackend{
+									Name: "svc",
+									Port: netv1.ServiceBackendPort{Number: 80},
+								},
+							},
+						}},
+					},
+				},
+			}},
+		},
+	}
+	httpRoute := func(name string, creationTimestamp metav1.Time, hostnames ...gatewayapi.Hostname) *gatewayapi.HTTPRoute {
+		route := &gatewayapi.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "default",
+				CreationTimestamp: creationTimestamp,
+			},
+			Spec: gatewayapi.HTTPRouteSpec{
+				Hostnames: hostnames,
+				Rules: []gatewayapi.HTTPRouteRule{{
+					Matches: []gatewayapi.HTTPRouteMatch{
+						builder.NewHTTPRouteMatch().WithPathPrefix("/").Build(),
+					},
+					BackendRefs: []gatewayapi.HTTPBackendRef{
+						builder.NewHTTPBackendRef("svc").WithPort(80).Build(),
+					},
+				}},
+			},
+		}
+		route.SetGroupVersionKind(httprouteGVK)
+		return route
+	}
+	objects := store.FakeObjects{
+		Services: []*corev1.Service{{
+			ObjectMeta: metav1.ObjectMeta{Name: "svc", Namespace: "default"},
+			Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Port: 80}}},
+		}},
+		IngressesV1: []*netv1.Ingress{ingress},
+		HTTPRoutes: []*gatewayapi.HTTPRoute{
+			httpRoute("original", olderTimestamp, "api.example.com"),
+			httpRoute("identical", newerTimestamp, "api.example.com"),
+			httpRoute("partial", newerTimestamp, "api.example.com", "www.example.com", "new.example.com"),
+			httpRoute("distinct", olderTimestamp, "other.example.com"),
+		},
+	}
+	conflictingRoutes := func(conflicts []RouteConflict) []string {
+		return lo.Map(conflicts, func(c RouteConflict, _ int) string {
+			return string(c.Type) + " " + describeObject(c.Objects[0]) + " " + describeObject(c.Objects[1])
+		})
+	}
+
+	t.Run("conflicts are reported", func(t *testing.T) {
+		s, err := store.NewFakeStore(objects)
+		require.NoError(t, err)
+		p := mustNewTranslator(t, s)
+
+		result := p.BuildKongConfig(context.Background())
+		require.Empty(t, result.TranslationFailures)
+		require.ElementsMatch(t, []string{
+			"Exact HTTPRoute default/original HTTPRoute default/identical",
+			"Partial HTTPRoute default/original HTTPRoute default/partial",
+			"Partial HTTPRoute default/identical HTTPRoute default/partial",
+			"Partial Ingress default/ingress HTTPRoute default/partial",
+			// The exact root path of the HTTPRoute shadows the Ingress' root prefix path.
+			"Shadowed Ingress default/ingress HTTPRoute default/partial",
+		}, conflictingRoutes(result.RouteConflicts))
+		for _, conflict := range result.RouteConflicts {
+			require.False(t, conflict.Rejected)
+			require.Contains(t, conflict.Message(), "https ")
+		}
+		require.Len(t, routeHosts(ingressRulesFromServices(result.KongState.Services)), 7,
+			"all the routes should be configured")
+	})
+
+	t.Run("conflicting matches of newer objects are rejected", func(t *testing.T) {
+		s, err := store.NewFakeStore(objects)
+		require.NoError(t, err)
+		p := mustNewTranslator(t, s)
+		p.featureFlags.RejectConflictingRoutes = true
+
+		result := p.BuildKongConfig(context.Background())
+		require.ElementsMatch(t, []string{
+			"Exact HTTPRoute default/original HTTPRoute default/identical",
+			"Partial HTTPRoute default/original HTTPRoute default/partial",
+			"Partial HTTPRoute default/identical HTTPRoute default/partial",
+			"Partial Ingress default/ingress HTTPRoute default/partial",
+			// The exact root path of the HTTPRoute shadows the Ingress' root prefix path.
+			"Shadowed Ingress default/ingress HTTPRoute default/partial",
+		}, conflictingRoutes(result.RouteConflicts))
+		require.ElementsMatch(t, []string{
+			"default/api.example.com",
+			"default/www.example.com",
+			"default/other.example.com",
+			"default/new.example.com",
+		}, routeHosts(ingressRulesFromServices(result.KongState.Services)),
+			"only the matches of the oldest objects and the non-conflicting matches should be configured")
+		rejectedMatches := lo.FlatMap(result.RouteConflicts, func(c RouteConflict, _ int) []string { return c.Matches })
+		for _, service := range result.KongState.Services {
+			for _, route := range service.Routes {
+				if route.Ingress.Name != "partial" {
+					continue
+				}
+				require.Empty(t, lo.Intersect(rejectedMatches, routeMatches(route)),
+					"route %s should not have any conflicting match", *route.Name)
+			}
+		}
+		require.ElementsMatch(t, []string{
+			"HTTPRoute default/identical",
+			"HTTPRoute default/partial",
+		}, lo.Map(result.TranslationFailures, func(f failures.ResourceFailure, _ int) string {
+			return describeObject(f.CausingObjects()[0])
+		}), "a single failure should be reported for each object")
+		for _, f := range result.TranslationFailures {
+			if f.CausingObjects()[0].GetName() == "partial" {
+				require.Equal(t, 3, strings.Count(f.Message(), "; "), "all the rejected matches should be listed")
+			}
+		}
+	})
+
+	t.Run("routes of different kinds are compared with the expressions router", func(t *testing.T) {
+		s, err := store.NewFakeStore(objects)
+		require.NoError(t, err)
+		p := mustNewTranslator(t, s)
+		p.featureFlags.ExpressionRoutes = true
+		p.featureFlags.RejectConflictingRoutes = true
+
+		result := p.BuildKongConfig(context.Background())
+		// Expression routes are split by hostnames, so the routes of the Ingress and the HTTPRoute with the same
+		// host and path are identical even though their expressions are generated differently.
+		require.ElementsMatch(t, []string{
+			"Exact HTTPRoute default/original HTTPRoute default/identical",
+			"Exact HTTPRoute default/original HTTPRoute default/partial",
+			"Exact HTTPRoute default/identical HTTPRoute default/partial",
+			"Exact Ingress default/ingress HTTPRoute default/partial",
+		}, conflictingRoutes(result.RouteConflicts))
+		require.ElementsMatch(t, []string{
+			"default/www.example.com",
+			"default/api.example.com",
+			"default/other.example.com",
+			"default/new.example.com",
+		}, lo.FlatMap(result.KongState.Services, func(service kongstate.Service, _ int) []string {
+			return lo.Map(service.Routes, func(route kongstate.Route, _ int) string {
+				host := strings.Split(routeMatches(route)[0], " ")[1]
+				return route.Ingress.Namespace + "/" + host
+			})
+		}), "only the matches of the oldest objects and the non-conflicting matches should be configured")
+	})
+
+	t.Run("shadowing matches of older objects are not rejected", func(t *testing.T) {
+		newerIngress := ingress.DeepCopy()
+		newerIngress.Name = "newer-ingress"
+		newerIngress.CreationTimestamp = newerTimestamp
+		newerIngress.Spec.Rules[0].Host = "other.example.com"
+		specific := httpRoute("specific", olderTimestamp, "other.example.com")
+		specific.Spec.Rules[0].Matches = []gatewayapi.HTTPRouteMatch{
+			builder.NewHTTPRouteMatch().WithPathExact("/foo").Build(),
+		}
+		s, err := store.NewFakeStore(store.FakeObjects{
+			Services:    objects.Services,
+			IngressesV1: []*netv1.Ingress{newerIngress},
+			HTTPRoutes:  []*gatewayapi.HTTPRoute{specific},
+		})
+		require.NoError(t, err)
+		p := mustNewTranslator(t, s)
+		p.featureFlags.RejectConflictingRoutes = true
+
+		result := p.BuildKongConfig(context.Background())
+		require.Empty(t, result.TranslationFailures)
+		require.Len(t, result.RouteConflicts, 1)
+		conflict := result.RouteConflicts[0]
+		require.Equal(t, RouteConflictTypeShadowed, conflict.Type)
+		require.Equal(t, "HTTPRoute default/specific", describeObject(conflict.Objects[0]))
+		require.Equal(t, 0, conflict.Shadowing)
+		require.False(t, conflict.Rejected, "the older object already gets the requests of its shadowing matches")
+		require.Equal(t, []string{"http other.example.com =/foo * *", "https other.example.com =/foo * *"}, conflict.Matches)
+		require.Contains(t, conflict.Message(),
+			"of HTTPRoute default/specific has matches shadowing less specific matches of route default.newer-ingress")
+	})
+}
+
+func TestWithoutRouteMatches(t *testing.T) {
+	route := kongstate.Route{
+		Route: kong.Route{
+			Name:      kong.String("route"),
+			Protocols: kong.StringSlice("http"),
+			Hosts:     kong.StringSlice("a.example.com", "b.example.com"),
+			Paths:     kong.StringSlice("/x", "/y"),
+		},
+	}
+	matchesOf := func(hosts, paths []string) map[string]struct{} {
+		r := route
+		r.Hosts, r.Paths = kong.StringSlice(hosts...), kong.StringSlice(paths...)
+		return lo.SliceToMap(routeMatches(r), func(match string) (string, struct{}) { return match, struct{}{} })
+	}
+
+	describeRoutes := func(routes []kongstate.Route) []string {
+		return lo.Map(routes, func(r kongstate.Route, _ int) string {
+			return strings.Join(append([]string{*r.Name}, routeMatches(r)...), ", ")
+		})
+	}
+
+	t.Run("values whose all matches are rejected are removed", func(t *testing.T) {
+		routes := withoutRouteMatches(route, matchesOf([]string{"a.example.com"}, []string{"/x", "/y"}))
+		require.Equal(t, []string{
+			"route, http b.example.com /x * *, http b.example.com /y * *",
+		}, describeRoutes(routes))
+		require.Len(t, route.Hosts, 2, "original route must not be modified")
+	})
+
+	t.Run("route is split when rejected matches cannot be removed otherwise", func(t *testing.T) {
+		routes := withoutRouteMatches(route, matchesOf([]string{"a.example.com"}, []string{"/x"}))
+		require.Equal(t, []string{
+			"route.split-0, http a.example.com /y * *",
+			"route.split-1, http b.example.com /x * *, http b.example.com /y * *",
+		}, describeRoutes(routes))
+		require.Len(t, route.Hosts, 2, "original route must not be modified")
+		require.Len(t, route.Paths, 2, "original route must not be modified")
+	})
+
+	t.Run("route is dropped when all its matches are rejected", func(t *testing.T) {
+		routes := withoutRouteMatches(route, matchesOf([]string{"a.example.com", "b.example.com"}, []string{"/x", "/y"}))
+		require.Empty(t, routes)
+	})
+
+	t.Run("expression route gets a negative match of the rejected matches", func(t *testing.T) {
+		expressionRoute := kongstate.Route{
+			Route: kong.Route{
+				Name:       kong.String("route"),
+				Expression: kong.String(`(http.host == "a.example.com") && ((http.path == "/x") || (http.path ^= "/x/"))`),
+			},
+			ExpressionRoutes: true,
+		}
+		routes := withoutRouteMatches(expressionRoute, map[string]struct{}{"http a.example.com =/x * *": {}})
+		require.Len(t, routes, 1)
+		require.Equal(t, `((http.host == "a.example.com") && ((http.path == "/x") || (http.path ^= "/x/"))) && `+
+			`(!((http.host == "a.example.com") && (http.path == "/x") && (net.protocol == "http")))`, *routes[0].Expression)
+		require.Equal(t, `(http.host == "a.example.com") && ((http.path == "/x") || (http.path ^= "/x/"))`,
+			*expressionRoute.Expression, "original route must not be modified")
+
+		routes = withoutRouteMatches(expressionRoute, lo.SliceToMap(routeMatches(expressionRoute), func(m string) (string, struct{}) {
+			return m, struct{}{}
+		}))
+		require.Empty(t, routes)
+	})
+}
+
+// ingressRulesFromServices wraps the services in ingress rules, so their routes can be inspected with routeHosts.
+func ingressRulesFromServices(services []kongstate.Service) ingressRules {
+	rules := newIngressRules()
+	for _, service := range services {
+		rules.ServiceNameToServices[*service.Name] = service
+	}
+	return rules
+}
@@ -0,0 +1,322 @@
+package translator
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator/atc"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator/subtranslator"
+)
+
+// routeMatch is a normalized combination of a route's match criteria. Equivalent matches of routes are described
+// the same way regardless of how they're expressed (e.g. the order of predicates in expressions, or an exact path
+// written as a regex of a traditional route), so routes of different kinds can be compared.
+type routeMatch struct {
+	protocol string
+	// host is a lowercase host, a wildcard host (*.example.com or example.*) or * for any host.
+	host    string
+	path    routePath
+	method  string
+	headers string
+	// other describes the remaining criteria: SNIs, destinations and sources of stream routes, and expression
+	// predicates not described by the other fields.
+	other string
+	// stream tells the match is one of a traditional stream route, described by its protocol and other criteria.
+	stream bool
+	// unparsed tells the match is the whole expression of a route that couldn't be parsed, described by other.
+	unparsed bool
+	// matcher matches the requests of a match of an expression route.
+	matcher atc.Matcher
+}
+
+// String returns the description of the match. Two routes having a match with the same description both match
+// the requests it describes. Criteria a match doesn't have are described as "*".
+func (m routeMatch) String() string {
+	switch {
+	case m.unparsed:
+		return m.other
+	case m.stream:
+		return m.protocol + " " + m.other
+	}
+	description := strings.Join([]string{m.protocol, m.host, m.path.String(), m.method, m.headers}, " ")
+	if m.other != "" {
+		description += " " + m.other
+	}
+	return description
+}
+
+// covers tells whether the match matches all the requests the other match does with a less specific path, while
+// all its other criteria are the same, e.g. a prefix path covering an exact path or a longer prefix path.
+func (m routeMatch) covers(other routeMatch) bool {
+	if m.unparsed || m.stream || other.unparsed || other.stream {
+		return false
+	}
+	return m.protocol == other.protocol && m.host == other.host && m.method == other.method &&
+		m.headers == other.headers && m.other == other.other && m.path.covers(other.path)
+}
+
+// routePathType is the type of a path match.
+type routePathType int
+
+const (
+	routePathAny routePathType = iota
+	routePathPrefix
+	routePathExact
+	routePathRegex
+)
+
+// routePath is a normalized path match. Values of regex paths are anchored at the beginning of the path.
+type routePath struct {
+	typ   routePathType
+	value string
+}
+
+// String describes prefix paths as is, exact paths prefixed with = and regex paths prefixed with ~.
+func (p routePath) String() string {
+	switch p.typ {
+	case routePathPrefix:
+		return p.value
+	case routePathExact:
+		return "=" + p.value
+	case routePathRegex:
+		return subtranslator.KongPathRegexPrefix + p.value
+	default:
+		return "*"
+	}
+}
+
+// covers tells whether the path matches all the paths the other path does, and it's less specific.
+func (p routePath) covers(other routePath) bool {
+	switch p.typ {
+	case routePathAny:
+		return other.typ != routePathAny
+	case routePathPrefix:
+		return (other.typ == routePathExact || other.typ == routePathPrefix && other.value != p.value) &&
+			strings.HasPrefix(other.value, p.value)
+	default:
+		return false
+	}
+}
+
+// regexMetaCharacters are the characters making a regex path match more than a literal path.
+const regexMetaCharacters = `\.+*?()|[]{}^$`
+
+// traditionalRoutePath normalizes a path of a traditional route. A regex matching only a literal path (e.g. ~/foo$
+// generated for exact paths) is an exact path.
+func traditionalRoutePath(path string) routePath {
+	regex, ok := strings.CutPrefix(path, subtranslator.KongPathRegexPrefix)
+	if !ok {
+		return routePath{typ: routePathPrefix, value: path}
+	}
+	if literal, ok := strings.CutSuffix(regex, "$"); ok && !strings.ContainsAny(literal, regexMetaCharacters) {
+		return routePath{typ: routePathExact, value: literal}
+	}
+	return routePath{typ: routePathRegex, value: regex}
+}
+
+// routeMatches returns descriptions of the route's matches (see routeMatch.String).
+func routeMatches(route kongstate.Route) []string {
+	return lo.Uniq(lo.Map(normalizedRouteMatches(route), func(m routeMatch, _ int) string { return m.String() }))
+}
+
+// normalizedRouteMatches returns all the combinations of the route's match criteria.
+func normalizedRouteMatches(route kongstate.Route) []routeMatch {
+	if route.Expression != nil {
+		return expressionRouteMatches(*route.Expression)
+	}
+
+	protocols := derefStrings(route.Protocols)
+	if len(protocols) == 0 {
+		protocols = []string{"http", "https"}
+	}
+	if lo.ContainsBy(protocols, func(p string) bool { return p == "tcp" || p == "tls" || p == "udp" }) {
+		others := combineRouteCriteria(
+			orAny(derefStrings(route.SNIs)),
+			orAny(lo.Map(route.Destinations, func(d *kong.CIDRPort, _ int) string { return describeCIDRPort(d) })),
+			orAny(lo.Map(route.Sources, func(s *kong.CIDRPort, _ int) string { return describeCIDRPort(s) })),
+		)
+		var matches []routeMatch
+		for _, protocol := range lo.Uniq(protocols) {
+			for _, other := range others {
+				matches = append(matches, routeMatch{protocol: protocol, other: other, stream: true})
+			}
+		}
+		return matches
+	}
+
+	paths := []routePath{{typ: routePathAny}}
+	if len(route.Paths) > 0 {
+		paths = lo.Map(lo.Uniq(derefStrings(route.Paths)), func(p string, _ int) routePath { return traditionalRoutePath(p) })
+	}
+	hosts := lo.Uniq(orAny(lo.Map(derefStrings(route.Hosts), func(h string, _ int) string { return strings.ToLower(h) })))
+	methods := lo.Uniq(orAny(derefStrings(route.Methods)))
+	headers := describeRouteHeaders(route.Headers)
+
+	matches := make([]routeMatch, 0, len(protocols)*len(hosts)*len(paths)*len(methods))
+	for _, protocol := range lo.Uniq(protocols) {
+		for _, host := range hosts {
+			for _, path := range paths {
+				for _, method := range methods {
+					matches = append(matches, routeMatch{
+						protocol: protocol,
+						host:     host,
+						path:     path,
+						method:   method,
+						headers:  headers,
+					})
+				}
+			}
+		}
+	}
+	return matches
+}
+
+// expressionRouteMatches returns the matches of the clauses of the disjunctive normal form of the expression.
+// An expression that can't be parsed is described as a whole.
+func expressionRouteMatches(expression string) []routeMatch {
+	clauses, err := atc.ParseDisjunctiveNormalForm(expression)
+	if err != nil {
+		return []routeMatch{{
+			other:    fmt.Sprintf("expression %q", expression),
+			unparsed: true,
+			matcher:  expressionMatcher(expression),
+		}}
+	}
+	return lo.FlatMap(clauses, func(clause []atc.ParsedPredicate, _ int) []routeMatch {
+		return expressionClauseMatches(clause)
+	})
+}
+
+// expressionClauseMatches normalizes a conjunction of predicates of an expression. A clause without a protocol
+// predicate is described as a match for each of the default protocols of traditional HTTP routes.
+func expressionClauseMatches(clause []atc.ParsedPredicate) []routeMatch {
+	var (
+		protocols      []string
+		headers, other []string
+		match          = routeMatch{host: "*", method: "*"}
+		hasHost        bool
+		hasPath        bool
+	)
+	for _, p := range clause {
+		if p.Negated || !p.StringValue {
+			other = append(other, p.Expression())
+			continue
+		}
+		switch {
+		case p.Field == atc.FieldNetProtocol.String() && p.Op == atc.OpEqual && len(protocols) == 0:
+			protocols = []string{p.Value}
+		case p.Field == atc.FieldHTTPHost.String() && !hasHost && p.Op == atc.OpEqual:
+			match.host, hasHost = strings.ToLower(p.Value), true
+		case p.Field == atc.FieldHTTPHost.String() && !hasHost && p.Op == atc.OpSuffixMatch && strings.HasPrefix(p.Value, "."):
+			match.host, hasHost = "*"+strings.ToLower(p.Value), true
+		case p.Field == atc.FieldHTTPHost.String() && !hasHost && p.Op == atc.OpPrefixMatch && strings.HasSuffix(p.Value, "."):
+			match.host, hasHost = strings.ToLower(p.Value)+"*", true
+		case p.Field == atc.FieldHTTPPath.String() && !hasPath && p.Op == atc.OpEqual:
+			match.path, hasPath = routePath{typ: routePathExact, value: p.Value}, true
+		case p.Field == atc.FieldHTTPPath.String() && !hasPath && p.Op == atc.OpPrefixMatch:
+			match.path, hasPath = routePath{typ: routePathPrefix, value: p.Value}, true
+		case p.Field == atc.FieldHTTPPath.String() && !hasPath && p.Op == atc.OpRegexMatch:
+			regex, anchored := strings.CutPrefix(p.Value, "^")
+			if !anchored {
+				regex = ".*" + regex
+			}
+			match.path, hasPath = routePath{typ: routePathRegex, value: regex}, true
+		case p.Field == atc.FieldHTTPMethod.String() && match.method == "*" && p.Op == atc.OpEqual:
+			match.method = p.Value
+		case strings.HasPrefix(p.Field, "http.headers.") && (p.Op == atc.OpEqual || p.Op == atc.OpRegexMatch):
+			name := strings.TrimPrefix(p.Field, "http.headers.")
+			if p.Op == atc.OpRegexMatch {
+				headers = append(headers, name+"=~"+p.Value)
+			} else {
+				headers = append(headers, name+"="+p.Value)
+			}
+		default:
+			other = append(other, p.Expression())
+		}
+	}
+	match.headers = "*"
+	if len(headers) > 0 {
+		sort.Strings(headers)
+		match.headers = strings.Join(headers, ",")
+	}
+	sort.Strings(other)
+	match.other = strings.Join(other, " && ")
+
+	matchers := lo.Map(clause, func(p atc.ParsedPredicate, _ int) atc.Matcher { return p })
+	if len(protocols) > 0 {
+		match.protocol = protocols[0]
+		match.matcher = atc.And(matchers...)
+		return []routeMatch{match}
+	}
+	return lo.Map([]string{"http", "https"}, func(protocol string, _ int) routeMatch {
+		m := match
+		m.protocol = protocol
+		m.matcher = atc.And(append(slices.Clone(matchers), atc.NewPredicateNetProtocol(atc.OpEqual, protocol))...)
+		return m
+	})
+}
+
+// combineRouteCriteria returns descriptions of all the combinations of the values of the criteria.
+func combineRouteCriteria(criteria ...[]string) []string {
+	combinations := []string{""}
+	for _, values := range criteria {
+		values = lo.Uniq(values)
+		next := make([]string, 0, len(combinations)*len(values))
+		for _, combination := range combinations {
+			for _, value := range values {
+				next = append(next, combination+" "+value)
+			}
+		}
+		combinations = next
+	}
+	return lo.Map(combinations, func(c string, _ int) string { return strings.TrimPrefix(c, " ") })
+}
+
+func derefStrings(values []*string) []string {
+	return lo.FilterMap(values, func(v *string, _ int) (string, bool) {
+		if v == nil {
+			return "", false
+		}
+		return *v, true
+	})
+}
+
+func orAny(values []string) []string {
+	if len(values) == 0 {
+		return []string{"*"}
+	}
+	return values
+}
+
+func describeCIDRPort(c *kong.CIDRPort) string {
+	ip, port := "*", "*"
+	if c.IP != nil {
+		ip = *c.IP
+	}
+	if c.Port != nil {
+		port = strconv.Itoa(*c.Port)
+	}
+	return ip + ":" + port
+}
+
+// describeRouteHeaders returns a canonical description of header matches, so the same headers in any order are
+// described the same way.
+func describeRouteHeaders(headers map[string][]string) string {
+	if len(headers) == 0 {
+		return "*"
+	}
+	names := lo.Keys(headers)
+	sort.Strings(names)
+	return strings.Join(lo.Map(names, func(name string, _ int) string {
+		values := slices.Clone(headers[name])
+		slices.Sort(values)
+		return strings.ToLower(name) + "=" + strings.Join(values, "|")
+	}), ",")
+}
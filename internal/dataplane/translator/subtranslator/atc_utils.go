@@ -1,8 +1,11 @@
 package subtranslator
 
 import (
+	"fmt"
+	"strconv"
 	"strings"
 
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator/atc"
 )
 
@@ -26,6 +29,78 @@ type (
 	RoutePriorityType = uint64
 )
 
+const (
+	// kindPriorityRange is the number of priorities available to routes translated from resources of a single kind.
+	kindPriorityRange RoutePriorityType = 1 << FromResourceKindPriorityShiftBits
+
+	// MaxRoutePriorityAdjustment is the maximum absolute value of the konghq.com/priority-adjustment annotation.
+	// An adjustment of that size moves a route across the whole priority range of its resource kind.
+	MaxRoutePriorityAdjustment = int64(kindPriorityRange - 1)
+)
+
+// ParseRoutePriorityAdjustment parses the value of the konghq.com/priority-adjustment annotation. It returns
+// an error if the value is not an integer in the [-MaxRoutePriorityAdjustment, MaxRoutePriorityAdjustment] range.
+func ParseRoutePriorityAdjustment(value string) (int64, error) {
+	adjustment, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not an integer", value)
+	}
+	if adjustment < -MaxRoutePriorityAdjustment || adjustment > MaxRoutePriorityAdjustment {
+		return 0, fmt.Errorf("%d is out of the [%d, %d] range", adjustment, -MaxRoutePriorityAdjustment, MaxRoutePriorityAdjustment)
+	}
+	return adjustment, nil
+}
+
+// CheckRoutePriorityAdjustment returns an error if the adjustment from the konghq.com/priority-adjustment annotation
+// would move any of the given unadjusted priorities of an object's expression routes out of the priority range of
+// their resource kind, i.e. if adjustRoutePriority would clamp it. The error tells the range of adjustments allowed
+// for the object.
+func CheckRoutePriorityAdjustment(value string, priorities ...RoutePriorityType) error {
+	adjustment, err := ParseRoutePriorityAdjustment(value)
+	if err != nil || adjustment == 0 || len(priorities) == 0 {
+		return err
+	}
+	lowest, highest := -MaxRoutePriorityAdjustment, MaxRoutePriorityAdjustment
+	for _, priority := range priorities {
+		kindMin := priority &^ (kindPriorityRange - 1)
+		kindMax := kindMin + kindPriorityRange - 1
+		lowest = max(lowest, -int64(priority-kindMin))
+		highest = min(highest, int64(kindMax-priority))
+	}
+	if adjustment < lowest || adjustment > highest {
+		return fmt.Errorf("%d would move priorities of some routes out of the priority range of their kind, "+
+			"the adjustment must be in the [%d, %d] range", adjustment, lowest, highest)
+	}
+	return nil
+}
+
+// adjustRoutePriority folds the adjustment from the konghq.com/priority-adjustment annotation into the priority
+// calculated for an expression route. The result is clamped to the priority range of the route's resource kind,
+// so routes of different kinds keep their order regardless of their adjustments. Invalid values and values that
+// would be clamped are rejected by the admission webhook (see CheckRoutePriorityAdjustment), invalid values are
+// ignored here.
+func adjustRoutePriority(priority RoutePriorityType, anns map[string]string) RoutePriorityType {
+	value, ok := annotations.ExtractPriorityAdjustment(anns)
+	if !ok {
+		return priority
+	}
+	adjustment, err := ParseRoutePriorityAdjustment(value)
+	if err != nil || adjustment == 0 {
+		return priority
+	}
+
+	kindMin := priority &^ (kindPriorityRange - 1)
+	kindMax := kindMin + kindPriorityRange - 1
+	if adjustment > 0 {
+		// Priorities use at most 46 bits, so the sum cannot overflow.
+		return min(priority+RoutePriorityType(adjustment), kindMax)
+	}
+	if decrease := RoutePriorityType(-adjustment); priority-kindMin > decrease {
+		return priority - decrease
+	}
+	return kindMin
+}
+
 const (
 	// CatchAllHTTPExpression is the expression to match all HTTP/HTTPS requests.
 	// For rules with no matches and no hostnames in its parent HTTPRoute or GRPCRoute,
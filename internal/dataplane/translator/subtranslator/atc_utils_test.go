@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
 )
 
 func TestHostMatcherFromHosts(t *testing.T) {
@@ -42,3 +44,172 @@ func TestHostMatcherFromHosts(t *testing.T) {
 		})
 	}
 }
+
+func TestParseRoutePriorityAdjustment(t *testing.T) {
+	testCases := []struct {
+		value         string
+		expected      int64
+		expectedError string
+	}{
+		{value: "100", expected: 100},
+		{value: "-100", expected: -100},
+		{value: "17592186044415", expected: MaxRoutePriorityAdjustment},
+		{value: "-17592186044415", expected: -MaxRoutePriorityAdjustment},
+		{value: "17592186044416", expectedError: "17592186044416 is out of the [-17592186044415, 17592186044415] range"},
+		{value: "-17592186044416", expectedError: "-17592186044416 is out of the [-17592186044415, 17592186044415] range"},
+		{value: "9223372036854775808", expectedError: `"9223372036854775808" is not an integer`},
+		{value: "high", expectedError: `"high" is not an integer`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.value, func(t *testing.T) {
+			adjustment, err := ParseRoutePriorityAdjustment(tc.value)
+			if tc.expectedError != "" {
+				require.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, adjustment)
+		})
+	}
+}
+
+func TestAdjustRoutePriority(t *testing.T) {
+	const (
+		httpRouteKindMin = RoutePriorityType(ResourceKindBitsHTTPRoute << FromResourceKindPriorityShiftBits)
+		httpRouteKindMax = RoutePriorityType(ResourceKindBitsIngress<<FromResourceKindPriorityShiftBits) - 1
+		ingressKindMax   = RoutePriorityType(1<<(FromResourceKindPriorityShiftBits+2)) - 1
+		basePriority     = httpRouteKindMin + 1000
+	)
+	withAdjustment := func(value string) map[string]string {
+		return map[string]string{annotations.AnnotationPrefix + annotations.PriorityAdjustKey: value}
+	}
+
+	testCases := []struct {
+		name        string
+		priority    RoutePriorityType
+		annotations map[string]string
+		expected    RoutePriorityType
+	}{
+		{
+			name:     "no annotation",
+			priority: basePriority,
+			expected: basePriority,
+		},
+		{
+			name:        "positive adjustment",
+			priority:    basePriority,
+			annotations: withAdjustment("24"),
+			expected:    basePriority + 24,
+		},
+		{
+			name:        "negative adjustment",
+			priority:    basePriority,
+			annotations: withAdjustment("-24"),
+			expected:    basePriority - 24,
+		},
+		{
+			name:        "adjustment overflowing into the kind bits is clamped to the kind's maximum",
+			priority:    basePriority,
+			annotations: withAdjustment("17592186044415"),
+			expected:    httpRouteKindMax,
+		},
+		{
+			name:        "adjustment underflowing into the kind bits is clamped to the kind's minimum",
+			priority:    basePriority,
+			annotations: withAdjustment("-1001"),
+			expected:    httpRouteKindMin,
+		},
+		{
+			name:        "maximum adjustment of the highest possible priority does not exceed Kong's limit",
+			priority:    ingressKindMax,
+			annotations: withAdjustment("17592186044415"),
+			expected:    ingressKindMax,
+		},
+		{
+			name:        "minimum adjustment of a priority without kind bits does not wrap around",
+			priority:    5,
+			annotations: withAdjustment("-17592186044415"),
+			expected:    0,
+		},
+		{
+			name:        "invalid adjustment is ignored",
+			priority:    basePriority,
+			annotations: withAdjustment("17592186044416"),
+			expected:    basePriority,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, adjustRoutePriority(tc.priority, tc.annotations))
+		})
+	}
+}
+
+func TestCheckRoutePriorityAdjustment(t *testing.T) {
+	const (
+		httpRouteKindMin = RoutePriorityType(ResourceKindBitsHTTPRoute << FromResourceKindPriorityShiftBits)
+		grpcRouteKindMin = RoutePriorityType(ResourceKindBitsGRPCRoute << FromResourceKindPriorityShiftBits)
+	)
+	priorities := []RoutePriorityType{httpRouteKindMin + 1000, httpRouteKindMin + 5000}
+
+	testCases := []struct {
+		name          string
+		value         string
+		priorities    []RoutePriorityType
+		expectedError string
+	}{
+		{
+			name:       "adjustment keeping priorities in the kind's range",
+			value:      "-1000",
+			priorities: priorities,
+		},
+		{
+			name:       "largest adjustment keeping priorities in the kind's range",
+			value:      "17592186039415",
+			priorities: priorities,
+		},
+		{
+			name:       "no priorities to adjust",
+			value:      "17592186044415",
+			priorities: nil,
+		},
+		{
+			name:       "zero adjustment",
+			value:      "0",
+			priorities: []RoutePriorityType{grpcRouteKindMin},
+		},
+		{
+			name:       "negative adjustment moving a priority below the kind's range",
+			value:      "-1001",
+			priorities: priorities,
+			expectedError: "-1001 would move priorities of some routes out of the priority range of their kind, " +
+				"the adjustment must be in the [-1000, 17592186039415] range",
+		},
+		{
+			name:       "positive adjustment moving a priority above the kind's range",
+			value:      "17592186039416",
+			priorities: priorities,
+			expectedError: "17592186039416 would move priorities of some routes out of the priority range of their kind, " +
+				"the adjustment must be in the [-1000, 17592186039415] range",
+		},
+		{
+			name:          "invalid adjustment",
+			value:         "high",
+			priorities:    priorities,
+			expectedError: `"high" is not an integer`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := CheckRoutePriorityAdjustment(tc.value, tc.priorities...)
+			if tc.expectedError != "" {
+				require.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -168,7 +168,7 @@ func headerMatcherFromGRPCHeaderMatches(headerMatches []gatewayapi.GRPCHeaderMat
 	matchers := make([]atc.Matcher, 0, len(headerMatches))
 	for _, headerMatch := range headerMatches {
 		httpHeaderMatch := gatewayapi.HTTPHeaderMatch{
-			Type:  headerMatch.Type,
+			Type:  (*gatewayapi.HeaderMatchType)(headerMatch.Type),
 			Name:  gatewayapi.HTTPHeaderName(headerMatch.Name),
 			Value: headerMatch.Value,
 		}
@@ -353,6 +353,8 @@ type SplitGRPCRouteMatchToPriority struct {
 // If ties exists in the first step, where multiple matches has the same priority
 // calculated from the fields, we run a sort for the matches in the tie
 // and assign the bits for "relative order" according to the sorting result of these matches.
+// At last, the adjustment from the konghq.com/priority-adjustment annotation of the source route is folded
+// into the priority.
 func AssignRoutePriorityToSplitGRPCRouteMatches(
 	logger logr.Logger,
 	splitGRPCouteMatches []SplitGRPCRouteMatch,
@@ -377,7 +379,7 @@ func AssignRoutePriorityToSplitGRPCRouteMatches(
 			splitGRPCRoutesToPriority = append(
 				splitGRPCRoutesToPriority, SplitGRPCRouteMatchToPriority{
 					Match:    matches[0],
-					Priority: priority + defaultRelativeOrderPriorityBits,
+					Priority: adjustRoutePriority(priority+defaultRelativeOrderPriorityBits, matches[0].Source.Annotations),
 				})
 			continue
 		}
@@ -396,7 +398,7 @@ func AssignRoutePriorityToSplitGRPCRouteMatches(
 			}
 			splitGRPCRoutesToPriority = append(splitGRPCRoutesToPriority, SplitGRPCRouteMatchToPriority{
 				Match:    match,
-				Priority: priority + relativeOrderBits,
+				Priority: adjustRoutePriority(priority+relativeOrderBits, match.Source.Annotations),
 			})
 		}
 
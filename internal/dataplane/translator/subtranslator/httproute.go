@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	pathlib "path"
+	"regexp"
 	"sort"
 	"strings"
 
@@ -24,7 +25,11 @@ import (
 type KongServiceTranslation struct {
 	Name        string
 	BackendRefs []gatewayapi.HTTPBackendRef
-	KongRoutes  []KongRouteTranslation
+	// Timeouts are the timeouts shared by all the rules translated to this Kong service.
+	Timeouts *gatewayapi.HTTPRouteTimeouts
+	// Retry is the retry policy shared by all the rules translated to this Kong service.
+	Retry      *gatewayapi.HTTPRouteRetry
+	KongRoutes []KongRouteTranslation
 }
 
 // KongRouteTranslation is a translation of a single HTTPRoute rule into metadata
@@ -37,9 +42,10 @@ type KongRouteTranslation struct {
 
 // TranslateHTTPRoute translates a list of HTTPRoutes into a list of HTTPRouteTranslationMeta
 // objects that can be used to instantiate Kong routes and services.
-// The translation is done by grouping the HTTPRoutes by their backendRefs.
+// The translation is done by grouping the HTTPRoutes by their backendRefs, timeouts and retry policies.
 // This means that all the rules of a single HTTPRoute will be grouped together
-// if they share the same backendRefs.
+// if they share the same backendRefs, timeouts and retry policy. Rules with the same backendRefs
+// but different timeouts or retry policies are translated into separate Kong services.
 func TranslateHTTPRoute(route *gatewayapi.HTTPRoute) []*KongServiceTranslation {
 	index := httpRouteTranslationIndex{}
 	index.setRoute(route)
@@ -73,7 +79,7 @@ func (i *httpRouteTranslationIndex) extractRulesMeta(route *gatewayapi.HTTPRoute
 }
 
 func (i *httpRouteTranslationIndex) translate() []*KongServiceTranslation {
-	rulesGroupedByBackendRed := groupRulesByBackendRefs(i.rulesMeta)
+	rulesGroupedByBackendRed := groupRulesByBackendRefsTimeoutsAndRetry(i.rulesMeta)
 	translations := make([]*KongServiceTranslation, 0, len(rulesGroupedByBackendRed))
 
 	for _, rulesByBackends := range rulesGroupedByBackendRed {
@@ -90,6 +96,8 @@ func (i *httpRouteTranslationIndex) translateToKongService(rulesMeta []httpRoute
 	return &KongServiceTranslation{
 		Name:        i.translateToKongServiceName(rulesMeta),
 		BackendRefs: i.translateToKongServiceBackends(rulesMeta),
+		Timeouts:    i.translateToKongServiceTimeouts(rulesMeta),
+		Retry:       i.translateToKongServiceRetry(rulesMeta),
 		KongRoutes:  nil,
 	}
 }
@@ -119,6 +127,24 @@ func (i *httpRouteTranslationIndex) translateToKongServiceBackends(rulesMeta []h
 	return rulesMeta[0].Rule.BackendRefs
 }
 
+func (i *httpRouteTranslationIndex) translateToKongServiceTimeouts(rulesMeta []httpRouteRuleMeta) *gatewayapi.HTTPRouteTimeouts {
+	if len(rulesMeta) == 0 {
+		return nil
+	}
+	// get the timeouts from any rule, as they are all the same,
+	// because the rules are processed in groups with the same backendRefs and timeouts.
+	return rulesMeta[0].Rule.Timeouts
+}
+
+func (i *httpRouteTranslationIndex) translateToKongServiceRetry(rulesMeta []httpRouteRuleMeta) *gatewayapi.HTTPRouteRetry {
+	if len(rulesMeta) == 0 {
+		return nil
+	}
+	// get the retry policy from any rule, as they are all the same,
+	// because the rules are processed in groups with the same backendRefs, timeouts and retry policy.
+	return rulesMeta[0].Rule.Retry
+}
+
 func (i *httpRouteTranslationIndex) translateToKongServiceRoutes(s *KongServiceTranslation, rulesMeta []httpRouteRuleMeta) {
 	for _, rulesByFilter := range groupRulesByFilter(rulesMeta) {
 		// each filter group must be a separate Kong route, not eligible for consolidation
@@ -194,11 +220,13 @@ func (i *httpRouteTranslationIndex) translateToKongRouteName(matchesMeta httpRou
 	)
 }
 
-// groupRulesByBackendRefs groups the rules by their backendRefs.
-// The backendRefs are grouped by their key function.
+// groupRulesByBackendRefsTimeoutsAndRetry groups the rules by their backendRefs, timeouts and retry policies.
+// The rules are grouped by their key function.
 // The elements in the groups have the order of the original slice, but the groups themselves are not ordered.
-func groupRulesByBackendRefs(ruleEntries []httpRouteRuleMeta) map[string][]httpRouteRuleMeta {
-	return groupSliceByKeyFn(ruleEntries, httpRouteRuleMeta.getHTTPBackendRefsKey)
+func groupRulesByBackendRefsTimeoutsAndRetry(ruleEntries []httpRouteRuleMeta) map[string][]httpRouteRuleMeta {
+	return groupSliceByKeyFn(ruleEntries, func(m httpRouteRuleMeta) string {
+		return m.getHTTPBackendRefsKey() + ";" + m.getTimeoutsKey() + ";" + m.getRetryKey()
+	})
 }
 
 // groupRulesByFilter groups the rules by their filters.
@@ -241,6 +269,16 @@ func (m httpRouteRuleMeta) getHTTPBackendRefsKey() string {
 	return getSortedItemsString(m.Rule.BackendRefs)
 }
 
+// getTimeoutsKey computes a key from the rule's timeouts.
+func (m httpRouteRuleMeta) getTimeoutsKey() string {
+	return mustMarshalJSON(m.Rule.Timeouts)
+}
+
+// getRetryKey computes a key from the rule's retry policy.
+func (m httpRouteRuleMeta) getRetryKey() string {
+	return mustMarshalJSON(m.Rule.Retry)
+}
+
 func (m *httpRouteRuleMeta) matches() httpRouteMatchMetaList {
 	matches := make([]httpRouteMatchMeta, 0, len(m.Rule.Matches))
 
@@ -446,6 +484,13 @@ func generatePluginsFromHTTPRouteFilters(
 			transformerPlugins = append(transformerPlugins, plugins...)
 			kongRouteModifiers = append(kongRouteModifiers, routeModifiers...)
 
+		case gatewayapi.HTTPRouteFilterCORS:
+			plugin, err := generateCORSKongPlugin(filter.CORS)
+			if err != nil {
+				return httpRouteFiltersOriginatedPlugins{}, err
+			}
+			kongPlugins = append(kongPlugins, plugin)
+
 		case gatewayapi.HTTPRouteFilterRequestMirror:
 			// not supported
 			return httpRouteFiltersOriginatedPlugins{}, fmt.Errorf("httpFilter %s unsupported", filter.Type)
@@ -598,6 +643,106 @@ func generateExtensionRefKongPlugin(modifier *gatewayapi.LocalObjectReference) (
 	return string(modifier.Name), nil
 }
 
+// corsDefaultMaxAge is the default of the HTTPCORSFilter's maxAge defined by Gateway API.
+const corsDefaultMaxAge = 5
+
+// corsSafelistedMethods are the methods that are always allowed by the HTTPCORSFilter.
+var corsSafelistedMethods = []string{"GET", "HEAD", "POST"}
+
+// corsSupportedMethods are the methods that can be set in the Kong cors plugin's configuration.
+var corsSupportedMethods = []string{"GET", "HEAD", "PUT", "PATCH", "POST", "DELETE", "OPTIONS", "TRACE", "CONNECT"}
+
+// ValidateHTTPCORSFilter checks whether the given CORS filter can be translated into a Kong cors plugin.
+func ValidateHTTPCORSFilter(filter *gatewayapi.HTTPCORSFilter) error {
+	if filter == nil {
+		return fmt.Errorf("filter %s has no cors configuration", gatewayapi.HTTPRouteFilterCORS)
+	}
+	for _, origin := range filter.AllowOrigins {
+		if _, err := corsOriginToKongOrigin(string(origin)); err != nil {
+			return err
+		}
+	}
+	for _, method := range filter.AllowMethods {
+		if method == "*" {
+			if len(filter.AllowMethods) > 1 {
+				return fmt.Errorf("CORS allowMethods wildcard %q must be the only entry", method)
+			}
+			continue
+		}
+		if !lo.Contains(corsSupportedMethods, string(method)) {
+			return fmt.Errorf("CORS allowMethods %q is not supported, supported methods are: %s",
+				method, strings.Join(corsSupportedMethods, ", "))
+		}
+	}
+	if len(filter.AllowHeaders) > 1 && lo.Contains(filter.AllowHeaders, "*") {
+		return fmt.Errorf("CORS allowHeaders wildcard %q must be the only entry", "*")
+	}
+	if len(filter.ExposeHeaders) > 1 && lo.Contains(filter.ExposeHeaders, "*") {
+		return fmt.Errorf("CORS exposeHeaders wildcard %q must be the only entry", "*")
+	}
+	if filter.MaxAge < 0 {
+		return fmt.Errorf("CORS maxAge %d must not be negative", filter.MaxAge)
+	}
+	return nil
+}
+
+// generateCORSKongPlugin converts a gatewayapi.HTTPCORSFilter into a kong.Plugin of type cors.
+// Wildcard origins (e.g. https://*.example.com) are translated to regular expressions, which
+// Kong matches against the whole Origin header. Wildcard or empty allowMethods and allowHeaders
+// are left unset, so Kong allows all the methods and the headers requested in preflights.
+func generateCORSKongPlugin(filter *gatewayapi.HTTPCORSFilter) (kong.Plugin, error) {
+	if err := ValidateHTTPCORSFilter(filter); err != nil {
+		return kong.Plugin{}, err
+	}
+
+	config := kong.Configuration{
+		"credentials": bool(filter.AllowCredentials),
+		"max_age":     corsDefaultMaxAge,
+	}
+	if filter.MaxAge > 0 {
+		config["max_age"] = int(filter.MaxAge)
+	}
+	if len(filter.AllowOrigins) > 0 {
+		origins := make([]string, 0, len(filter.AllowOrigins))
+		for _, origin := range filter.AllowOrigins {
+			// Validated above.
+			kongOrigin, _ := corsOriginToKongOrigin(string(origin))
+			origins = append(origins, kongOrigin)
+		}
+		config["origins"] = origins
+	}
+	if len(filter.AllowMethods) > 0 && filter.AllowMethods[0] != "*" {
+		// CORS-safelisted methods are always allowed by the filter.
+		methods := lo.Map(filter.AllowMethods, func(m gatewayapi.HTTPMethodWithWildcard, _ int) string { return string(m) })
+		config["methods"] = lo.Uniq(append(methods, corsSafelistedMethods...))
+	}
+	if len(filter.AllowHeaders) > 0 && filter.AllowHeaders[0] != "*" {
+		config["headers"] = lo.Map(filter.AllowHeaders, func(h gatewayapi.HTTPHeaderName, _ int) string { return string(h) })
+	}
+	if len(filter.ExposeHeaders) > 0 {
+		config["exposed_headers"] = lo.Map(filter.ExposeHeaders, func(h gatewayapi.HTTPHeaderName, _ int) string { return string(h) })
+	}
+
+	return kong.Plugin{
+		Name:   kong.String("cors"),
+		Config: config,
+	}, nil
+}
+
+// corsOriginToKongOrigin converts an origin of the HTTPCORSFilter into an origin of the Kong cors plugin.
+// The origin `*` and origins without wildcards are used as they are, origins with a wildcard host label
+// are converted into regular expressions matching a single host label.
+func corsOriginToKongOrigin(origin string) (string, error) {
+	if origin == "*" || !strings.Contains(origin, "*") {
+		return origin, nil
+	}
+	scheme, host, ok := strings.Cut(origin, "://")
+	if !ok || strings.Count(host, "*") != 1 || !strings.HasPrefix(host, "*.") {
+		return "", fmt.Errorf("CORS allowOrigins %q is invalid, only the leftmost host label can be a wildcard", origin)
+	}
+	return regexp.QuoteMeta(scheme+"://") + `[^.]+` + regexp.QuoteMeta(strings.TrimPrefix(host, "*")), nil
+}
+
 // generateRequestHeaderModifierKongPlugin converts a gatewayapi.HTTPRequestHeaderFilter into a
 // kong.Plugin of type request-transformer.
 func generateRequestHeaderModifierKongPlugin(modifier *gatewayapi.HTTPHeaderFilter) transformerPlugin {
@@ -447,6 +447,30 @@ func (t HTTPRoutePriorityTraits) EncodeToPriority() RoutePriorityType {
 	return priority
 }
 
+const (
+	// httpRouteRelativeOrderAssignedBits is the number of the lowest priority bits of routes from HTTPRoutes used
+	// for the relative order of matches having the same priority otherwise.
+	httpRouteRelativeOrderAssignedBits = 12
+	// httpRouteDefaultRelativeOrderPriorityBits are the relative order bits of the first match.
+	httpRouteDefaultRelativeOrderPriorityBits = (RoutePriorityType(1) << httpRouteRelativeOrderAssignedBits) - 1
+)
+
+// CheckHTTPRoutePriorityAdjustment returns an error if the adjustment from the konghq.com/priority-adjustment
+// annotation of the HTTPRoute would move priorities of its expression routes out of the priority range of
+// HTTPRoutes. As the relative order bits depend on other HTTPRoutes, any of their values is taken into account.
+func CheckHTTPRoutePriorityAdjustment(httproute *gatewayapi.HTTPRoute) error {
+	value, ok := annotations.ExtractPriorityAdjustment(httproute.Annotations)
+	if !ok {
+		return nil
+	}
+	var priorities []RoutePriorityType
+	for _, match := range SplitHTTPRoute(httproute) {
+		priority := CalculateHTTPRouteMatchPriorityTraits(match).EncodeToPriority()
+		priorities = append(priorities, priority, priority+httpRouteDefaultRelativeOrderPriorityBits)
+	}
+	return CheckRoutePriorityAdjustment(value, priorities...)
+}
+
 // AssignRoutePriorityToSplitHTTPRouteMatches assigns priority to
 // ALL split matches from ALL HTTPRoutes in the cache.
 // Firstly assign "fixed" bits by the following fields of the match:
@@ -454,6 +478,8 @@ func (t HTTPRoutePriorityTraits) EncodeToPriority() RoutePriorityType {
 // If ties exists in the first step, where multiple matches has the same priority
 // calculated from the fields, we run a sort for the matches in the tie
 // and assign the bits for "relative order" according to the sorting result of these matches.
+// At last, the adjustment from the konghq.com/priority-adjustment annotation of the source route is folded
+// into the priority.
 func AssignRoutePriorityToSplitHTTPRouteMatches(
 	logger logr.Logger,
 	splitHTTPRouteMatches []SplitHTTPRouteMatch,
@@ -471,13 +497,12 @@ func AssignRoutePriorityToSplitHTTPRouteMatches(
 	// If multiple matches are assigned to the same priority in the previous step,
 	// sort them then starts with 2^12 -1 and decrease by one for each HTTPRoute;
 	// If only one match occupies the priority, fill the relative order bits with all 1s.
-	const RelativeOrderAssignedBits = 12
-	const defaultRelativeOrderPriorityBits = (uint64(1) << RelativeOrderAssignedBits) - 1
+	const defaultRelativeOrderPriorityBits = httpRouteDefaultRelativeOrderPriorityBits
 	for priority, matches := range priorityToSplitHTTPRouteMatches {
 		if len(matches) == 1 {
 			httpRouteMatchesToPriorities = append(httpRouteMatchesToPriorities, SplitHTTPRouteMatchToKongRoutePriority{
 				Match:    matches[0],
-				Priority: priority + defaultRelativeOrderPriorityBits,
+				Priority: adjustRoutePriority(priority+defaultRelativeOrderPriorityBits, matches[0].Source.Annotations),
 			})
 			continue
 		}
@@ -496,7 +521,7 @@ func AssignRoutePriorityToSplitHTTPRouteMatches(
 			}
 			httpRouteMatchesToPriorities = append(httpRouteMatchesToPriorities, SplitHTTPRouteMatchToKongRoutePriority{
 				Match:    match,
-				Priority: priority + relativeOrderBits,
+				Priority: adjustRoutePriority(priority+relativeOrderBits, match.Source.Annotations),
 			})
 		}
 		// Just in case, log a very unlikely scenario where we have more than 2^12 matches with the same base
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
@@ -1043,6 +1044,80 @@ func TestAssignRoutePriorityToSplitHTTPRouteMatches(t *testing.T) {
 				}.EncodeToPriority() + maxRelativeOrderPriorityBits - 1,
 			},
 		},
+		{
+			name: "priority adjustment annotation is folded into the priority",
+			matches: []SplitHTTPRouteMatch{
+				{
+					Source: &gatewayapi.HTTPRoute{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace:         "default",
+							Name:              "httproute-1",
+							CreationTimestamp: metav1.NewTime(now.Add(-5 * time.Second)),
+							Annotations: map[string]string{
+								annotations.AnnotationPrefix + annotations.PriorityAdjustKey: "100000000000",
+							},
+						},
+						Spec: gatewayapi.HTTPRouteSpec{
+							Hostnames: []gatewayapi.Hostname{"*.foo.com"},
+							Rules: []gatewayapi.HTTPRouteRule{
+								{
+									Matches: builder.NewHTTPRouteMatch().WithPathPrefix("/").ToSlice(),
+								},
+							},
+						},
+					},
+					Hostname:   "*.foo.com",
+					Match:      builder.NewHTTPRouteMatch().WithPathPrefix("/").Build(),
+					RuleIndex:  0,
+					MatchIndex: 0,
+				},
+				{
+					Source: &gatewayapi.HTTPRoute{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace:         "default",
+							Name:              "httproute-2",
+							CreationTimestamp: metav1.NewTime(now.Add(-10 * time.Second)),
+							Annotations: map[string]string{
+								annotations.AnnotationPrefix + annotations.PriorityAdjustKey: "17592186044415",
+							},
+						},
+						Spec: gatewayapi.HTTPRouteSpec{
+							Hostnames: []gatewayapi.Hostname{"foo.com"},
+							Rules: []gatewayapi.HTTPRouteRule{
+								{
+									Matches: builder.NewHTTPRouteMatch().WithPathExact("/foo").ToSlice(),
+								},
+							},
+						},
+					},
+					Hostname:   "foo.com",
+					Match:      builder.NewHTTPRouteMatch().WithPathExact("/foo").Build(),
+					RuleIndex:  0,
+					MatchIndex: 0,
+				},
+			},
+			priorities: map[splitHTTPRouteIndex]RoutePriorityType{
+				{
+					namespace:  "default",
+					name:       "httproute-1",
+					hostname:   "*.foo.com",
+					ruleIndex:  0,
+					matchIndex: 0,
+				}: HTTPRoutePriorityTraits{
+					PreciseHostname: false,
+					HostnameLength:  len("*.foo.com"),
+					PathType:        gatewayapi.PathMatchPathPrefix,
+					PathLength:      len("/"),
+				}.EncodeToPriority() + maxRelativeOrderPriorityBits + 100000000000,
+				{
+					namespace:  "default",
+					name:       "httproute-2",
+					hostname:   "foo.com",
+					ruleIndex:  0,
+					matchIndex: 0,
+				}: ResourceKindBitsIngress<<FromResourceKindPriorityShiftBits - 1,
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1065,3 +1140,40 @@ func TestAssignRoutePriorityToSplitHTTPRouteMatches(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckHTTPRoutePriorityAdjustment(t *testing.T) {
+	httproute := func(adjustment string) *gatewayapi.HTTPRoute {
+		return &gatewayapi.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "default",
+				Name:        "httproute",
+				Annotations: map[string]string{annotations.AnnotationPrefix + annotations.PriorityAdjustKey: adjustment},
+			},
+			Spec: gatewayapi.HTTPRouteSpec{
+				Hostnames: []gatewayapi.Hostname{"foo.com"},
+				Rules: []gatewayapi.HTTPRouteRule{
+					{
+						Matches: builder.NewHTTPRouteMatch().WithPathExact("/foo").ToSlice(),
+					},
+				},
+			},
+		}
+	}
+	priority := HTTPRoutePriorityTraits{
+		PreciseHostname: true,
+		HostnameLength:  len("foo.com"),
+		PathType:        gatewayapi.PathMatchExact,
+		PathLength:      len("/foo"),
+	}.EncodeToPriority()
+	kindMin := RoutePriorityType(ResourceKindBitsHTTPRoute << FromResourceKindPriorityShiftBits)
+	// Any relative order bits can be assigned to the route, so the highest ones are taken into account.
+	highest := int64(kindMin + kindPriorityRange - 1 - priority - httpRouteDefaultRelativeOrderPriorityBits)
+	lowest := -int64(priority - kindMin)
+
+	require.NoError(t, CheckHTTPRoutePriorityAdjustment(httproute(strconv.FormatInt(highest, 10))))
+	require.NoError(t, CheckHTTPRoutePriorityAdjustment(httproute(strconv.FormatInt(lowest, 10))))
+	require.ErrorContains(t, CheckHTTPRoutePriorityAdjustment(httproute(strconv.FormatInt(highest+1, 10))),
+		"out of the priority range of their kind")
+	require.ErrorContains(t, CheckHTTPRoutePriorityAdjustment(httproute(strconv.FormatInt(lowest-1, 10))),
+		"out of the priority range of their kind")
+}
@@ -215,6 +215,81 @@ func TestGeneratePluginsFromHTTPRouteFilters(t *testing.T) {
 			},
 			expectedErr: errors.New("plugin configuration.konghq.com/WrongKind unsupported"),
 		},
+		{
+			name: "CORS filter",
+			filters: []gatewayapi.HTTPRouteFilter{
+				{
+					Type: gatewayapi.HTTPRouteFilterCORS,
+					CORS: &gatewayapi.HTTPCORSFilter{
+						AllowOrigins:     []gatewayapi.AbsoluteURI{"https://example.com", "https://*.example.net:8443"},
+						AllowCredentials: true,
+						AllowMethods:     []gatewayapi.HTTPMethodWithWildcard{"PUT", "GET"},
+						AllowHeaders:     []gatewayapi.HTTPHeaderName{"X-Foo"},
+						ExposeHeaders:    []gatewayapi.HTTPHeaderName{"X-Bar"},
+						MaxAge:           60,
+					},
+				},
+			},
+			expectedPlugins: []kong.Plugin{
+				{
+					Name: kong.String("cors"),
+					Config: kong.Configuration{
+						"origins":         []string{"https://example.com", `https://[^.]+\.example\.net:8443`},
+						"credentials":     true,
+						"methods":         []string{"PUT", "GET", "HEAD", "POST"},
+						"headers":         []string{"X-Foo"},
+						"exposed_headers": []string{"X-Bar"},
+						"max_age":         60,
+					},
+				},
+			},
+		},
+		{
+			name: "CORS filter with wildcards and default max age",
+			filters: []gatewayapi.HTTPRouteFilter{
+				{
+					Type: gatewayapi.HTTPRouteFilterCORS,
+					CORS: &gatewayapi.HTTPCORSFilter{
+						AllowOrigins:  []gatewayapi.AbsoluteURI{"*"},
+						AllowMethods:  []gatewayapi.HTTPMethodWithWildcard{"*"},
+						AllowHeaders:  []gatewayapi.HTTPHeaderName{"*"},
+						ExposeHeaders: []gatewayapi.HTTPHeaderName{"*"},
+					},
+				},
+			},
+			expectedPlugins: []kong.Plugin{
+				{
+					Name: kong.String("cors"),
+					Config: kong.Configuration{
+						"origins":         []string{"*"},
+						"credentials":     false,
+						"exposed_headers": []string{"*"},
+						"max_age":         5,
+					},
+				},
+			},
+		},
+		{
+			name: "CORS filter without configuration",
+			filters: []gatewayapi.HTTPRouteFilter{
+				{
+					Type: gatewayapi.HTTPRouteFilterCORS,
+				},
+			},
+			expectedErr: errors.New("filter CORS has no cors configuration"),
+		},
+		{
+			name: "CORS filter with invalid wildcard origin",
+			filters: []gatewayapi.HTTPRouteFilter{
+				{
+					Type: gatewayapi.HTTPRouteFilterCORS,
+					CORS: &gatewayapi.HTTPCORSFilter{
+						AllowOrigins: []gatewayapi.AbsoluteURI{"https://foo.*.example.com"},
+					},
+				},
+			},
+			expectedErr: errors.New(`CORS allowOrigins "https://foo.*.example.com" is invalid, only the leftmost host label can be a wildcard`),
+		},
 		{
 			name: "RequestHeaderModifier and PrefixMatchHTTPPathModifier",
 			filters: []gatewayapi.HTTPRouteFilter{
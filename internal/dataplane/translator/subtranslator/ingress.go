@@ -183,6 +183,13 @@ func (i *ingressTranslationIndex) getIngressPathBackend(namespace string, httpIn
 	}
 
 	if resource := httpIngressPath.Backend.Resource; resource != nil {
+		if IsKongExternalBackend(resource) {
+			externalBackend, err := i.storer.GetKongExternalBackend(namespace, resource.Name)
+			if err != nil {
+				return ingressTranslationMetaBackend{}, fmt.Errorf("failed to get KongExternalBackend %q: %w", resource.Name, err)
+			}
+			return newIngressTranslationMetaBackendForKongExternalBackend(resource.Name, externalBackend), nil
+		}
 		if !IsKongServiceFacade(resource) {
 			gk := resource.Kind
 			if resource.APIGroup != nil {
@@ -215,6 +222,12 @@ func IsKongServiceFacade(resource *corev1.TypedLocalObjectReference) bool {
 		resource.APIGroup != nil && *resource.APIGroup == incubatorv1alpha1.GroupVersion.Group
 }
 
+// IsKongExternalBackend returns true if the given resource reference is a KongExternalBackend.
+func IsKongExternalBackend(resource *corev1.TypedLocalObjectReference) bool {
+	return resource.Kind == kongv1alpha1.KongExternalBackendKind &&
+		resource.APIGroup != nil && *resource.APIGroup == kongv1alpha1.GroupVersion.Group
+}
+
 func (i *ingressTranslationIndex) Translate() map[string]kongstate.Service {
 	kongStateServiceCache := make(map[string]kongstate.Service)
 	for _, meta := range i.cache {
@@ -262,8 +275,9 @@ type ingressTranslationMeta struct {
 type ingressPathBackendType string
 
 const (
-	ingressPathBackendTypeKongServiceFacade ingressPathBackendType = "KongServiceFacade"
-	ingressPathBackendTypeKubernetesService ingressPathBackendType = "KubernetesService"
+	ingressPathBackendTypeKongServiceFacade   ingressPathBackendType = "KongServiceFacade"
+	ingressPathBackendTypeKongExternalBackend ingressPathBackendType = "KongExternalBackend"
+	ingressPathBackendTypeKubernetesService   ingressPathBackendType = "KubernetesService"
 )
 
 type ingressTranslationMetaBackend struct {
@@ -278,6 +292,9 @@ type ingressTranslationMetaBackend struct {
 
 	// parentKongServiceFacade is the parent KongServiceFacade object if the backend is a KongServiceFacade. Otherwise, it's nil.
 	parentKongServiceFacade *incubatorv1alpha1.KongServiceFacade
+
+	// parentKongExternalBackend is the parent KongExternalBackend object if the backend is a KongExternalBackend. Otherwise, it's nil.
+	parentKongExternalBackend *kongv1alpha1.KongExternalBackend
 }
 
 func newIngressTranslationMetaBackendForKongServiceFacade(
@@ -293,6 +310,18 @@ func newIngressTranslationMetaBackendForKongServiceFacade(
 	}
 }
 
+func newIngressTranslationMetaBackendForKongExternalBackend(
+	name string,
+	parentKongExternalBackend *kongv1alpha1.KongExternalBackend,
+) ingressTranslationMetaBackend {
+	return ingressTranslationMetaBackend{
+		backendType:               ingressPathBackendTypeKongExternalBackend,
+		name:                      name,
+		port:                      kongstate.PortDef{Mode: kongstate.PortModeImplicit},
+		parentKongExternalBackend: parentKongExternalBackend,
+	}
+}
+
 func newIngressTranslationMetaBackendForKubernetesService(
 	name string,
 	port kongstate.PortDef,
@@ -315,6 +344,12 @@ func (b ingressTranslationMetaBackend) intoKongRouteName(ingress k8stypes.Namesp
 		return fmt.Sprintf("%s.%s.%s.%s.svc.facade", ingress.Namespace, ingress.Name, host, b.name)
 	}
 
+	// The same applies to KongExternalBackend backends for which Kong Routes are named
+	// `<ingress-namespace>.<ingress-name>.<host>.<external-backend-name>.external`.
+	if b.backendType == ingressPathBackendTypeKongExternalBackend {
+		return fmt.Sprintf("%s.%s.%s.%s.external", ingress.Namespace, ingress.Name, host, b.name)
+	}
+
 	// Otherwise, we assume it's a Kubernetes Service and create Kong Routes for the following combination
 	// `<ingress-namespace>.<ingress-name>.<host>.<service-name>.<service-port>`.
 	return fmt.Sprintf("%s.%s.%s.%s.%s", ingress.Namespace, ingress.Name, b.name, host, b.port.CanonicalString())
@@ -325,10 +360,46 @@ func (b ingressTranslationMetaBackend) isServiceFacade() bool {
 	return b.backendType == ingressPathBackendTypeKongServiceFacade
 }
 
+// isExternalBackend returns true if the backend is a KongExternalBackend.
+func (b ingressTranslationMetaBackend) isExternalBackend() bool {
+	return b.backendType == ingressPathBackendTypeKongExternalBackend
+}
+
 func (m *ingressTranslationMeta) translateIntoKongStateService(
 	kongServiceName string,
 	portDef kongstate.PortDef,
 ) (kongstate.Service, error) {
+	if m.backend.isExternalBackend() {
+		serviceBackend, err := kongstate.NewServiceBackendForExternalBackend(
+			k8stypes.NamespacedName{
+				Namespace: m.parentIngress.GetNamespace(),
+				Name:      m.backend.name,
+			},
+		)
+		if err != nil {
+			return kongstate.Service{}, fmt.Errorf("failed to create ServiceBackend for KongExternalBackend %q: %w", m.backend.name, err)
+		}
+
+		service := kongstate.Service{
+			Namespace: m.parentIngress.GetNamespace(),
+			Service: kong.Service{
+				Name:           kong.String(kongServiceName),
+				Host:           kong.String(kongServiceName),
+				Port:           kong.Int(defaultHTTPPort),
+				Protocol:       kong.String("http"),
+				Path:           kong.String("/"),
+				ConnectTimeout: defaultServiceTimeoutInKongFormat(),
+				ReadTimeout:    defaultServiceTimeoutInKongFormat(),
+				WriteTimeout:   defaultServiceTimeoutInKongFormat(),
+				Retries:        kong.Int(defaultRetries),
+			},
+			Backends: []kongstate.ServiceBackend{serviceBackend},
+			Parent:   m.backend.parentKongExternalBackend,
+		}
+		kongstate.ApplyExternalBackendTLS(&service.Service, m.backend.parentKongExternalBackend.Spec.TLS)
+		return service, nil
+	}
+
 	if m.backend.isServiceFacade() {
 		serviceBackend, err := kongstate.NewServiceBackendForServiceFacade(
 			k8stypes.NamespacedName{
@@ -390,6 +461,11 @@ func (m *ingressTranslationMeta) translateIntoKongStateService(
 }
 
 func (m *ingressTranslationMeta) generateKongServiceName() string {
+	if m.backend.isExternalBackend() {
+		// For KongExternalBackend we create one Kong Service per KongExternalBackend.
+		// The naming pattern is `<external-backend-namespace>.<external-backend-name>.external`.
+		return kongstate.ExternalBackendServiceName(m.parentIngress.GetNamespace(), m.backend.name, false)
+	}
 	if m.backend.isServiceFacade() {
 		// For KongServiceFacade we create one Kong Service per KongServiceFacade.
 		// The naming pattern is `<facade-namespace>.<facade-name>.svc.facade`.
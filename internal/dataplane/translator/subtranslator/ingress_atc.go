@@ -390,6 +390,8 @@ func calculateIngressRoutePriorityTraits(
 //   - Then, paths with regex match has higher priority than prefix match.
 //   - Then, sort by regex_priority field (not supported in KIC with expression routes).
 //   - At last, sort by maximum length of paths in the route.
+//
+// The adjustment from the konghq.com/priority-adjustment annotation is then folded into the priority.
 func calculateExpressionRoutePriority(
 	paths []netv1.HTTPIngressPath,
 	regexPathPrefix string,
@@ -399,5 +401,5 @@ func calculateExpressionRoutePriority(
 	traits := calculateIngressRoutePriorityTraits(
 		paths, regexPathPrefix, ingressHost, ingressAnnotations,
 	)
-	return traits.EncodeToPriority()
+	return adjustRoutePriority(traits.EncodeToPriority(), ingressAnnotations)
 }
@@ -1,6 +1,8 @@
 package subtranslator
 
 import (
+	"strings"
+
 	"github.com/samber/lo"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
@@ -12,7 +14,7 @@ import (
 func ApplyExpressionToL4KongRoute(r *kongstate.Route) {
 	matchers := []atc.Matcher{}
 
-	sniMatcher := sniMatcherFromSNIs(lo.Map(r.Route.SNIs, func(item *string, _ int) string { return *item }))
+	sniMatcher := l4SNIMatcherFromSNIs(lo.Map(r.Route.SNIs, func(item *string, _ int) string { return *item }))
 	matchers = append(matchers, sniMatcher)
 
 	// TODO(rodman10): replace with helper function.
@@ -35,3 +37,20 @@ func ApplyExpressionToL4KongRoute(r *kongstate.Route) {
 	r.ExpressionRoutes = true
 	atc.ApplyExpression(&r.Route, atc.And(matchers...), 1)
 }
+
+// l4SNIMatcherFromSNIs generates a matcher matching the given SNIs. In addition to
+// the exact SNIs accepted by sniMatcherFromSNIs it supports wildcard SNIs
+// (e.g. "*.example.com", which TLSRoutes may specify) by matching the SNI suffix.
+func l4SNIMatcherFromSNIs(snis []string) atc.Matcher {
+	matchers := make([]atc.Matcher, 0, len(snis))
+	for _, sni := range snis {
+		if suffix, ok := strings.CutPrefix(sni, "*"); ok && validSNIs.MatchString(strings.TrimPrefix(suffix, ".")) {
+			matchers = append(matchers, atc.NewPredicateTLSSNI(atc.OpSuffixMatch, suffix))
+			continue
+		}
+		if validSNIs.MatchString(sni) {
+			matchers = append(matchers, atc.NewPredicateTLSSNI(atc.OpEqual, sni))
+		}
+	}
+	return atc.Or(matchers...)
+}
@@ -72,6 +72,19 @@ func TestApplyExpressionToL4KongRoute(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "wildcard SNI host",
+			subExpr: "(tls.sni == \"example.com\") || (tls.sni =^ \".example.net\")",
+			route: kong.Route{
+				SNIs: []*string{
+					lo.ToPtr("example.com"),
+					lo.ToPtr("*.example.net"),
+				},
+				Protocols: []*string{
+					lo.ToPtr("tls"),
+				},
+			},
+		},
 		{
 			name:    "SNI host and multiple destination ports",
 			subExpr: "(tls.sni == \"example.com\") && ((net.dst.port == 1234) || (net.dst.port == 5678))",
@@ -9,6 +9,7 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -84,8 +85,14 @@ func (t *Translator) getGatewayCerts() []certWrapper {
 			}
 
 			if listener.TLS != nil {
-				if len(listener.TLS.CertificateRefs) > 0 {
-					if len(listener.TLS.CertificateRefs) > 1 {
+				certificateRefs := listener.TLS.CertificateRefs
+				if len(certificateRefs) == 0 &&
+					lo.FromPtrOr(listener.TLS.Mode, gatewayapi.TLSModeTerminate) == gatewayapi.TLSModeTerminate {
+					// Fall back to the default certificate of the GatewayClass, if any.
+					certificateRefs = t.gatewayClassDefaultCertificateRefs(gateway)
+				}
+				if len(certificateRefs) > 0 {
+					if len(certificateRefs) > 1 {
 						// TODO support cert_alt and key_alt if there are 2 SecretObjectReferences
 						// https://github.com/Kong/kubernetes-ingress-controller/issues/2604
 						t.registerTranslationFailure("listener '%s' has more than one certificateRef, it's not supported", gateway)
@@ -93,7 +100,7 @@ func (t *Translator) getGatewayCerts() []certWrapper {
 					}
 
 					// determine the Secret Namespace
-					ref := listener.TLS.CertificateRefs[0]
+					ref := certificateRefs[0]
 					namespace := gateway.Namespace
 					if ref.Namespace != nil {
 						namespace = string(*ref.Namespace)
@@ -116,10 +123,16 @@ func (t *Translator) getGatewayCerts() []certWrapper {
 						continue
 					}
 
-					// determine the SNI
-					hostname := "*"
+					// determine the SNIs
+					snis := []string{"*"}
 					if listener.Hostname != nil {
-						hostname = string(*listener.Hostname)
+						snis = []string{string(*listener.Hostname)}
+					} else if listener.Protocol == gatewayapi.TLSProtocolType &&
+						lo.FromPtrOr(listener.TLS.Mode, gatewayapi.TLSModeTerminate) == gatewayapi.TLSModeTerminate {
+						// TLS listeners terminating TLSRoutes without a hostname of their own serve
+						// the certificate for the hostnames of the attached TLSRoutes too, on top of
+						// the catch-all SNI.
+						snis = append(snis, t.getTLSRouteHostnamesForListener(gateway, listener.Name)...)
 					}
 
 					// create a Kong certificate, wrap it in metadata, and add it to the certs slice
@@ -132,7 +145,7 @@ func (t *Translator) getGatewayCerts() []certWrapper {
 							Tags: util.GenerateTagsForObject(secret),
 						},
 						CreationTimestamp: secret.CreationTimestamp,
-						snis:              []string{hostname},
+						snis:              snis,
 					})
 				}
 			}
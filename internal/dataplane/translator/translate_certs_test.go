@@ -6,9 +6,15 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/builder"
 	"github.com/kong/kubernetes-ingress-controller/v3/test/helpers/certificate"
 )
 
@@ -142,3 +148,108 @@ func TestMergeCerts(t *testing.T) {
 		})
 	}
 }
+
+func TestGetGatewayCertsTLSRouteSNIs(t *testing.T) {
+	cert, key := certificate.MustGenerateSelfSignedCertPEMFormat()
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "cert",
+			UID:       "secret-uid",
+		},
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       cert,
+			corev1.TLSPrivateKeyKey: key,
+		},
+	}
+
+	gatewayWithListener := func(listener gatewayapi.Listener) *gatewayapi.Gateway {
+		return &gatewayapi.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "gateway-1",
+			},
+			Spec: gatewayapi.GatewaySpec{
+				Listeners: []gatewayapi.Listener{listener},
+			},
+			Status: gatewayapi.GatewayStatus{
+				Listeners: []gatewayapi.ListenerStatus{
+					{
+						Name: listener.Name,
+						Conditions: []metav1.Condition{
+							{
+								Type:   string(gatewayapi.ListenerConditionProgrammed),
+								Status: metav1.ConditionTrue,
+								Reason: string(gatewayapi.ListenerReasonProgrammed),
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	terminateTLSConfig := &gatewayapi.GatewayTLSConfig{
+		Mode:            lo.ToPtr(gatewayapi.TLSModeTerminate),
+		CertificateRefs: []gatewayapi.SecretObjectReference{{Name: "cert"}},
+	}
+	parentRef := gatewayapi.ParentReference{Name: "gateway-1"}
+	tlsRoute := &gatewayapi.TLSRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "tlsroute-1",
+		},
+		Spec: gatewayapi.TLSRouteSpec{
+			CommonRouteSpec: gatewayapi.CommonRouteSpec{
+				ParentRefs: []gatewayapi.ParentReference{parentRef},
+			},
+			Hostnames: []gatewayapi.Hostname{"foo.com", "*.bar.com"},
+		},
+		Status: gatewayapi.TLSRouteStatus{
+			RouteStatus: gatewayapi.RouteStatus{
+				Parents: []gatewayapi.RouteParentStatus{{ParentRef: parentRef}},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		listener     gatewayapi.Listener
+		tlsRoutes    []*gatewayapi.TLSRoute
+		expectedSNIs []string
+	}{
+		{
+			name:         "TLS listener without hostname uses catch-all SNI and hostnames of attached TLSRoutes",
+			listener:     builder.NewListener("tls").TLS().WithPort(443).WithTLSConfig(terminateTLSConfig).Build(),
+			tlsRoutes:    []*gatewayapi.TLSRoute{tlsRoute},
+			expectedSNIs: []string{"*", "*.bar.com", "foo.com"},
+		},
+		{
+			name:         "TLS listener without hostname and without attached TLSRoutes uses catch-all SNI",
+			listener:     builder.NewListener("tls").TLS().WithPort(443).WithTLSConfig(terminateTLSConfig).Build(),
+			expectedSNIs: []string{"*"},
+		},
+		{
+			name: "TLS listener with hostname uses its hostname",
+			listener: builder.NewListener("tls").TLS().WithPort(443).WithHostname("foo.com").
+				WithTLSConfig(terminateTLSConfig).Build(),
+			tlsRoutes:    []*gatewayapi.TLSRoute{tlsRoute},
+			expectedSNIs: []string{"foo.com"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakestore, err := store.NewFakeStore(store.FakeObjects{
+				Gateways:  []*gatewayapi.Gateway{gatewayWithListener(tc.listener)},
+				TLSRoutes: tc.tlsRoutes,
+				Secrets:   []*corev1.Secret{secret},
+			})
+			require.NoError(t, err)
+			translator := mustNewTranslator(t, fakestore)
+
+			certs := translator.getGatewayCerts()
+			require.Len(t, certs, 1)
+			require.Equal(t, tc.expectedSNIs, certs[0].snis)
+		})
+	}
+}
@@ -1,7 +1,13 @@
 package translator
 
 import (
+	"errors"
 	"fmt"
+	"strings"
+
+	"github.com/samber/lo"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator/subtranslator"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
@@ -22,6 +28,10 @@ func (t *Translator) ingressRulesFromGRPCRoutes() ingressRules {
 		return result
 	}
 
+	grpcRouteList = lo.FilterMap(grpcRouteList, func(grpcroute *gatewayapi.GRPCRoute, _ int) (*gatewayapi.GRPCRoute, bool) {
+		return t.enforceHostnamePoliciesOnGRPCRoute(grpcroute)
+	})
+
 	if t.featureFlags.ExpressionRoutes {
 		t.ingressRulesFromGRPCRoutesUsingExpressionRoutes(grpcRouteList, &result)
 		return result
@@ -29,7 +39,9 @@ func (t *Translator) ingressRulesFromGRPCRoutes() ingressRules {
 
 	var errs []error
 	for _, grpcRoute := range grpcRouteList {
-		if err := t.ingressRulesFromGRPCRoute(&result, grpcRoute); err != nil {
+		if err := t.translateWithCache(&result, grpcRoute, grpcRoute.Spec.ParentRefs, "", func(rules *ingressRules) error {
+			return t.ingressRulesFromGRPCRoute(rules, grpcRoute)
+		}); err != nil {
 			err = fmt.Errorf("GRPCRoute %s/%s can't be routed: %w", grpcRoute.Namespace, grpcRoute.Name, err)
 			errs = append(errs, err)
 		} else {
@@ -88,12 +100,30 @@ func (t *Translator) ingressRulesFromGRPCRoutesUsingExpressionRoutes(grpcRoutes
 	// assign priorities to split GRPCRoutes.
 	splitGRPCRouteMatchesWithPriorities := subtranslator.AssignRoutePriorityToSplitGRPCRouteMatches(t.logger, splitGRPCRouteMatches)
 	// generate Kong service and route from each split GRPC route with its assigned priority of Kong route.
-	for _, splitGRPCRouteMatchWithPriority := range splitGRPCRouteMatchesWithPriorities {
-		t.ingressRulesFromGRPCRouteWithPriority(result, splitGRPCRouteMatchWithPriority)
-	}
-
-	// register successful translation of GRPCRoutes.
+	// Split matches are translated per GRPCRoute, so results of GRPCRoutes whose priorities didn't change can be
+	// taken from the translation cache.
+	matchesByGRPCRoute := lo.GroupBy(splitGRPCRouteMatchesWithPriorities, func(m subtranslator.SplitGRPCRouteMatchToPriority) k8stypes.NamespacedName {
+		return client.ObjectKeyFromObject(m.Match.Source)
+	})
 	for _, grpcRoute := range translatedGRPCRoutes {
+		matches := matchesByGRPCRoute[client.ObjectKeyFromObject(grpcRoute)]
+		priorities := lo.Map(matches, func(m subtranslator.SplitGRPCRouteMatchToPriority, _ int) string {
+			return fmt.Sprintf("%d.%d.%s=%d", m.Match.RuleIndex, m.Match.MatchIndex, m.Match.Hostname, m.Priority)
+		})
+		err := t.translateWithCache(result, grpcRoute, grpcRoute.Spec.ParentRefs, strings.Join(priorities, ","), func(rules *ingressRules) error {
+			var errs []error
+			for _, match := range matches {
+				if err := t.ingressRulesFromGRPCRouteWithPriority(rules, match); err != nil {
+					errs = append(errs, err)
+				}
+			}
+			return errors.Join(errs...)
+		})
+		if err != nil {
+			t.registerTranslationFailure(fmt.Sprintf("GRPCRoute can't be routed: %s", err), grpcRoute)
+			continue
+		}
+		// register successful translation of the GRPCRoute.
 		t.registerSuccessfullyTranslatedObject(grpcRoute)
 	}
 }
@@ -101,7 +131,7 @@ func (t *Translator) ingressRulesFromGRPCRoutesUsingExpressionRoutes(grpcRoutes
 func (t *Translator) ingressRulesFromGRPCRouteWithPriority(
 	rules *ingressRules,
 	splitGRPCRouteMatchWithPriority subtranslator.SplitGRPCRouteMatchToPriority,
-) {
+) error {
 	match := splitGRPCRouteMatchWithPriority.Match
 	grpcRoute := splitGRPCRouteMatchWithPriority.Match.Source
 	// (very unlikely that) the rule index split from the source GRPCRoute is larger then length of original rules.
@@ -109,14 +139,14 @@ func (t *Translator) ingressRulesFromGRPCRouteWithPriority(
 		t.logger.Error(nil, "Split rule index is greater than the length of rules in source GRPCRoute",
 			"rule_index", match.RuleIndex,
 			"rule_count", len(grpcRoute.Spec.Rules))
-		return
+		return nil
 	}
 	grpcRouteRule := grpcRoute.Spec.Rules[match.RuleIndex]
 
 	serviceName := subtranslator.KongServiceNameFromSplitGRPCRouteMatch(match)
 
 	// Create a service and attach the routes to it.
-	kongService, _ := generateKongServiceFromBackendRefWithName(
+	kongService, err := generateKongServiceFromBackendRefWithName(
 		t.logger,
 		t.storer,
 		rules,
@@ -125,13 +155,17 @@ func (t *Translator) ingressRulesFromGRPCRouteWithPriority(
 		t.getProtocolForKongService(grpcRoute),
 		grpcBackendRefsToBackendRefs(grpcRouteRule.BackendRefs)...,
 	)
+	if err != nil {
+		return err
+	}
 	kongService.Routes = append(
 		kongService.Routes,
 		subtranslator.KongExpressionRouteFromSplitGRPCRouteMatchWithPriority(splitGRPCRouteMatchWithPriority),
 	)
 	// cache the service to avoid duplicates in further loop iterations
-	rules.ServiceNameToServices[serviceName] = kongService
-	rules.ServiceNameToParent[serviceName] = grpcRoute
+	rules.ServiceNameToServices[*kongService.Service.Name] = kongService
+	rules.ServiceNameToParent[*kongService.Service.Name] = kongService.Parent
+	return nil
 }
 
 func grpcBackendRefsToBackendRefs(grpcBackendRef []gatewayapi.GRPCBackendRef) []gatewayapi.BackendRef {
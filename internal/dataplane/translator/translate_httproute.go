@@ -3,13 +3,16 @@ package translator
 import (
 	"errors"
 	"fmt"
+	"math"
 	"strings"
 	"time"
 
 	"github.com/kong/go-kong/kong"
 	"github.com/samber/lo"
 	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator/subtranslator"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
@@ -38,6 +41,11 @@ func (t *Translator) ingressRulesFromHTTPRoutes() ingressRules {
 			t.registerTranslationFailure(fmt.Sprintf("HTTPRoute can't be routed: %v", err), httproute)
 			continue
 		}
+		httproute, ok := t.enforceHostnamePoliciesOnHTTPRoute(httproute)
+		if !ok {
+			continue
+		}
+		t.registerHTTPRouteTimeoutsAndRetryConflicts(httproute)
 		httpRoutesToTranslate = append(httpRoutesToTranslate, httproute)
 	}
 
@@ -47,7 +55,9 @@ func (t *Translator) ingressRulesFromHTTPRoutes() ingressRules {
 	}
 
 	for _, httproute := range httpRoutesToTranslate {
-		if err := t.ingressRulesFromHTTPRoute(&result, httproute); err != nil {
+		if err := t.translateWithCache(&result, httproute, httproute.Spec.ParentRefs, "", func(rules *ingressRules) error {
+			return t.ingressRulesFromHTTPRoute(rules, httproute)
+		}); err != nil {
 			t.registerTranslationFailure(fmt.Sprintf("HTTPRoute can't be routed: %s", err), httproute)
 		} else {
 			// at this point the object has been configured and can be
@@ -73,6 +83,10 @@ func (t *Translator) ingressRulesFromHTTPRoute(result *ingressRules, httproute *
 		if err != nil {
 			return err
 		}
+		service = ruleServiceForDedicatedBackend(service, serviceName, kongServiceTranslation.Timeouts, kongServiceTranslation.Retry)
+		if err := applyTimeoutsAndRetryToService(&service, kongServiceTranslation.Timeouts, kongServiceTranslation.Retry); err != nil {
+			return err
+		}
 
 		// generate the routes for the service and attach them to the service
 		for _, kongRouteTranslation := range kongServiceTranslation.KongRoutes {
@@ -85,51 +99,150 @@ func (t *Translator) ingressRulesFromHTTPRoute(result *ingressRules, httproute *
 
 		// cache the service to avoid duplicates in further loop iterations
 		result.ServiceNameToServices[*service.Service.Name] = service
-		result.ServiceNameToParent[serviceName] = httproute
+		result.ServiceNameToParent[*service.Service.Name] = service.Parent
 	}
-	applyTimeoutsToService(httproute, result)
 	return nil
 }
 
-// applyTimeoutsToService applies timeouts from HTTPRoute to the service.
-// If the HTTPRoute has multiple rules, the timeout from the last rule which has specific timeout will be applied to the service.
-// If the HTTPRoute has multiple rules and the first rule doesn't have timeout, the default timeout will be applied to the service.
-func applyTimeoutsToService(httpRoute *gatewayapi.HTTPRoute, rules *ingressRules) {
-	// If the HTTPRoute doesn't have rules, we don't need to apply timeouts to the service.
-	if httpRoute.Spec.Rules == nil {
-		return
-	}
-
-	backendRequestTimeout := DefaultServiceTimeout
-	for _, rule := range httpRoute.Spec.Rules {
-		if rule.Timeouts != nil && rule.Timeouts.BackendRequest != nil {
-			duration, err := time.ParseDuration(string(*rule.Timeouts.BackendRequest))
-			// We ignore the error here because the rule.Timeouts.BackendRequest is validated
-			// to be a strict subset of Golang time.ParseDuration so it should never happen
-			if err != nil {
-				continue
-			}
-			backendRequestTimeout = int(duration.Milliseconds())
+// maxKongTimeout is the maximum timeout (in milliseconds) accepted by Kong services.
+// It is used when an HTTPRoute rule disables a timeout by setting it to zero.
+const maxKongTimeout = math.MaxInt32 - 1
+
+// maxKongRetries is the maximum number of retries accepted by Kong services.
+const maxKongRetries = math.MaxInt16
+
+// ruleServiceForDedicatedBackend returns the service routes of an HTTPRoute rule with the given timeouts and
+// retry policy are attached to. Services of KongServiceFacades and KongExternalBackends are shared by all the
+// routes using them, so for rules setting timeouts or a retry policy it returns a copy of the shared service
+// named after the rule. The copy keeps the shared service's host, so it uses the same upstream, but its
+// settings never affect routes of other rules, HTTPRoutes or Ingresses.
+func ruleServiceForDedicatedBackend(
+	service kongstate.Service,
+	ruleServiceName string,
+	timeouts *gatewayapi.HTTPRouteTimeouts,
+	retry *gatewayapi.HTTPRouteRetry,
+) kongstate.Service {
+	if !isDedicatedBackendService(service) || (timeouts == nil && retry == nil) {
+		return service
+	}
+	ruleService := service.DeepCopy()
+	ruleService.Name = kong.String(fmt.Sprintf("%s.%s", ruleServiceName, *service.Name))
+	ruleService.Routes = nil
+	return ruleService
+}
+
+// applyTimeoutsAndRetryToService applies timeouts and the retry policy of an HTTPRoute rule to the service
+// the rule is translated to. Rules with different timeouts or retry policies are translated to separate
+// services (see ruleServiceForDedicatedBackend for services shared with other objects), so the settings
+// of one rule never affect services of other rules.
+func applyTimeoutsAndRetryToService(
+	service *kongstate.Service,
+	timeouts *gatewayapi.HTTPRouteTimeouts,
+	retry *gatewayapi.HTTPRouteRetry,
+) error {
+	if retry != nil && retry.Attempts != nil {
+		if *retry.Attempts < 0 || *retry.Attempts > maxKongRetries {
+			return fmt.Errorf("invalid retry attempts %d: must be between 0 and %d", *retry.Attempts, maxKongRetries)
 		}
+		service.Service.Retries = kong.Int(*retry.Attempts)
+	}
+	return applyTimeoutsToService(service, timeouts)
+}
+
+// applyTimeoutsToService applies timeouts of an HTTPRoute rule to the service the rule is translated to.
+// The BackendRequest timeout is used when set. Otherwise, the Request timeout is used as Kong does not
+// have a notion of a timeout for the whole request, and the backend request cannot take longer than it.
+func applyTimeoutsToService(service *kongstate.Service, timeouts *gatewayapi.HTTPRouteTimeouts) error {
+	if timeouts == nil {
+		return nil
 	}
 
-	// if the backendRequestTimeout is the same as the default timeout, we don't need to apply it to the service.
-	if backendRequestTimeout == DefaultServiceTimeout {
-		return
+	timeout := timeouts.BackendRequest
+	if timeout == nil {
+		timeout = timeouts.Request
+	}
+	if timeout == nil {
+		return nil
+	}
+
+	duration, err := time.ParseDuration(string(*timeout))
+	// The timeouts are validated to be a strict subset of Golang time.ParseDuration
+	// so it should never happen, unless the validation is bypassed.
+	if err != nil {
+		return fmt.Errorf("invalid timeout %q: %w", *timeout, err)
+	}
+	backendRequestTimeout := int(duration.Milliseconds())
+	// Zero disables the timeout as per Gateway API specification.
+	if backendRequestTimeout == 0 || duration.Milliseconds() > maxKongTimeout {
+		backendRequestTimeout = maxKongTimeout
 	}
 
-	// due rules.ServiceNameToServices is a map, we need to iterate over the map to find the service
-	// which has the same parent as the HTTPRoute.
-	for serviceName, service := range rules.ServiceNameToServices {
-		if service.Parent.GetObjectKind() == httpRoute.GetObjectKind() && service.Parent.GetName() == httpRoute.Name && service.Parent.GetNamespace() == httpRoute.Namespace {
-			// Due to only one field being available in the Gateway API to control this behavior,
-			// when users set `spec.rules[].timeouts` in HTTPRoute,
-			// KIC will also set ReadTimeout, WriteTimeout and ConnectTimeout for the service to this value
-			// https://github.com/Kong/kubernetes-ingress-controller/issues/4914#issuecomment-1813964669
-			service.Service.ReadTimeout = kong.Int(backendRequestTimeout)
-			service.Service.ConnectTimeout = kong.Int(backendRequestTimeout)
-			service.Service.WriteTimeout = kong.Int(backendRequestTimeout)
-			rules.ServiceNameToServices[serviceName] = service
+	// Due to only one field being available in the Gateway API to control this behavior,
+	// when users set `spec.rules[].timeouts` in HTTPRoute,
+	// KIC will also set ReadTimeout, WriteTimeout and ConnectTimeout for the service to this value
+	// https://github.com/Kong/kubernetes-ingress-controller/issues/4914#issuecomment-1813964669
+	service.Service.ReadTimeout = kong.Int(backendRequestTimeout)
+	service.Service.ConnectTimeout = kong.Int(backendRequestTimeout)
+	service.Service.WriteTimeout = kong.Int(backendRequestTimeout)
+	return nil
+}
+
+// registerHTTPRouteTimeoutsAndRetryConflicts registers translation failures for the HTTPRoute rules which
+// specify timeouts or retry attempts that are going to be overridden by annotations of their backend Services,
+// and for the rules which use retry policy fields Kong doesn't support.
+func (t *Translator) registerHTTPRouteTimeoutsAndRetryConflicts(httproute *gatewayapi.HTTPRoute) {
+	timeoutAnnotations := []string{
+		annotations.AnnotationPrefix + annotations.ConnectTimeoutKey,
+		annotations.AnnotationPrefix + annotations.ReadTimeoutKey,
+		annotations.AnnotationPrefix + annotations.WriteTimeoutKey,
+	}
+
+	for ruleNumber, rule := range httproute.Spec.Rules {
+		if rule.Retry != nil && (len(rule.Retry.Codes) > 0 || rule.Retry.Backoff != nil) {
+			t.registerTranslationFailure(
+				fmt.Sprintf("retry codes and backoff of HTTPRoute rule %d are not supported, Kong retries only on connection errors and timeouts",
+					ruleNumber),
+				httproute,
+			)
+		}
+
+		hasTimeouts := rule.Timeouts != nil && (rule.Timeouts.BackendRequest != nil || rule.Timeouts.Request != nil)
+		hasRetryAttempts := rule.Retry != nil && rule.Retry.Attempts != nil
+		if !hasTimeouts && !hasRetryAttempts {
+			continue
+		}
+		for _, backendRef := range rule.BackendRefs {
+			if backendRef.Kind != nil && *backendRef.Kind != "Service" {
+				continue
+			}
+			namespace := httproute.Namespace
+			if backendRef.Namespace != nil {
+				namespace = string(*backendRef.Namespace)
+			}
+			service, err := t.storer.GetService(namespace, string(backendRef.Name))
+			if err != nil {
+				continue
+			}
+			if hasTimeouts {
+				conflicting := lo.Filter(timeoutAnnotations, func(key string, _ int) bool {
+					_, ok := service.Annotations[key]
+					return ok
+				})
+				if len(conflicting) > 0 {
+					t.registerTranslationFailure(
+						fmt.Sprintf("timeouts of HTTPRoute rule %d conflict with %s annotations of Service %s/%s, the annotations take precedence",
+							ruleNumber, strings.Join(conflicting, ", "), service.Namespace, service.Name),
+						httproute,
+					)
+				}
+			}
+			if _, ok := service.Annotations[annotations.AnnotationPrefix+annotations.RetriesKey]; hasRetryAttempts && ok {
+				t.registerTranslationFailure(
+					fmt.Sprintf("retry attempts of HTTPRoute rule %d conflict with %s annotation of Service %s/%s, the annotation takes precedence",
+						ruleNumber, annotations.AnnotationPrefix+annotations.RetriesKey, service.Namespace, service.Name),
+					httproute,
+				)
+			}
 		}
 	}
 }
@@ -173,33 +286,31 @@ func (t *Translator) ingressRulesFromHTTPRoutesUsingExpressionRoutes(httpRoutes
 	}
 	// assign priorities to split HTTPRoutes.
 	splitHTTPRoutesWithPriorities := subtranslator.AssignRoutePriorityToSplitHTTPRouteMatches(t.logger, splitHTTPRouteMatches)
-	httpRouteNameToTranslationFailure := map[k8stypes.NamespacedName][]error{}
+
+	// Because one HTTPRoute may be split into multiple HTTPRoutes, group the split matches by their source HTTPRoute,
+	// so each HTTPRoute is translated (or taken from the translation cache when its priorities didn't change) at once.
+	matchesByHTTPRoute := lo.GroupBy(splitHTTPRoutesWithPriorities, func(m subtranslator.SplitHTTPRouteMatchToKongRoutePriority) k8stypes.NamespacedName {
+		return client.ObjectKeyFromObject(m.Match.Source)
+	})
 
 	// translate split HTTPRoute matches to ingress rules, including services, routes, upstreams.
-	for _, httpRouteWithPriority := range splitHTTPRoutesWithPriorities {
-		err := t.ingressRulesFromSplitHTTPRouteMatchWithPriority(result, httpRouteWithPriority)
-		if err != nil {
-			nsName := k8stypes.NamespacedName{
-				Namespace: httpRouteWithPriority.Match.Source.Namespace,
-				Name:      httpRouteWithPriority.Match.Source.Name,
-			}
-			httpRouteNameToTranslationFailure[nsName] = append(httpRouteNameToTranslationFailure[nsName], err)
-		}
-	}
-	// Register successful translated objects and translation failures.
-	// Because one HTTPRoute may be split into multiple HTTPRoutes, we need to de-duplicate by namespace and name.
 	for _, httproute := range httpRoutes {
-		nsName := k8stypes.NamespacedName{
-			Namespace: httproute.Namespace,
-			Name:      httproute.Name,
-		}
-		if translationFailures, ok := httpRouteNameToTranslationFailure[nsName]; !ok {
-			applyTimeoutsToService(httproute, result)
-		} else {
-			t.registerTranslationFailure(
-				fmt.Sprintf("HTTPRoute can't be routed: %v", errors.Join(translationFailures...)),
-				httproute,
-			)
+		matches := matchesByHTTPRoute[client.ObjectKeyFromObject(httproute)]
+		priorities := lo.Map(matches, func(m subtranslator.SplitHTTPRouteMatchToKongRoutePriority, _ int) string {
+			return fmt.Sprintf("%d.%d.%s=%d", m.Match.RuleIndex, m.Match.MatchIndex, m.Match.Hostname, m.Priority)
+		})
+		err := t.translateWithCache(result, httproute, httproute.Spec.ParentRefs, strings.Join(priorities, ","), func(rules *ingressRules) error {
+			var translationFailures []error
+			for _, httpRouteWithPriority := range matches {
+				if err := t.ingressRulesFromSplitHTTPRouteMatchWithPriority(rules, httpRouteWithPriority); err != nil {
+					translationFailures = append(translationFailures, err)
+				}
+			}
+			return errors.Join(translationFailures...)
+		})
+		// Register successful translated objects and translation failures.
+		if err != nil {
+			t.registerTranslationFailure(fmt.Sprintf("HTTPRoute can't be routed: %v", err), httproute)
 			continue
 		}
 		t.registerSuccessfullyTranslatedObject(httproute)
@@ -497,6 +608,10 @@ func (t *Translator) ingressRulesFromSplitHTTPRouteMatchWithPriority(
 	if err != nil {
 		return err
 	}
+	kongService = ruleServiceForDedicatedBackend(kongService, serviceName, rule.Timeouts, rule.Retry)
+	if err := applyTimeoutsAndRetryToService(&kongService, rule.Timeouts, rule.Retry); err != nil {
+		return err
+	}
 
 	additionalRoutes, err := subtranslator.KongExpressionRouteFromHTTPRouteMatchWithPriority(httpRouteMatchWithPriority)
 	if err != nil {
@@ -508,7 +623,7 @@ func (t *Translator) ingressRulesFromSplitHTTPRouteMatchWithPriority(
 		*additionalRoutes,
 	)
 	// cache the service to avoid duplicates in further loop iterations
-	rules.ServiceNameToServices[serviceName] = kongService
-	rules.ServiceNameToParent[serviceName] = httpRoute
+	rules.ServiceNameToServices[*kongService.Service.Name] = kongService
+	rules.ServiceNameToParent[*kongService.Service.Name] = kongService.Parent
 	return nil
 }
@@ -1,6 +1,9 @@
 package translator
 
 import (
+	"context"
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/go-logr/zapr"
@@ -11,11 +14,13 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/failures"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator/subtranslator"
@@ -23,6 +28,10 @@ import (
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/builder"
+	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
+	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
+	kongv1beta1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1beta1"
+	incubatorv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/incubator/v1alpha1"
 )
 
 // httprouteGVK is the GVK for HTTPRoutes, needed in unit tests because
@@ -2544,3 +2553,744 @@ func commonRouteSpecMock(parentReferentName string) gatewayapi.CommonRouteSpec {
 		}},
 	}
 }
+
+func TestIngressRulesFromHTTPRoutesWithPerRuleTimeouts(t *testing.T) {
+	timeouts := func(backendRequest, request string) *gatewayapi.HTTPRouteTimeouts {
+		t := &gatewayapi.HTTPRouteTimeouts{}
+		if backendRequest != "" {
+			t.BackendRequest = lo.ToPtr(gatewayapi.Duration(backendRequest))
+		}
+		if request != "" {
+			t.Request = lo.ToPtr(gatewayapi.Duration(request))
+		}
+		return t
+	}
+	httpRouteWithRules := func(rules ...gatewayapi.HTTPRouteRule) *gatewayapi.HTTPRoute {
+		route := &gatewayapi.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "httproute-1",
+				Namespace: corev1.NamespaceDefault,
+			},
+			Spec: gatewayapi.HTTPRouteSpec{
+				Rules: rules,
+			},
+		}
+		route.SetGroupVersionKind(httprouteGVK)
+		return route
+	}
+	ruleWithTimeouts := func(path string, timeouts *gatewayapi.HTTPRouteTimeouts) gatewayapi.HTTPRouteRule {
+		return gatewayapi.HTTPRouteRule{
+			Matches: []gatewayapi.HTTPRouteMatch{
+				builder.NewHTTPRouteMatch().WithPathExact(path).Build(),
+			},
+			BackendRefs: []gatewayapi.HTTPBackendRef{
+				builder.NewHTTPBackendRef("service1").WithPort(80).Build(),
+			},
+			Timeouts: timeouts,
+		}
+	}
+	service := func(annotations map[string]string) *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   corev1.NamespaceDefault,
+				Name:        "service1",
+				Annotations: annotations,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name string
+		// expectedTimeouts maps the expected Kong service names (in traditional router flavor) to their
+		// connect/read/write timeouts. Services generated by the expressions router are named with an
+		// additional hostname segment ("_" when no hostnames are specified).
+		expectedTimeouts map[string]int
+		// expectedTimeoutsExpressionRoutes overrides expectedTimeouts for the expressions router, which
+		// translates every rule to a separate service.
+		expectedTimeoutsExpressionRoutes map[string]int
+		httpRoute                        *gatewayapi.HTTPRoute
+		service                          *corev1.Service
+		expectedFailure                  string
+	}{
+		{
+			name: "rules sharing a backend with different timeouts are translated to separate services",
+			httpRoute: httpRouteWithRules(
+				ruleWithTimeouts("/fast", timeouts("500ms", "")),
+				ruleWithTimeouts("/slow", timeouts("10s", "")),
+				ruleWithTimeouts("/default", nil),
+			),
+			service: service(nil),
+			expectedTimeouts: map[string]int{
+				"httproute.default.httproute-1.0": 500,
+				"httproute.default.httproute-1.1": 10000,
+				"httproute.default.httproute-1.2": DefaultServiceTimeout,
+			},
+		},
+		{
+			name: "rules sharing a backend with the same timeouts are translated to a single service",
+			httpRoute: httpRouteWithRules(
+				ruleWithTimeouts("/a", timeouts("500ms", "")),
+				ruleWithTimeouts("/b", timeouts("500ms", "")),
+			),
+			service: service(nil),
+			expectedTimeouts: map[string]int{
+				"httproute.default.httproute-1.0": 500,
+			},
+			expectedTimeoutsExpressionRoutes: map[string]int{
+				"httproute.default.httproute-1.0": 500,
+				"httproute.default.httproute-1.1": 500,
+			},
+		},
+		{
+			name: "request timeout is used when backend request timeout is not set",
+			httpRoute: httpRouteWithRules(
+				ruleWithTimeouts("/request", timeouts("", "2s")),
+				ruleWithTimeouts("/both", timeouts("1s", "2s")),
+			),
+			service: service(nil),
+			expectedTimeouts: map[string]int{
+				"httproute.default.httproute-1.0": 2000,
+				"httproute.default.httproute-1.1": 1000,
+			},
+		},
+		{
+			name: "zero timeout disables the timeout",
+			httpRoute: httpRouteWithRules(
+				ruleWithTimeouts("/no-timeout", timeouts("0s", "")),
+			),
+			service: service(nil),
+			expectedTimeouts: map[string]int{
+				"httproute.default.httproute-1.0": maxKongTimeout,
+			},
+		},
+		{
+			name: "timeouts conflicting with Service annotations are reported",
+			httpRoute: httpRouteWithRules(
+				ruleWithTimeouts("/fast", timeouts("500ms", "")),
+			),
+			service: service(map[string]string{
+				"konghq.com/read-timeout": "1000",
+			}),
+			expectedTimeouts: map[string]int{
+				"httproute.default.httproute-1.0": 500,
+			},
+			expectedFailure: "timeouts of HTTPRoute rule 0 conflict with konghq.com/read-timeout annotations of Service default/service1",
+		},
+	}
+
+	for _, tc := range testCases {
+		for _, expressionRoutes := range []bool{false, true} {
+			t.Run(fmt.Sprintf("%s, expression routes: %v", tc.name, expressionRoutes), func(t *testing.T) {
+				fakestore, err := store.NewFakeStore(store.FakeObjects{
+					HTTPRoutes: []*gatewayapi.HTTPRoute{tc.httpRoute},
+					Services:   []*corev1.Service{tc.service},
+				})
+				require.NoError(t, err)
+				translator := mustNewTranslator(t, fakestore)
+				translator.featureFlags.ExpressionRoutes = expressionRoutes
+				failuresCollector := failures.NewResourceFailuresCollector(zapr.NewLogger(zap.NewNop()))
+				translator.failuresCollector = failuresCollector
+
+				expectedTimeouts := tc.expectedTimeouts
+				if expressionRoutes && tc.expectedTimeoutsExpressionRoutes != nil {
+					expectedTimeouts = tc.expectedTimeoutsExpressionRoutes
+				}
+
+				result := translator.ingressRulesFromHTTPRoutes()
+				require.Len(t, result.ServiceNameToServices, len(expectedTimeouts))
+				for serviceName, expectedTimeout := range expectedTimeouts {
+					if expressionRoutes {
+						serviceName = strings.Replace(serviceName, "httproute-1.", "httproute-1._.", 1)
+					}
+					service, ok := result.ServiceNameToServices[serviceName]
+					require.Truef(t, ok, "should find service %s", serviceName)
+					require.Equal(t, expectedTimeout, *service.ConnectTimeout)
+					require.Equal(t, expectedTimeout, *service.ReadTimeout)
+					require.Equal(t, expectedTimeout, *service.WriteTimeout)
+				}
+
+				translationFailures := failuresCollector.PopResourceFailures()
+				if tc.expectedFailure == "" {
+					require.Empty(t, translationFailures)
+					return
+				}
+				require.Len(t, translationFailures, 1)
+				require.Contains(t, translationFailures[0].Message(), tc.expectedFailure)
+			})
+		}
+	}
+}
+
+func TestIngressRulesFromHTTPRoutesWithRetry(t *testing.T) {
+	httpRoute := func(rules ...gatewayapi.HTTPRouteRule) *gatewayapi.HTTPRoute {
+		route := &gatewayapi.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "httproute-1",
+				Namespace: corev1.NamespaceDefault,
+			},
+			Spec: gatewayapi.HTTPRouteSpec{
+				Rules: rules,
+			},
+		}
+		route.SetGroupVersionKind(httprouteGVK)
+		return route
+	}
+	ruleWithRetry := func(path string, retry *gatewayapi.HTTPRouteRetry) gatewayapi.HTTPRouteRule {
+		return gatewayapi.HTTPRouteRule{
+			Matches: []gatewayapi.HTTPRouteMatch{
+				builder.NewHTTPRouteMatch().WithPathExact(path).Build(),
+			},
+			BackendRefs: []gatewayapi.HTTPBackendRef{
+				builder.NewHTTPBackendRef("service1").WithPort(80).Build(),
+			},
+			Retry: retry,
+		}
+	}
+	service := func(annotations map[string]string) *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   corev1.NamespaceDefault,
+				Name:        "service1",
+				Annotations: annotations,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		httpRoute *gatewayapi.HTTPRoute
+		service   *corev1.Service
+		// expectedRetries maps the expected Kong service names (in traditional router flavor) to their retries.
+		expectedRetries map[string]int
+		expectedFailure string
+	}{
+		{
+			name: "rules sharing a backend with different retry attempts are translated to separate services",
+			httpRoute: httpRoute(
+				ruleWithRetry("/once", &gatewayapi.HTTPRouteRetry{Attempts: lo.ToPtr(1)}),
+				ruleWithRetry("/never", &gatewayapi.HTTPRouteRetry{Attempts: lo.ToPtr(0)}),
+				ruleWithRetry("/default", nil),
+			),
+			service: service(nil),
+			expectedRetries: map[string]int{
+				"httproute.default.httproute-1.0": 1,
+				"httproute.default.httproute-1.1": 0,
+				"httproute.default.httproute-1.2": DefaultRetries,
+			},
+		},
+		{
+			name: "retry codes and backoff are reported as not supported",
+			httpRoute: httpRoute(
+				ruleWithRetry("/codes", &gatewayapi.HTTPRouteRetry{
+					Attempts: lo.ToPtr(2),
+					Codes:    []gatewayapi.HTTPRouteRetryStatusCode{503},
+					Backoff:  lo.ToPtr(gatewayapi.Duration("100ms")),
+				}),
+			),
+			service: service(nil),
+			expectedRetries: map[string]int{
+				"httproute.default.httproute-1.0": 2,
+			},
+			expectedFailure: "retry codes and backoff of HTTPRoute rule 0 are not supported",
+		},
+		{
+			name: "retry attempts conflicting with Service annotation are reported",
+			httpRoute: httpRoute(
+				ruleWithRetry("/once", &gatewayapi.HTTPRouteRetry{Attempts: lo.ToPtr(1)}),
+			),
+			service: service(map[string]string{
+				"konghq.com/retries": "3",
+			}),
+			expectedRetries: map[string]int{
+				"httproute.default.httproute-1.0": 1,
+			},
+			expectedFailure: "retry attempts of HTTPRoute rule 0 conflict with konghq.com/retries annotation of Service default/service1",
+		},
+	}
+
+	for _, tc := range testCases {
+		for _, expressionRoutes := range []bool{false, true} {
+			t.Run(fmt.Sprintf("%s, expression routes: %v", tc.name, expressionRoutes), func(t *testing.T) {
+				fakestore, err := store.NewFakeStore(store.FakeObjects{
+					HTTPRoutes: []*gatewayapi.HTTPRoute{tc.httpRoute},
+					Services:   []*corev1.Service{tc.service},
+				})
+				require.NoError(t, err)
+				translator := mustNewTranslator(t, fakestore)
+				translator.featureFlags.ExpressionRoutes = expressionRoutes
+				failuresCollector := failures.NewResourceFailuresCollector(zapr.NewLogger(zap.NewNop()))
+				translator.failuresCollector = failuresCollector
+
+				result := translator.ingressRulesFromHTTPRoutes()
+				require.Len(t, result.ServiceNameToServices, len(tc.expectedRetries))
+				for serviceName, expectedRetries := range tc.expectedRetries {
+					if expressionRoutes {
+						serviceName = strings.Replace(serviceName, "httproute-1.", "httproute-1._.", 1)
+					}
+					service, ok := result.ServiceNameToServices[serviceName]
+					require.Truef(t, ok, "should find service %s", serviceName)
+					require.Equal(t, expectedRetries, *service.Retries)
+				}
+
+				translationFailures := failuresCollector.PopResourceFailures()
+				if tc.expectedFailure == "" {
+					require.Empty(t, translationFailures)
+					return
+				}
+				require.Len(t, translationFailures, 1)
+				require.Contains(t, translationFailures[0].Message(), tc.expectedFailure)
+			})
+		}
+	}
+}
+
+func TestIngressRulesFromHTTPRoutesWithTimeoutsOnSharedBackend(t *testing.T) {
+	facadeBackendRef := builder.NewHTTPBackendRef("facade-1").
+		WithGroup(incubatorv1alpha1.GroupVersion.Group).
+		WithKind(incubatorv1alpha1.KongServiceFacadeKind).
+		Build()
+	httpRoute := func(name string, timeout string) *gatewayapi.HTTPRoute {
+		rule := gatewayapi.HTTPRouteRule{
+			Matches: []gatewayapi.HTTPRouteMatch{
+				builder.NewHTTPRouteMatch().WithPathExact("/" + name).Build(),
+			},
+			BackendRefs: []gatewayapi.HTTPBackendRef{facadeBackendRef},
+		}
+		if timeout != "" {
+			rule.Timeouts = &gatewayapi.HTTPRouteTimeouts{BackendRequest: lo.ToPtr(gatewayapi.Duration(timeout))}
+		}
+		route := &gatewayapi.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: corev1.NamespaceDefault,
+			},
+			Spec: gatewayapi.HTTPRouteSpec{
+				Rules: []gatewayapi.HTTPRouteRule{rule},
+			},
+		}
+		route.SetGroupVersionKind(httprouteGVK)
+		return route
+	}
+	facade := &incubatorv1alpha1.KongServiceFacade{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       incubatorv1alpha1.KongServiceFacadeKind,
+			APIVersion: incubatorv1alpha1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "facade-1",
+			Namespace: corev1.NamespaceDefault,
+		},
+		Spec: incubatorv1alpha1.KongServiceFacadeSpec{
+			Backend: incubatorv1alpha1.KongServiceFacadeBackend{
+				Name: "service1",
+				Port: 80,
+			},
+		},
+	}
+
+	for _, expressionRoutes := range []bool{false, true} {
+		t.Run(fmt.Sprintf("expression routes: %v", expressionRoutes), func(t *testing.T) {
+			fakestore, err := store.NewFakeStore(store.FakeObjects{
+				HTTPRoutes: []*gatewayapi.HTTPRoute{
+					httpRoute("fast", "500ms"),
+					httpRoute("slow", "10s"),
+					httpRoute("default", ""),
+				},
+				Services: []*corev1.Service{{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: corev1.NamespaceDefault,
+						Name:      "service1",
+					},
+				}},
+				KongServiceFacades: []*incubatorv1alpha1.KongServiceFacade{facade},
+			})
+			require.NoError(t, err)
+			translator := mustNewTranslator(t, fakestore)
+			translator.featureFlags.ExpressionRoutes = expressionRoutes
+			failuresCollector := failures.NewResourceFailuresCollector(zapr.NewLogger(zap.NewNop()))
+			translator.failuresCollector = failuresCollector
+
+			result := translator.ingressRulesFromHTTPRoutes()
+			require.Empty(t, failuresCollector.PopResourceFailures())
+
+			ruleServiceName := func(route string) string {
+				if expressionRoutes {
+					return fmt.Sprintf("httproute.default.%s._.0.default.facade-1.svc.facade", route)
+				}
+				return fmt.Sprintf("httproute.default.%s.0.default.facade-1.svc.facade", route)
+			}
+			expectedTimeouts := map[string]int{
+				// The route without timeouts uses the service shared with other objects.
+				"default.facade-1.svc.facade": DefaultServiceTimeout,
+				ruleServiceName("fast"):       500,
+				ruleServiceName("slow"):       10000,
+			}
+			require.Len(t, result.ServiceNameToServices, len(expectedTimeouts))
+			for serviceName, expectedTimeout := range expectedTimeouts {
+				service, ok := result.ServiceNameToServices[serviceName]
+				require.Truef(t, ok, "should find service %s", serviceName)
+				require.Equal(t, expectedTimeout, *service.ReadTimeout)
+				require.Len(t, service.Routes, 1)
+				// All the services use the same upstream.
+				require.Equal(t, "default.facade-1.svc.facade", *service.Host)
+				require.Equal(t, facade, result.ServiceNameToParent[serviceName])
+			}
+		})
+	}
+}
+
+func TestIngressRulesFromHTTPRoutesWithKongServiceFacade(t *testing.T) {
+	facadeBackendRef := builder.NewHTTPBackendRef("facade-1").
+		WithGroup(incubatorv1alpha1.GroupVersion.Group).
+		WithKind(incubatorv1alpha1.KongServiceFacadeKind).
+		Build()
+	httpRouteWithRules := func(rules ...gatewayapi.HTTPRouteRule) *gatewayapi.HTTPRoute {
+		route := &gatewayapi.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "httproute-1",
+				Namespace: corev1.NamespaceDefault,
+			},
+			Spec: gatewayapi.HTTPRouteSpec{
+				Rules: rules,
+			},
+		}
+		route.SetGroupVersionKind(httprouteGVK)
+		return route
+	}
+	ruleWithBackendRefs := func(path string, backendRefs ...gatewayapi.HTTPBackendRef) gatewayapi.HTTPRouteRule {
+		return gatewayapi.HTTPRouteRule{
+			Matches: []gatewayapi.HTTPRouteMatch{
+				builder.NewHTTPRouteMatch().WithPathExact(path).Build(),
+			},
+			BackendRefs: backendRefs,
+		}
+	}
+	facade := &incubatorv1alpha1.KongServiceFacade{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       incubatorv1alpha1.KongServiceFacadeKind,
+			APIVersion: incubatorv1alpha1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "facade-1",
+			Namespace: corev1.NamespaceDefault,
+		},
+		Spec: incubatorv1alpha1.KongServiceFacadeSpec{
+			Backend: incubatorv1alpha1.KongServiceFacadeBackend{
+				Name: "service1",
+				Port: 80,
+			},
+		},
+	}
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: corev1.NamespaceDefault,
+			Name:      "service1",
+		},
+	}
+
+	testCases := []struct {
+		name                  string
+		httpRoute             *gatewayapi.HTTPRoute
+		expectedServicesCount int
+		expectedFacadeRoutes  int
+		// expectedFacadeRoutesExpressionRoutes overrides expectedFacadeRoutes for the expressions router, which
+		// translates every match to a separate route.
+		expectedFacadeRoutesExpressionRoutes int
+		expectedFailure                      string
+	}{
+		{
+			name: "rules using the same KongServiceFacade are translated to a single dedicated service",
+			httpRoute: httpRouteWithRules(
+				ruleWithBackendRefs("/facade-a", facadeBackendRef),
+				ruleWithBackendRefs("/facade-b", facadeBackendRef),
+				ruleWithBackendRefs("/service", builder.NewHTTPBackendRef("service1").WithPort(80).Build()),
+			),
+			expectedServicesCount:                2,
+			expectedFacadeRoutes:                 1,
+			expectedFacadeRoutesExpressionRoutes: 2,
+		},
+		{
+			name: "KongServiceFacade mixed with other backendRefs in a rule is rejected",
+			httpRoute: httpRouteWithRules(
+				ruleWithBackendRefs("/mixed", facadeBackendRef, builder.NewHTTPBackendRef("service1").WithPort(80).Build()),
+			),
+			expectedFailure: "KongServiceFacade default/facade-1 has to be the only backendRef of a rule",
+		},
+	}
+
+	for _, tc := range testCases {
+		for _, expressionRoutes := range []bool{false, true} {
+			t.Run(fmt.Sprintf("%s, expression routes: %v", tc.name, expressionRoutes), func(t *testing.T) {
+				fakestore, err := store.NewFakeStore(store.FakeObjects{
+					HTTPRoutes:         []*gatewayapi.HTTPRoute{tc.httpRoute},
+					Services:           []*corev1.Service{service},
+					KongServiceFacades: []*incubatorv1alpha1.KongServiceFacade{facade},
+				})
+				require.NoError(t, err)
+				translator := mustNewTranslator(t, fakestore)
+				translator.featureFlags.ExpressionRoutes = expressionRoutes
+				failuresCollector := failures.NewResourceFailuresCollector(zapr.NewLogger(zap.NewNop()))
+				translator.failuresCollector = failuresCollector
+
+				result := translator.ingressRulesFromHTTPRoutes()
+				translationFailures := failuresCollector.PopResourceFailures()
+				if tc.expectedFailure != "" {
+					require.Len(t, translationFailures, 1)
+					require.Contains(t, translationFailures[0].Message(), tc.expectedFailure)
+					require.NotContains(t, result.ServiceNameToServices, "default.facade-1.svc.facade")
+					return
+				}
+				require.Empty(t, translationFailures)
+
+				require.Len(t, result.ServiceNameToServices, tc.expectedServicesCount)
+				facadeService, ok := result.ServiceNameToServices["default.facade-1.svc.facade"]
+				require.True(t, ok, "should find the KongServiceFacade service")
+				require.Equal(t, "default.facade-1.svc.facade", *facadeService.Host)
+				require.Equal(t, facade, facadeService.Parent)
+				require.Equal(t, facade, result.ServiceNameToParent["default.facade-1.svc.facade"])
+				require.Len(t, facadeService.Backends, 1)
+				require.True(t, facadeService.Backends[0].IsServiceFacade())
+				expectedFacadeRoutes := tc.expectedFacadeRoutes
+				if expressionRoutes {
+					expectedFacadeRoutes = tc.expectedFacadeRoutesExpressionRoutes
+				}
+				require.Len(t, facadeService.Routes, expectedFacadeRoutes)
+			})
+		}
+	}
+}
+
+func TestTranslator_KongServiceFacadeSharedByIngressAndHTTPRoute(t *testing.T) {
+	storer := lo.Must(store.NewFakeStore(store.FakeObjects{
+		IngressesV1: []*netv1.Ingress{{
+			TypeMeta: metav1.TypeMeta{Kind: "Ingress", APIVersion: netv1.SchemeGroupVersion.String()},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "foo",
+				Namespace: corev1.NamespaceDefault,
+			},
+			Spec: netv1.IngressSpec{
+				IngressClassName: lo.ToPtr(annotations.DefaultIngressClass),
+				Rules: []netv1.IngressRule{{
+					Host: "example.com",
+					IngressRuleValue: netv1.IngressRuleValue{
+						HTTP: &netv1.HTTPIngressRuleValue{
+							Paths: []netv1.HTTPIngressPath{{
+								Path:     "/ingress",
+								PathType: lo.ToPtr(netv1.PathTypePrefix),
+								Backend: netv1.IngressBackend{
+									Resource: &corev1.TypedLocalObjectReference{
+										APIGroup: lo.ToPtr(incubatorv1alpha1.GroupVersion.Group),
+										Kind:     incubatorv1alpha1.KongServiceFacadeKind,
+										Name:     "foo-facade",
+									},
+								},
+							}},
+						},
+					},
+				}},
+			},
+		}},
+		HTTPRoutes: []*gatewayapi.HTTPRoute{func() *gatewayapi.HTTPRoute {
+			route := &gatewayapi.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: corev1.NamespaceDefault,
+				},
+				Spec: gatewayapi.HTTPRouteSpec{
+					Rules: []gatewayapi.HTTPRouteRule{{
+						Matches: []gatewayapi.HTTPRouteMatch{
+							builder.NewHTTPRouteMatch().WithPathExact("/httproute").Build(),
+						},
+						BackendRefs: []gatewayapi.HTTPBackendRef{
+							builder.NewHTTPBackendRef("foo-facade").
+								WithGroup(incubatorv1alpha1.GroupVersion.Group).
+								WithKind(incubatorv1alpha1.KongServiceFacadeKind).
+								Build(),
+						},
+					}},
+				},
+			}
+			route.SetGroupVersionKind(httprouteGVK)
+			return route
+		}()},
+		Services: []*corev1.Service{{
+			TypeMeta: metav1.TypeMeta{Kind: "Service", APIVersion: corev1.SchemeGroupVersion.String()},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "foo-svc",
+				Namespace: corev1.NamespaceDefault,
+			},
+			Spec: corev1.ServiceSpec{
+				Ports: []corev1.ServicePort{
+					builder.NewServicePort().WithPort(80).Build(),
+				},
+			},
+		}},
+		KongServiceFacades: []*incubatorv1alpha1.KongServiceFacade{{
+			TypeMeta: metav1.TypeMeta{Kind: incubatorv1alpha1.KongServiceFacadeKind, APIVersion: incubatorv1alpha1.GroupVersion.String()},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "foo-facade",
+				Namespace: corev1.NamespaceDefault,
+				Annotations: map[string]string{
+					annotations.AnnotationPrefix + annotations.PluginsKey: "key-auth",
+				},
+			},
+			Spec: incubatorv1alpha1.KongServiceFacadeSpec{
+				Backend: incubatorv1alpha1.KongServiceFacadeBackend{
+					Name: "foo-svc",
+					Port: 80,
+				},
+			},
+		}},
+		KongPlugins: []*kongv1.KongPlugin{{
+			TypeMeta: metav1.TypeMeta{Kind: "KongPlugin", APIVersion: kongv1.SchemeGroupVersion.String()},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "key-auth",
+				Namespace: corev1.NamespaceDefault,
+			},
+			PluginName: "key-auth",
+		}},
+	}))
+
+	translator := mustNewTranslator(t, storer)
+	result := translator.BuildKongConfig(context.Background())
+	require.Empty(t, result.TranslationFailures)
+	require.Len(t, result.KongState.Services, 1)
+	service := result.KongState.Services[0]
+	assert.Equal(t, "default.foo-facade.svc.facade", *service.Name)
+	assert.Len(t, service.Routes, 2, "routes of both the Ingress and the HTTPRoute should be attached")
+	assert.Contains(t, service.Tags, lo.ToPtr("k8s-kind:KongServiceFacade"), "tags are populated with KongServiceFacade as a parent")
+	require.Len(t, result.KongState.Plugins, 1)
+	require.NotNil(t, result.KongState.Plugins[0].Service)
+	assert.Equal(t, *service.Name, *result.KongState.Plugins[0].Service.ID, "plugin of the KongServiceFacade is attached to its service")
+}
+
+func TestTranslator_KongExternalBackendSharedByIngressAndHTTPRoute(t *testing.T) {
+	storer := lo.Must(store.NewFakeStore(store.FakeObjects{
+		IngressesV1: []*netv1.Ingress{{
+			TypeMeta: metav1.TypeMeta{Kind: "Ingress", APIVersion: netv1.SchemeGroupVersion.String()},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "foo",
+				Namespace: corev1.NamespaceDefault,
+			},
+			Spec: netv1.IngressSpec{
+				IngressClassName: lo.ToPtr(annotations.DefaultIngressClass),
+				Rules: []netv1.IngressRule{{
+					Host: "example.com",
+					IngressRuleValue: netv1.IngressRuleValue{
+						HTTP: &netv1.HTTPIngressRuleValue{
+							Paths: []netv1.HTTPIngressPath{{
+								Path:     "/ingress",
+								PathType: lo.ToPtr(netv1.PathTypePrefix),
+								Backend: netv1.IngressBackend{
+									Resource: &corev1.TypedLocalObjectReference{
+										APIGroup: lo.ToPtr(kongv1alpha1.GroupVersion.Group),
+										Kind:     kongv1alpha1.KongExternalBackendKind,
+										Name:     "foo-external",
+									},
+								},
+							}},
+						},
+					},
+				}},
+			},
+		}},
+		HTTPRoutes: []*gatewayapi.HTTPRoute{func() *gatewayapi.HTTPRoute {
+			route := &gatewayapi.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: corev1.NamespaceDefault,
+				},
+				Spec: gatewayapi.HTTPRouteSpec{
+					Rules: []gatewayapi.HTTPRouteRule{{
+						Matches: []gatewayapi.HTTPRouteMatch{
+							builder.NewHTTPRouteMatch().WithPathExact("/httproute").Build(),
+						},
+						BackendRefs: []gatewayapi.HTTPBackendRef{
+							builder.NewHTTPBackendRef("foo-external").
+								WithGroup(kongv1alpha1.GroupVersion.Group).
+								WithKind(kongv1alpha1.KongExternalBackendKind).
+								Build(),
+						},
+					}},
+				},
+			}
+			route.SetGroupVersionKind(httprouteGVK)
+			return route
+		}()},
+		KongExternalBackends: []*kongv1alpha1.KongExternalBackend{{
+			TypeMeta: metav1.TypeMeta{Kind: kongv1alpha1.KongExternalBackendKind, APIVersion: kongv1alpha1.GroupVersion.String()},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "foo-external",
+				Namespace: corev1.NamespaceDefault,
+				Annotations: map[string]string{
+					annotations.AnnotationPrefix + annotations.PluginsKey: "key-auth",
+					kongv1beta1.KongUpstreamPolicyAnnotationKey:           "foo-policy",
+				},
+			},
+			Spec: kongv1alpha1.KongExternalBackendSpec{
+				Targets: []kongv1alpha1.KongExternalBackendTarget{
+					{Host: "api.example.com", Port: 443},
+					{Host: "10.0.0.1", Port: 8443, Weight: lo.ToPtr(int32(10))},
+				},
+				TLS: &kongv1alpha1.KongExternalBackendTLS{
+					Verify:      true,
+					VerifyDepth: lo.ToPtr(int32(3)),
+				},
+			},
+		}},
+		KongUpstreamPolicies: []*kongv1beta1.KongUpstreamPolicy{{
+			TypeMeta: metav1.TypeMeta{Kind: "KongUpstreamPolicy", APIVersion: kongv1beta1.SchemeGroupVersion.String()},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "foo-policy",
+				Namespace: corev1.NamespaceDefault,
+			},
+			Spec: kongv1beta1.KongUpstreamPolicySpec{
+				Healthchecks: &kongv1beta1.KongUpstreamHealthcheck{
+					Active: &kongv1beta1.KongUpstreamActiveHealthcheck{
+						Type:     lo.ToPtr("https"),
+						HTTPPath: lo.ToPtr("/healthz"),
+					},
+				},
+			},
+		}},
+		KongPlugins: []*kongv1.KongPlugin{{
+			TypeMeta: metav1.TypeMeta{Kind: "KongPlugin", APIVersion: kongv1.SchemeGroupVersion.String()},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "key-auth",
+				Namespace: corev1.NamespaceDefault,
+			},
+			PluginName: "key-auth",
+		}},
+	}))
+
+	translator := mustNewTranslator(t, storer)
+	result := translator.BuildKongConfig(context.Background())
+	require.Empty(t, result.TranslationFailures)
+
+	require.Len(t, result.KongState.Services, 1)
+	service := result.KongState.Services[0]
+	assert.Equal(t, "default.foo-external.external", *service.Name)
+	assert.Equal(t, "https", *service.Protocol, "protocol is upgraded as the KongExternalBackend requests TLS")
+	assert.Equal(t, lo.ToPtr(true), service.TLSVerify)
+	assert.Equal(t, lo.ToPtr(3), service.TLSVerifyDepth)
+	assert.Len(t, service.Routes, 2, "routes of both the Ingress and the HTTPRoute should be attached")
+	assert.Contains(t, service.Tags, lo.ToPtr("k8s-kind:KongExternalBackend"), "tags are populated with KongExternalBackend as a parent")
+
+	require.Len(t, result.KongState.Upstreams, 1)
+	upstream := result.KongState.Upstreams[0]
+	assert.Equal(t, *service.Host, *upstream.Name)
+	targets := lo.SliceToMap(upstream.Targets, func(t kongstate.Target) (string, int) {
+		return *t.Target.Target, *t.Target.Weight
+	})
+	assert.Equal(t, map[string]int{
+		"api.example.com:443": kongstate.DefaultExternalBackendTargetWeight,
+		"10.0.0.1:8443":       10,
+	}, targets)
+	require.NotNil(t, upstream.Healthchecks, "KongUpstreamPolicy referenced by the KongExternalBackend is applied")
+	require.NotNil(t, upstream.Healthchecks.Active)
+	assert.Equal(t, "/healthz", *upstream.Healthchecks.Active.HTTPPath)
+
+	require.Len(t, result.KongState.Plugins, 1)
+	require.NotNil(t, result.KongState.Plugins[0].Service)
+	assert.Equal(t, *service.Name, *result.KongState.Plugins[0].Service.ID, "plugin of the KongExternalBackend is attached to its service")
+}
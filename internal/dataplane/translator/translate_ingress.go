@@ -22,7 +22,7 @@ import (
 func (t *Translator) ingressRulesFromIngressV1() ingressRules {
 	result := newIngressRules()
 
-	ingressList := t.storer.ListIngressesV1()
+	ingressList := t.enforceHostnamePoliciesOnIngresses(t.storer.ListIngressesV1())
 	icp, err := getIngressClassParametersOrDefault(t.storer)
 	if err != nil {
 		if !errors.As(err, &store.NotFoundError{}) {
@@ -30,6 +30,7 @@ func (t *Translator) ingressRulesFromIngressV1() ingressRules {
 			t.logger.Error(err, "Could not retrieve IngressClassParameters, using defaults")
 		}
 	}
+	ingressList = applyIngressClassDefaultsToIngresses(ingressList, icp.Defaults)
 
 	sort.SliceStable(ingressList, func(i, j int) bool {
 		return ingressList[i].CreationTimestamp.Before(
@@ -64,6 +65,7 @@ func (t *Translator) ingressRulesFromIngressV1() ingressRules {
 			t.registerTranslationFailure(err.Error(), service.Parent)
 			continue
 		}
+		applyIngressClassDefaultsToService(&service, icp.Defaults)
 
 		result.ServiceNameToServices[*service.Name] = service
 		result.ServiceNameToParent[*service.Name] = service.Parent
@@ -72,6 +74,7 @@ func (t *Translator) ingressRulesFromIngressV1() ingressRules {
 	// Add a default backend if it exists.
 	defaultBackendService, ok := getDefaultBackendService(t.storer, t.failuresCollector, allDefaultBackends, t.featureFlags)
 	if ok {
+		applyIngressClassDefaultsToService(&defaultBackendService, icp.Defaults)
 		// When such service would overwrite an existing service, merge the routes.
 		if svc, ok := result.ServiceNameToServices[*defaultBackendService.Name]; ok {
 			svc.Routes = append(svc.Routes, defaultBackendService.Routes...)
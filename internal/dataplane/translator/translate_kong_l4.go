@@ -23,6 +23,7 @@ func (t *Translator) ingressRulesFromTCPIngressV1beta1() ingressRules {
 		return result
 	}
 
+	ingressList = t.enforceHostnamePoliciesOnTCPIngresses(ingressList)
 	sort.SliceStable(ingressList, func(i, j int) bool {
 		return ingressList[i].CreationTimestamp.Before(
 			&ingressList[j].CreationTimestamp)
@@ -113,6 +114,7 @@ func (t *Translator) ingressRulesFromUDPIngressV1beta1() ingressRules {
 		return result
 	}
 
+	ingressList = t.enforceHostnamePoliciesOnUDPIngresses(ingressList)
 	sort.SliceStable(ingressList, func(i, j int) bool {
 		return ingressList[i].CreationTimestamp.Before(&ingressList[j].CreationTimestamp)
 	})
@@ -72,7 +72,7 @@ func (t *Translator) ingressRulesFromTCPRoute(result *ingressRules, tcproute *ga
 
 		// cache the service to avoid duplicates in further loop iterations
 		result.ServiceNameToServices[*service.Service.Name] = service
-		result.ServiceNameToParent[*service.Service.Name] = tcproute
+		result.ServiceNameToParent[*service.Service.Name] = service.Parent
 	}
 
 	return nil
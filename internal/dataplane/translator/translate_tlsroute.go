@@ -3,8 +3,10 @@ package translator
 import (
 	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator/subtranslator"
@@ -27,11 +29,13 @@ func (t *Translator) ingressRulesFromTLSRoutes() ingressRules {
 		return result
 	}
 
-	var errs []error
 	for _, tlsroute := range tlsRouteList {
+		tlsroute, ok := t.enforceHostnamePoliciesOnTLSRoute(tlsroute)
+		if !ok {
+			continue
+		}
 		if err := t.ingressRulesFromTLSRoute(&result, tlsroute); err != nil {
-			err = fmt.Errorf("TLSRoute %s/%s can't be routed: %w", tlsroute.Namespace, tlsroute.Name, err)
-			errs = append(errs, err)
+			t.registerTranslationFailure(fmt.Sprintf("TLSRoute can't be routed: %s", err), tlsroute)
 		} else {
 			// at this point the object has been configured and can be
 			// reported as successfully translated.
@@ -43,10 +47,6 @@ func (t *Translator) ingressRulesFromTLSRoutes() ingressRules {
 		applyExpressionToIngressRules(&result)
 	}
 
-	for _, err := range errs {
-		t.logger.Error(err, "Could not generate route from TLSRoute")
-	}
-
 	return result
 }
 
@@ -60,7 +60,7 @@ func (t *Translator) ingressRulesFromTLSRoute(result *ingressRules, tlsroute *ga
 		return subtranslator.ErrRouteValidationNoRules
 	}
 
-	tlsPassthrough, err := t.isTLSRoutePassthrough(tlsroute)
+	tlsMode, err := t.getTLSRouteListenerTLSMode(tlsroute)
 	if err != nil {
 		return err
 	}
@@ -71,15 +71,17 @@ func (t *Translator) ingressRulesFromTLSRoute(result *ingressRules, tlsroute *ga
 		// Determine the routes needed to route traffic to services for this rule.
 		// TLSRoute matches based on hostname with Gateway listener thus passing gwPorts is pointless.
 		routes, err := generateKongRoutesFromRouteRule(tlsroute, nil, ruleNumber, rule)
-		// Change protocols in route to tls_passthrough.
-		if tlsPassthrough {
+		if err != nil {
+			return err
+		}
+		// Routes are generated with the "tls" protocol, which makes Kong terminate TLS
+		// using the certificate matching the SNI and forward plaintext TCP to the backend.
+		// For passthrough listeners the TLS stream is forwarded as is.
+		if tlsMode == gatewayapi.TLSModePassthrough {
 			for i := range routes {
 				routes[i].Protocols = kong.StringSlice("tls_passthrough")
 			}
 		}
-		if err != nil {
-			return err
-		}
 
 		// create a service and attach the routes to it
 		service, err := generateKongServiceFromBackendRefWithRuleNumber(t.logger, t.storer, result, tlsroute, ruleNumber, "tcp", rule.BackendRefs...)
@@ -96,10 +98,14 @@ func (t *Translator) ingressRulesFromTLSRoute(result *ingressRules, tlsroute *ga
 	return nil
 }
 
-// isTLSRoutePassthrough returns true if we need to configure TLS passthrough to kong
-// for the tlsroute object.
-// returns a non-nil error if we failed to get the supported gateway.
-func (t *Translator) isTLSRoutePassthrough(tlsroute *gatewayapi.TLSRoute) (bool, error) {
+// getTLSRouteListenerTLSMode returns the TLS mode of the Gateway listeners the TLSRoute
+// is attached to. When no attached listener can be found, TLSModeTerminate (Gateway API's
+// default TLS mode) is returned.
+// It returns a non-nil error if we failed to get the supported gateway, if the attached
+// listeners use different TLS modes or if a listener in Terminate mode has no certificate
+// that Kong could use to terminate TLS.
+func (t *Translator) getTLSRouteListenerTLSMode(tlsroute *gatewayapi.TLSRoute) (gatewayapi.TLSModeType, error) {
+	var modes []gatewayapi.TLSModeType
 	// reconcile loop will push TLSRoute object with updated status when
 	// gateway is ready and TLSRoute object becomes stable.
 	// so we get the supported gateways from status.parents.
@@ -130,20 +136,71 @@ func (t *Translator) isTLSRoutePassthrough(tlsroute *gatewayapi.TLSRoute) (bool,
 					"tlsroute_name", tlsroute.Name)
 				continue
 			}
-			return false, err
+			return "", err
 		}
 
-		// If any of the gateway's listeners is configured to passthrough
-		// TLS requests, we return true.
 		for _, listener := range gateway.Spec.Listeners {
-			if parentRef.SectionName == nil || listener.Name == *parentRef.SectionName {
-				if listener.TLS != nil && listener.TLS.Mode != nil &&
-					*listener.TLS.Mode == gatewayapi.TLSModePassthrough {
-					return true, nil
-				}
+			if parentRef.SectionName != nil && listener.Name != *parentRef.SectionName {
+				continue
 			}
+			if listener.Protocol != gatewayapi.TLSProtocolType || listener.TLS == nil {
+				continue
+			}
+			mode := lo.FromPtrOr(listener.TLS.Mode, gatewayapi.TLSModeTerminate)
+			if mode == gatewayapi.TLSModeTerminate && len(listener.TLS.CertificateRefs) == 0 {
+				return "", fmt.Errorf("listener %s of Gateway %s/%s is in Terminate mode but has no certificateRefs",
+					listener.Name, gateway.Namespace, gateway.Name)
+			}
+			modes = append(modes, mode)
+		}
+	}
+
+	modes = lo.Uniq(modes)
+	switch len(modes) {
+	case 0:
+		return gatewayapi.TLSModeTerminate, nil
+	case 1:
+		return modes[0], nil
+	default:
+		return "", fmt.Errorf("TLSRoute is attached to listeners with different TLS modes: %v", modes)
+	}
+}
+
+// getTLSRouteHostnamesForListener returns the hostnames of all TLSRoutes attached to
+// the given Gateway listener. It is used to associate the listener certificate with
+// SNIs when the listener itself does not specify a hostname.
+func (t *Translator) getTLSRouteHostnamesForListener(gateway *gatewayapi.Gateway, listenerName gatewayapi.SectionName) []string {
+	tlsRoutes, err := t.storer.ListTLSRoutes()
+	if err != nil {
+		t.logger.Error(err, "Failed to list TLSRoutes")
+		return nil
+	}
+
+	var hostnames []string
+	for _, tlsroute := range tlsRoutes {
+		attached := lo.ContainsBy(tlsroute.Status.Parents, func(ps gatewayapi.RouteParentStatus) bool {
+			parentRef := ps.ParentRef
+			if parentRef.Group != nil && string(*parentRef.Group) != gatewayv1.GroupName {
+				return false
+			}
+			if parentRef.Kind != nil && *parentRef.Kind != KindGateway {
+				return false
+			}
+			namespace := tlsroute.Namespace
+			if parentRef.Namespace != nil {
+				namespace = string(*parentRef.Namespace)
+			}
+			return namespace == gateway.Namespace && string(parentRef.Name) == gateway.Name &&
+				(parentRef.SectionName == nil || *parentRef.SectionName == listenerName)
+		})
+		if !attached {
+			continue
+		}
+		for _, hostname := range tlsroute.Spec.Hostnames {
+			hostnames = append(hostnames, string(hostname))
 		}
 	}
 
-	return false, nil
+	sort.Strings(hostnames)
+	return lo.Uniq(hostnames)
 }
@@ -257,3 +257,142 @@ func TestIngressRulesFromTLSRoutesUsingExpressionRoutes(t *testing.T) {
 		})
 	}
 }
+
+func TestIngressRulesFromTLSRoutesListenerTLSModes(t *testing.T) {
+	tlsRouteTypeMeta := metav1.TypeMeta{Kind: "TLSRoute", APIVersion: corev1.SchemeGroupVersion.String()}
+
+	gatewayWithListeners := func(listeners ...gatewayapi.Listener) *gatewayapi.Gateway {
+		return &gatewayapi.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "gateway-1",
+			},
+			Spec: gatewayapi.GatewaySpec{
+				Listeners: listeners,
+			},
+		}
+	}
+	tlsListener := func(name string, mode gatewayapi.TLSModeType, certRefs ...gatewayapi.SecretObjectReference) gatewayapi.Listener {
+		return builder.NewListener(name).TLS().WithPort(443).
+			WithTLSConfig(&gatewayapi.GatewayTLSConfig{
+				Mode:            lo.ToPtr(mode),
+				CertificateRefs: certRefs,
+			}).Build()
+	}
+	tlsRouteAttachedTo := func(sectionName *gatewayapi.SectionName) *gatewayapi.TLSRoute {
+		parentRef := gatewayapi.ParentReference{
+			Name:        "gateway-1",
+			SectionName: sectionName,
+		}
+		return &gatewayapi.TLSRoute{
+			TypeMeta: tlsRouteTypeMeta,
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "tlsroute-1",
+			},
+			Spec: gatewayapi.TLSRouteSpec{
+				CommonRouteSpec: gatewayapi.CommonRouteSpec{
+					ParentRefs: []gatewayapi.ParentReference{parentRef},
+				},
+				Hostnames: []gatewayapi.Hostname{"foo.com"},
+				Rules: []gatewayapi.TLSRouteRule{
+					{
+						BackendRefs: []gatewayapi.BackendRef{
+							builder.NewBackendRef("service1").WithPort(80).Build(),
+						},
+					},
+				},
+			},
+			Status: gatewayapi.TLSRouteStatus{
+				RouteStatus: gatewayapi.RouteStatus{
+					Parents: []gatewayapi.RouteParentStatus{{ParentRef: parentRef}},
+				},
+			},
+		}
+	}
+	certRef := gatewayapi.SecretObjectReference{Name: "cert"}
+
+	testCases := []struct {
+		name              string
+		gateway           *gatewayapi.Gateway
+		tlsRoute          *gatewayapi.TLSRoute
+		expectedProtocols []*string
+		expectedFailure   string
+	}{
+		{
+			name:              "listener in Passthrough mode",
+			gateway:           gatewayWithListeners(tlsListener("tls", gatewayapi.TLSModePassthrough)),
+			tlsRoute:          tlsRouteAttachedTo(nil),
+			expectedProtocols: kong.StringSlice("tls_passthrough"),
+		},
+		{
+			name:              "listener in Terminate mode",
+			gateway:           gatewayWithListeners(tlsListener("tls", gatewayapi.TLSModeTerminate, certRef)),
+			tlsRoute:          tlsRouteAttachedTo(nil),
+			expectedProtocols: kong.StringSlice("tls"),
+		},
+		{
+			name: "sectionName selects listener in Terminate mode",
+			gateway: gatewayWithListeners(
+				tlsListener("passthrough", gatewayapi.TLSModePassthrough),
+				tlsListener("terminate", gatewayapi.TLSModeTerminate, certRef),
+			),
+			tlsRoute:          tlsRouteAttachedTo(lo.ToPtr(gatewayapi.SectionName("terminate"))),
+			expectedProtocols: kong.StringSlice("tls"),
+		},
+		{
+			name: "listeners with conflicting TLS modes",
+			gateway: gatewayWithListeners(
+				tlsListener("passthrough", gatewayapi.TLSModePassthrough),
+				tlsListener("terminate", gatewayapi.TLSModeTerminate, certRef),
+			),
+			tlsRoute:        tlsRouteAttachedTo(nil),
+			expectedFailure: "TLSRoute is attached to listeners with different TLS modes",
+		},
+		{
+			name:            "listener in Terminate mode without certificateRefs",
+			gateway:         gatewayWithListeners(tlsListener("tls", gatewayapi.TLSModeTerminate)),
+			tlsRoute:        tlsRouteAttachedTo(nil),
+			expectedFailure: "is in Terminate mode but has no certificateRefs",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			fakestore, err := store.NewFakeStore(store.FakeObjects{
+				TLSRoutes: []*gatewayapi.TLSRoute{tc.tlsRoute},
+				Gateways:  []*gatewayapi.Gateway{tc.gateway},
+				Services: []*corev1.Service{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "default",
+							Name:      "service1",
+						},
+					},
+				},
+			})
+			require.NoError(t, err)
+			translator := mustNewTranslator(t, fakestore)
+
+			failureCollector := failures.NewResourceFailuresCollector(zapr.NewLogger(zap.NewNop()))
+			translator.failuresCollector = failureCollector
+
+			result := translator.ingressRulesFromTLSRoutes()
+			translationFailures := failureCollector.PopResourceFailures()
+			if tc.expectedFailure != "" {
+				require.Empty(t, result.ServiceNameToServices)
+				require.Len(t, translationFailures, 1)
+				require.Contains(t, translationFailures[0].Message(), tc.expectedFailure)
+				return
+			}
+
+			require.Empty(t, translationFailures)
+			service, ok := result.ServiceNameToServices["tlsroute.default.tlsroute-1.0"]
+			require.True(t, ok)
+			require.Len(t, service.Routes, 1)
+			require.Equal(t, tc.expectedProtocols, service.Routes[0].Protocols)
+			require.Equal(t, kong.StringSlice("foo.com"), service.Routes[0].SNIs)
+		})
+	}
+}
@@ -43,6 +43,21 @@ func (t *Translator) getUpstreams(serviceMap map[string]kongstate.Service) ([]ko
 				backendNamespace := backend.Namespace()
 
 				backendName := backend.Name()
+				if backend.IsExternalBackend() {
+					// In the case of KongExternalBackend, targets are defined directly in its spec.
+					externalBackend, err := t.storer.GetKongExternalBackend(backend.Namespace(), backend.Name())
+					if err != nil {
+						t.registerTranslationFailure(
+							fmt.Sprintf("couldn't get KongExternalBackend %s: %v", backend.Name(), err),
+							service.Parent,
+						)
+						continue
+					}
+					for _, target := range kongstate.ExternalBackendTargets(externalBackend) {
+						targetMap = updateTargetMap(targetMap, target)
+					}
+					continue
+				}
 				if backend.IsServiceFacade() {
 					// In the case of KongServiceFacade we need to look it up to determine the backing Kubernetes Service.
 					svcFacade, err := t.storer.GetKongServiceFacade(backend.Namespace(), backend.Name())
@@ -2,6 +2,7 @@ package translator
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/go-logr/logr"
 	"github.com/kong/go-kong/kong"
@@ -11,6 +12,7 @@ import (
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator/subtranslator"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
 )
 
 // -----------------------------------------------------------------------------
@@ -70,6 +72,26 @@ func generateKongServiceFromBackendRefWithName(
 
 	backends := backendRefsToKongStateBackends(logger, storer, route, backendRefs, allowed)
 
+	// KongServiceFacade backends are translated to a dedicated Kong service per KongServiceFacade
+	// which is shared by all the routes using it.
+	if idx := slices.IndexFunc(backends, func(b kongstate.ServiceBackend) bool { return b.IsServiceFacade() }); idx != -1 {
+		if len(backendRefs) > 1 {
+			return kongstate.Service{}, fmt.Errorf("KongServiceFacade %s/%s has to be the only backendRef of a rule",
+				backends[idx].Namespace(), backends[idx].Name())
+		}
+		return generateKongServiceForServiceFacade(storer, rules, protocol, backends[idx])
+	}
+
+	// KongExternalBackend backends are translated to a dedicated Kong service per KongExternalBackend
+	// which is shared by all the routes using it.
+	if idx := slices.IndexFunc(backends, func(b kongstate.ServiceBackend) bool { return b.IsExternalBackend() }); idx != -1 {
+		if len(backendRefs) > 1 {
+			return kongstate.Service{}, fmt.Errorf("KongExternalBackend %s/%s has to be the only backendRef of a rule",
+				backends[idx].Namespace(), backends[idx].Name())
+		}
+		return generateKongServiceForExternalBackend(storer, rules, protocol, backends[idx])
+	}
+
 	// the service host needs to be a resolvable name due to legacy logic so we'll
 	// use the anchor backendRef as the basis for the name
 	serviceHost := serviceName
@@ -96,8 +118,10 @@ func generateKongServiceFromBackendRefWithName(
 	// In the context of the gateway API conformance tests, if there is no service for the backend,
 	// the response must have a status code of 500. Since The default behavior of Kong is returning 503
 	// if there is no backend for a service, we inject a plugin that terminates all requests with 500
-	// as status code
-	if len(service.Backends) == 0 && len(backendRefs) != 0 {
+	// as status code.
+	// Stream services (TCPRoute, UDPRoute, TLSRoute) cannot use HTTP plugins. Their upstream gets
+	// no targets, so Kong rejects the connections as Gateway API requires for such routes.
+	if len(service.Backends) == 0 && len(backendRefs) != 0 && !util.IsStreamProtocol(protocol) {
 		if service.Plugins == nil {
 			service.Plugins = make([]kong.Plugin, 0)
 		}
@@ -113,6 +137,77 @@ func generateKongServiceFromBackendRefWithName(
 	return service, nil
 }
 
+// generateKongServiceForServiceFacade returns a Kong service for a KongServiceFacade backend of a Gateway APIs route.
+// The service is named after the KongServiceFacade and uses it as its parent, so the KongServiceFacade's annotations
+// (e.g. plugins) are applied to it the same way as when it's used as an Ingress backend.
+func generateKongServiceForServiceFacade(
+	storer store.Storer,
+	rules *ingressRules,
+	protocol string,
+	backend kongstate.ServiceBackend,
+) (kongstate.Service, error) {
+	serviceFacade, err := storer.GetKongServiceFacade(backend.Namespace(), backend.Name())
+	if err != nil {
+		return kongstate.Service{}, fmt.Errorf("failed to get KongServiceFacade %s/%s: %w", backend.Namespace(), backend.Name(), err)
+	}
+
+	// The naming pattern is `<facade-namespace>.<facade-name>.svc.facade`, the same as for Ingress backends.
+	serviceName := fmt.Sprintf("%s.%s.svc.facade", serviceFacade.Namespace, serviceFacade.Name)
+	if service, ok := rules.ServiceNameToServices[serviceName]; ok {
+		return service, nil
+	}
+	return kongstate.Service{
+		Service: kong.Service{
+			Name:           kong.String(serviceName),
+			Host:           kong.String(serviceName),
+			Protocol:       kong.String(protocol),
+			ConnectTimeout: kong.Int(DefaultServiceTimeout),
+			ReadTimeout:    kong.Int(DefaultServiceTimeout),
+			WriteTimeout:   kong.Int(DefaultServiceTimeout),
+			Retries:        kong.Int(DefaultRetries),
+		},
+		Namespace: serviceFacade.Namespace,
+		Backends:  kongstate.ServiceBackends{backend},
+		Parent:    serviceFacade,
+	}, nil
+}
+
+// generateKongServiceForExternalBackend returns a Kong service for a KongExternalBackend backend of a Gateway APIs route.
+// The service is named after the KongExternalBackend and uses it as its parent, so the KongExternalBackend's annotations
+// (e.g. plugins or upstream policy) are applied to it the same way as when it's used as an Ingress backend.
+func generateKongServiceForExternalBackend(
+	storer store.Storer,
+	rules *ingressRules,
+	protocol string,
+	backend kongstate.ServiceBackend,
+) (kongstate.Service, error) {
+	externalBackend, err := storer.GetKongExternalBackend(backend.Namespace(), backend.Name())
+	if err != nil {
+		return kongstate.Service{}, fmt.Errorf("failed to get KongExternalBackend %s/%s: %w", backend.Namespace(), backend.Name(), err)
+	}
+
+	serviceName := kongstate.ExternalBackendServiceName(externalBackend.Namespace, externalBackend.Name, util.IsStreamProtocol(protocol))
+	if service, ok := rules.ServiceNameToServices[serviceName]; ok {
+		return service, nil
+	}
+	service := kongstate.Service{
+		Service: kong.Service{
+			Name:           kong.String(serviceName),
+			Host:           kong.String(serviceName),
+			Protocol:       kong.String(protocol),
+			ConnectTimeout: kong.Int(DefaultServiceTimeout),
+			ReadTimeout:    kong.Int(DefaultServiceTimeout),
+			WriteTimeout:   kong.Int(DefaultServiceTimeout),
+			Retries:        kong.Int(DefaultRetries),
+		},
+		Namespace: externalBackend.Namespace,
+		Backends:  kongstate.ServiceBackends{backend},
+		Parent:    externalBackend,
+	}
+	kongstate.ApplyExternalBackendTLS(&service.Service, externalBackend.Spec.TLS)
+	return service, nil
+}
+
 // generateKongServiceFromBackendRefWithRuleNumber translates backendRefs for rule ruleNumber into a Kong service for use with the
 // rules generated from a Gateway APIs route. The service name is computed from route and ruleNumber by the function.
 func generateKongServiceFromBackendRefWithRuleNumber(
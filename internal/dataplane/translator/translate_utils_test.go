@@ -476,4 +476,32 @@ func TestGenerateKongServiceFromBackendRef(t *testing.T) {
 			}
 		})
 	}
+
+	t.Run("only not permitted remote ns with stream protocol", func(t *testing.T) {
+		route := &gatewayapi.TCPRoute{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "TCPRoute",
+				APIVersion: "gateway.networking.k8s.io/v1alpha2",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "kitab-ul-atfol",
+				Namespace: "behbudiy",
+			},
+		}
+		refs := []gatewayapi.BackendRef{
+			{
+				BackendObjectReference: gatewayapi.BackendObjectReference{
+					Name:      blueObjName,
+					Port:      &port,
+					Kind:      &serviceKind,
+					Namespace: &cholponNamespace,
+					Group:     &serviceGroup,
+				},
+			},
+		}
+		result, err := generateKongServiceFromBackendRefWithRuleNumber(p.logger, p.storer, &rules, route, ruleNumber, "tcp", refs...)
+		assert.Nil(t, err)
+		assert.Empty(t, result.Backends)
+		assert.Empty(t, result.Plugins, "stream services must not get the HTTP-only request-termination plugin")
+	})
 }
@@ -0,0 +1,246 @@
+package translator
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/failures"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/fallback"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/logging"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
+	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
+)
+
+// translationCache caches results of translating single Kubernetes objects into ingress rules, so objects
+// that did not change since the previous translation do not have to be translated again.
+//
+// An entry is keyed by the UID of the translated object and is valid as long as the fingerprint of the object
+// stays the same. The fingerprint consists of UIDs and resource versions of the object itself and of all
+// objects it depends on (transitively), as resolved by fallback.ResolveDependencies. That way a change of
+// e.g. a Service invalidates entries of all routes using it as a backend.
+//
+// translationCache is not safe for concurrent use. It's expected to be used only by a single Translator
+// which is not safe for concurrent use either.
+type translationCache struct {
+	logger  logr.Logger
+	entries map[k8stypes.UID]translationCacheEntry
+
+	// generation is incremented on every translation round. Entries not used in the latest round
+	// belong to objects that do not exist anymore and are pruned.
+	generation uint64
+	hits       int
+	misses     int
+
+	// referenceGrantsFingerprint is computed once per translation round as ReferenceGrants may affect
+	// translation of any route using a backend from another namespace.
+	referenceGrantsFingerprint string
+	// hostnamePoliciesFingerprint is computed once per translation round as KongHostnamePolicies may affect
+	// hostnames of any route.
+	hostnamePoliciesFingerprint string
+}
+
+type translationCacheEntry struct {
+	fingerprint string
+	rules       ingressRules
+	// failures are the translation failures registered while translating the object. They're registered
+	// again whenever the entry is used, as if the object was translated.
+	failures   []failures.ResourceFailure
+	generation uint64
+}
+
+func newTranslationCache(logger logr.Logger) *translationCache {
+	return &translationCache{
+		logger:  logger,
+		entries: make(map[k8stypes.UID]translationCacheEntry),
+	}
+}
+
+// startRound has to be called before translating objects in a new translation round.
+func (c *translationCache) startRound(cacheStores store.CacheStores) {
+	c.generation++
+	c.hits, c.misses = 0, 0
+	c.referenceGrantsFingerprint = objectsFingerprint[*gatewayapi.ReferenceGrant](cacheStores)
+	c.hostnamePoliciesFingerprint = objectsFingerprint[*kongv1alpha1.KongHostnamePolicy](cacheStores)
+}
+
+// finishRound prunes entries of objects that were not translated in the current round.
+func (c *translationCache) finishRound() {
+	for uid, entry := range c.entries {
+		if entry.generation != c.generation {
+			delete(c.entries, uid)
+		}
+	}
+	c.logger.V(logging.DebugLevel).Info("Finished incremental translation",
+		"cached", c.hits, "translated", c.misses, "entries", len(c.entries))
+}
+
+// getOrTranslate returns ingress rules of the given object from the cache if neither the object nor its
+// dependencies changed since they were cached. Otherwise, it calls translate and caches its result.
+// extraKey identifies inputs of the translation that don't come from the object and its dependencies
+// (e.g. priorities of expression routes assigned based on all the routes). Returned rules are always
+// a copy that can be safely modified by the caller, returned failures are the ones registered while
+// translating the object (now or when it was cached).
+func (c *translationCache) getOrTranslate(
+	cacheStores store.CacheStores,
+	obj client.Object,
+	parentRefs []gatewayapi.ParentReference,
+	extraKey string,
+	translate func() (ingressRules, []failures.ResourceFailure, error),
+) (ingressRules, []failures.ResourceFailure, error) {
+	fingerprint, err := c.fingerprint(cacheStores, obj, parentRefs)
+	if err == nil {
+		fingerprint += ";" + extraKey
+	}
+	if err != nil {
+		// Objects whose dependencies cannot be resolved are never cached.
+		c.logger.V(logging.DebugLevel).Info("Failed to resolve dependencies, translating without cache", "error", err.Error())
+		c.misses++
+		return translate()
+	}
+
+	if entry, ok := c.entries[obj.GetUID()]; ok && entry.fingerprint == fingerprint {
+		c.hits++
+		entry.generation = c.generation
+		c.entries[obj.GetUID()] = entry
+		return entry.rules.deepCopy(), entry.failures, nil
+	}
+
+	c.misses++
+	rules, translationFailures, err := translate()
+	if err != nil {
+		// Failed translations are not cached, so they're retried (and reported) in every round.
+		delete(c.entries, obj.GetUID())
+		return rules, translationFailures, err
+	}
+	c.entries[obj.GetUID()] = translationCacheEntry{
+		fingerprint: fingerprint,
+		rules:       rules.deepCopy(),
+		failures:    translationFailures,
+		generation:  c.generation,
+	}
+	return rules, translationFailures, nil
+}
+
+// translateWithCache translates the object with translate, reusing the result of its previous translation
+// when incremental translation is enabled and neither the object, its dependencies nor extraKey changed
+// since then. The result is merged into the given ingress rules, and translation failures registered
+// while translating the object are registered again when the cached result is used.
+func (t *Translator) translateWithCache(
+	result *ingressRules,
+	obj client.Object,
+	parentRefs []gatewayapi.ParentReference,
+	extraKey string,
+	translate func(*ingressRules) error,
+) error {
+	if t.translationCache == nil {
+		return translate(result)
+	}
+	rules, translationFailures, err := t.translationCache.getOrTranslate(t.storer.CacheStores(), obj, parentRefs, extraKey,
+		func() (ingressRules, []failures.ResourceFailure, error) {
+			// Collect failures of the object separately, so they can be cached along with its rules. They're
+			// logged once registered with the translator's collector below.
+			collector := t.failuresCollector
+			t.failuresCollector = failures.NewResourceFailuresCollector(logr.Discard())
+			defer func() { t.failuresCollector = collector }()
+
+			rules := newIngressRules()
+			err := translate(&rules)
+			return rules, t.failuresCollector.PopResourceFailures(), err
+		},
+	)
+	for _, f := range translationFailures {
+		t.registerTranslationFailure(f.Message(), f.CausingObjects()...)
+	}
+	result.merge(rules)
+	return err
+}
+
+// fingerprint returns a string identifying the exact versions of the object, its parent Gateways and all
+// the objects it depends on.
+func (c *translationCache) fingerprint(
+	cacheStores store.CacheStores,
+	obj client.Object,
+	parentRefs []gatewayapi.ParentReference,
+) (string, error) {
+	versions := []string{objectVersion(obj)}
+	seen := map[string]struct{}{versions[0]: {}}
+
+	// Gateways affect e.g. hostnames of routes, but they are not dependencies of routes in the fallback sense.
+	for _, gw := range parentGateways(cacheStores, obj.GetNamespace(), parentRefs) {
+		v := objectVersion(gw)
+		if _, ok := seen[v]; !ok {
+			seen[v] = struct{}{}
+			versions = append(versions, v)
+		}
+	}
+
+	toResolve := []client.Object{obj}
+	for len(toResolve) > 0 {
+		current := toResolve[0]
+		toResolve = toResolve[1:]
+		deps, err := fallback.ResolveDependencies(cacheStores, current)
+		if err != nil {
+			return "", err
+		}
+		for _, dep := range deps {
+			v := objectVersion(dep)
+			if _, ok := seen[v]; ok {
+				continue
+			}
+			seen[v] = struct{}{}
+			versions = append(versions, v)
+			toResolve = append(toResolve, dep)
+		}
+	}
+
+	// The order of the object itself doesn't matter as it's always first, sort only the rest so the fingerprint
+	// is stable regardless of the order dependencies are resolved in.
+	slices.Sort(versions[1:])
+	versions = append(versions, c.referenceGrantsFingerprint, c.hostnamePoliciesFingerprint)
+	return strings.Join(versions, ";"), nil
+}
+
+// parentGateways returns Gateways referenced by parentRefs that exist in the cache.
+func parentGateways(cacheStores store.CacheStores, routeNamespace string, parentRefs []gatewayapi.ParentReference) []client.Object {
+	var gateways []client.Object
+	for _, parentRef := range parentRefs {
+		if parentRef.Kind != nil && *parentRef.Kind != KindGateway {
+			continue
+		}
+		namespace := routeNamespace
+		if parentRef.Namespace != nil {
+			namespace = string(*parentRef.Namespace)
+		}
+		gw, exists, err := cacheStores.Gateway.GetByKey(fmt.Sprintf("%s/%s", namespace, parentRef.Name))
+		if err == nil && exists {
+			gateways = append(gateways, gw.(client.Object))
+		}
+	}
+	return gateways
+}
+
+// objectsFingerprint returns a string identifying the exact versions of all objects of type T in the cache.
+func objectsFingerprint[T client.Object](cacheStores store.CacheStores) string {
+	objs, err := store.List[T](cacheStores)
+	if err != nil {
+		return ""
+	}
+	versions := make([]string, 0, len(objs))
+	for _, obj := range objs {
+		versions = append(versions, objectVersion(obj))
+	}
+	slices.Sort(versions)
+	return strings.Join(versions, ",")
+}
+
+// objectVersion returns a string identifying the exact version of the object. Objects in the cache
+// may have no GVK set, so the Go type is used to tell objects of different kinds apart.
+func objectVersion(obj client.Object) string {
+	return fmt.Sprintf("%T:%s/%s:%s:%s", obj, obj.GetNamespace(), obj.GetName(), obj.GetUID(), obj.GetResourceVersion())
+}
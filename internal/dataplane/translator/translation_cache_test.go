@@ -0,0 +1,335 @@
+package translator
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/failures"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/builder"
+	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
+)
+
+func TestTranslator_IncrementalTranslation(t *testing.T) {
+	route := &gatewayapi.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "foo",
+			Namespace:       corev1.NamespaceDefault,
+			UID:             "route-uid",
+			ResourceVersion: "1",
+		},
+		Spec: gatewayapi.HTTPRouteSpec{
+			Rules: []gatewayapi.HTTPRouteRule{{
+				Matches: []gatewayapi.HTTPRouteMatch{
+					builder.NewHTTPRouteMatch().WithPathExact("/foo").Build(),
+				},
+				BackendRefs: []gatewayapi.HTTPBackendRef{
+					builder.NewHTTPBackendRef("foo-svc").WithPort(80).Build(),
+				},
+			}},
+		},
+	}
+	route.SetGroupVersionKind(httprouteGVK)
+	service := &corev1.Service{
+		TypeMeta: metav1.TypeMeta{Kind: "Service", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "foo-svc",
+			Namespace:       corev1.NamespaceDefault,
+			UID:             "service-uid",
+			ResourceVersion: "1",
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{{Port: 80}},
+		},
+	}
+
+	cacheStores := store.NewCacheStores()
+	require.NoError(t, cacheStores.Add(route))
+	require.NoError(t, cacheStores.Add(service))
+	storer := store.New(cacheStores, annotations.DefaultIngressClass, logr.Discard())
+	translator, err := NewTranslator(logr.Discard(), storer, "", FeatureFlags{
+		IncrementalTranslation: true,
+	}, fakeSchemaServiceProvier{})
+	require.NoError(t, err)
+
+	routePaths := func(s *kongstate.KongState) []string {
+		var paths []string
+		for _, svc := range s.Services {
+			for _, r := range svc.Routes {
+				for _, p := range r.Paths {
+					paths = append(paths, *p)
+				}
+			}
+		}
+		return paths
+	}
+
+	t.Log("Translating for the first time, the HTTPRoute is translated")
+	first := translator.BuildKongConfig(context.Background())
+	require.Empty(t, first.TranslationFailures)
+	require.Equal(t, []string{"~/foo$"}, routePaths(first.KongState))
+	require.Equal(t, 0, translator.translationCache.hits)
+	require.Equal(t, 1, translator.translationCache.misses)
+
+	t.Log("Modifying the result, it must not affect the cached translation")
+	first.KongState.Services[0].Routes[0].Paths[0] = lo.ToPtr("/modified")
+
+	t.Log("Translating again without changes, the HTTPRoute is taken from the cache")
+	second := translator.BuildKongConfig(context.Background())
+	require.Equal(t, []string{"~/foo$"}, routePaths(second.KongState))
+	require.Equal(t, 1, translator.translationCache.hits)
+	require.Equal(t, 0, translator.translationCache.misses)
+
+	t.Log("Updating the Service used as a backend, the HTTPRoute is translated again")
+	updatedService := service.DeepCopy()
+	updatedService.ResourceVersion = "2"
+	require.NoError(t, cacheStores.Add(updatedService))
+	third := translator.BuildKongConfig(context.Background())
+	require.Equal(t, []string{"~/foo$"}, routePaths(third.KongState))
+	require.Equal(t, 0, translator.translationCache.hits)
+	require.Equal(t, 1, translator.translationCache.misses)
+
+	t.Log("Updating the HTTPRoute itself, it is translated again")
+	updatedRoute := route.DeepCopy()
+	updatedRoute.ResourceVersion = "2"
+	updatedRoute.Spec.Rules[0].Matches = append(updatedRoute.Spec.Rules[0].Matches,
+		builder.NewHTTPRouteMatch().WithPathExact("/bar").Build(),
+	)
+	require.NoError(t, cacheStores.Add(updatedRoute))
+	fourth := translator.BuildKongConfig(context.Background())
+	require.Equal(t, []string{"~/foo$", "~/bar$"}, routePaths(fourth.KongState))
+	require.Equal(t, 0, translator.translationCache.hits)
+	require.Equal(t, 1, translator.translationCache.misses)
+
+	t.Log("Deleting the HTTPRoute, its cache entry is pruned")
+	require.NoError(t, cacheStores.Delete(updatedRoute))
+	fifth := translator.BuildKongConfig(context.Background())
+	require.Empty(t, fifth.KongState.Services)
+	require.Empty(t, translator.translationCache.entries)
+}
+
+func TestTranslator_IncrementalTranslationMultipleRounds(t *testing.T) {
+	newRoute := func(name, path string, annotations map[string]string) *gatewayapi.HTTPRoute {
+		route := &gatewayapi.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				Namespace:       corev1.NamespaceDefault,
+				UID:             k8stypes.UID(name + "-uid"),
+				ResourceVersion: "1",
+				Annotations:     annotations,
+			},
+			Spec: gatewayapi.HTTPRouteSpec{
+				Rules: []gatewayapi.HTTPRouteRule{{
+					Matches: []gatewayapi.HTTPRouteMatch{
+						builder.NewHTTPRouteMatch().WithPathExact(path).Build(),
+					},
+					BackendRefs: []gatewayapi.HTTPBackendRef{
+						builder.NewHTTPBackendRef("foo-svc").WithPort(80).Build(),
+					},
+				}},
+			},
+		}
+		route.SetGroupVersionKind(httprouteGVK)
+		return route
+	}
+
+	for _, expressionRoutes := range []bool{false, true} {
+		t.Run(fmt.Sprintf("expression routes: %t", expressionRoutes), func(t *testing.T) {
+			service := &corev1.Service{
+				TypeMeta: metav1.TypeMeta{Kind: "Service", APIVersion: "v1"},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "foo-svc",
+					Namespace:       corev1.NamespaceDefault,
+					UID:             "service-uid",
+					ResourceVersion: "1",
+					Annotations: map[string]string{
+						annotations.AnnotationPrefix + annotations.PathKey: "/v1",
+					},
+				},
+				Spec: corev1.ServiceSpec{
+					Ports: []corev1.ServicePort{{Port: 80}},
+				},
+			}
+			secret := &corev1.Secret{
+				TypeMeta: metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "plugin-conf",
+					Namespace:       corev1.NamespaceDefault,
+					UID:             "secret-uid",
+					ResourceVersion: "1",
+				},
+				Data: map[string][]byte{
+					"config": []byte(`{"header_name": "x-foo"}`),
+				},
+			}
+			plugin := &kongv1.KongPlugin{
+				TypeMeta: metav1.TypeMeta{Kind: "KongPlugin", APIVersion: kongv1.GroupVersion.String()},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "correlation",
+					Namespace:       corev1.NamespaceDefault,
+					UID:             "plugin-uid",
+					ResourceVersion: "1",
+				},
+				PluginName: "correlation-id",
+				ConfigFrom: &kongv1.ConfigSource{
+					SecretValue: kongv1.SecretValueFromSource{
+						Secret: "plugin-conf",
+						Key:    "config",
+					},
+				},
+			}
+			withPlugin := newRoute("with-plugin", "/foo", map[string]string{
+				annotations.AnnotationPrefix + annotations.PluginsKey: "correlation",
+			})
+			withoutPlugin := newRoute("without-plugin", "/bar", nil)
+
+			cacheStores := store.NewCacheStores()
+			for _, obj := range []client.Object{service, secret, plugin, withPlugin, withoutPlugin} {
+				require.NoError(t, cacheStores.Add(obj))
+			}
+			storer := store.New(cacheStores, annotations.DefaultIngressClass, logr.Discard())
+			newTranslator := func(incremental bool) *Translator {
+				translator, err := NewTranslator(logr.Discard(), storer, "", FeatureFlags{
+					ExpressionRoutes:       expressionRoutes,
+					IncrementalTranslation: incremental,
+				}, fakeSchemaServiceProvier{})
+				require.NoError(t, err)
+				return translator
+			}
+			translator := newTranslator(true)
+
+			// translateAndCompare translates with the incremental translator and checks the result is identical
+			// to the one of a translator translating everything from scratch.
+			translateAndCompare := func(t *testing.T) *kongstate.KongState {
+				t.Helper()
+				result := translator.BuildKongConfig(context.Background())
+				require.Empty(t, result.TranslationFailures)
+				expected := newTranslator(false).BuildKongConfig(context.Background())
+				require.Empty(t, expected.TranslationFailures)
+				// Services and upstreams are built from a map, so their order is not stable.
+				for _, s := range []*kongstate.KongState{result.KongState, expected.KongState} {
+					slices.SortFunc(s.Services, func(a, b kongstate.Service) int { return strings.Compare(*a.Name, *b.Name) })
+					slices.SortFunc(s.Upstreams, func(a, b kongstate.Upstream) int { return strings.Compare(*a.Name, *b.Name) })
+				}
+				require.Equal(t, expected.KongState, result.KongState)
+				return result.KongState
+			}
+			requireHitsAndMisses := func(t *testing.T, hits, misses int) {
+				t.Helper()
+				require.Equal(t, hits, translator.translationCache.hits, "hits")
+				require.Equal(t, misses, translator.translationCache.misses, "misses")
+			}
+			pluginConfig := func(t *testing.T, s *kongstate.KongState) kong.Configuration {
+				t.Helper()
+				require.Len(t, s.Plugins, 1)
+				return s.Plugins[0].Config
+			}
+			servicePaths := func(s *kongstate.KongState) []string {
+				return lo.Map(s.Services, func(svc kongstate.Service, _ int) string { return *svc.Path })
+			}
+
+			t.Log("Translating for the first time, all HTTPRoutes are translated")
+			state := translateAndCompare(t)
+			requireHitsAndMisses(t, 0, 2)
+			require.Equal(t, []string{"/v1", "/v1"}, servicePaths(state))
+			require.Equal(t, "x-foo", pluginConfig(t, state)["header_name"])
+
+			t.Log("Translating again without changes, all HTTPRoutes are taken from the cache with an identical result")
+			translateAndCompare(t)
+			requireHitsAndMisses(t, 2, 0)
+			translateAndCompare(t)
+			requireHitsAndMisses(t, 2, 0)
+
+			t.Log("Updating the Service used as a backend, all HTTPRoutes are translated again")
+			service = service.DeepCopy()
+			service.ResourceVersion = "2"
+			service.Annotations[annotations.AnnotationPrefix+annotations.PathKey] = "/v2"
+			require.NoError(t, cacheStores.Add(service))
+			state = translateAndCompare(t)
+			requireHitsAndMisses(t, 0, 2)
+			require.Equal(t, []string{"/v2", "/v2"}, servicePaths(state))
+
+			t.Log("Updating the Secret used by the KongPlugin, only the HTTPRoute using the plugin is translated again")
+			secret = secret.DeepCopy()
+			secret.ResourceVersion = "2"
+			secret.Data["config"] = []byte(`{"header_name": "x-bar"}`)
+			require.NoError(t, cacheStores.Add(secret))
+			state = translateAndCompare(t)
+			requireHitsAndMisses(t, 1, 1)
+			require.Equal(t, "x-bar", pluginConfig(t, state)["header_name"])
+
+			t.Log("Updating the KongPlugin, only the HTTPRoute using the plugin is translated again")
+			plugin = plugin.DeepCopy()
+			plugin.ResourceVersion = "2"
+			plugin.Protocols = kongv1.StringsToKongProtocols([]string{"https"})
+			require.NoError(t, cacheStores.Add(plugin))
+			state = translateAndCompare(t)
+			requireHitsAndMisses(t, 1, 1)
+			require.Equal(t, []*string{lo.ToPtr("https")}, state.Plugins[0].Protocols)
+
+			t.Log("Translating again without changes, all HTTPRoutes are taken from the cache with an identical result")
+			translateAndCompare(t)
+			requireHitsAndMisses(t, 2, 0)
+		})
+	}
+}
+
+func TestTranslator_IncrementalTranslationReplaysTranslationFailures(t *testing.T) {
+	route := &gatewayapi.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "foo",
+			Namespace:       corev1.NamespaceDefault,
+			UID:             "route-uid",
+			ResourceVersion: "1",
+		},
+	}
+	route.SetGroupVersionKind(httprouteGVK)
+
+	cacheStores := store.NewCacheStores()
+	require.NoError(t, cacheStores.Add(route))
+	storer := store.New(cacheStores, annotations.DefaultIngressClass, logr.Discard())
+	translator, err := NewTranslator(logr.Discard(), storer, "", FeatureFlags{
+		IncrementalTranslation: true,
+	}, fakeSchemaServiceProvier{})
+	require.NoError(t, err)
+
+	translations := 0
+	translateRound := func(t *testing.T) []string {
+		t.Helper()
+		translator.translationCache.startRound(cacheStores)
+		rules := newIngressRules()
+		require.NoError(t, translator.translateWithCache(&rules, route, nil, "", func(*ingressRules) error {
+			translations++
+			translator.registerTranslationFailure("rule 1 is ignored", route)
+			return nil
+		}))
+		translator.translationCache.finishRound()
+		return lo.Map(translator.popTranslationFailures(), func(f failures.ResourceFailure, _ int) string {
+			return f.Message() + ": " + f.CausingObjects()[0].GetName()
+		})
+	}
+
+	t.Log("Translating for the first time, the failure is registered by the translation")
+	require.Equal(t, []string{"rule 1 is ignored: foo"}, translateRound(t))
+	require.Equal(t, 1, translations)
+
+	t.Log("Translating again without changes, the cached failure is registered again")
+	require.Equal(t, []string{"rule 1 is ignored: foo"}, translateRound(t))
+	require.Equal(t, 1, translations)
+	require.Equal(t, 1, translator.translationCache.hits)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/license"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/manager/featuregates"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/tracing"
 )
 
 // -----------------------------------------------------------------------------
@@ -52,6 +53,15 @@ type FeatureFlags struct {
 
 	// KongCustomEntity indicates whether we should support translating custom entities from KongCustomEntity CRs.
 	KongCustomEntity bool
+
+	// IncrementalTranslation enables caching results of translating HTTPRoutes and GRPCRoutes, so only routes that
+	// changed (or whose dependencies changed) since the previous translation are translated again.
+	IncrementalTranslation bool
+
+	// RejectConflictingRoutes enables removing the matches of the newer Kubernetes object's route when routes of two
+	// objects have the same matches, or when its matches shadow matches of the older object's route, instead of only
+	// reporting the conflict.
+	RejectConflictingRoutes bool
 }
 
 func NewFeatureFlags(
@@ -68,6 +78,7 @@ func NewFeatureFlags(
 		RewriteURIs:                       featureGates.Enabled(featuregates.RewriteURIsFeature),
 		KongServiceFacade:                 featureGates.Enabled(featuregates.KongServiceFacade),
 		KongCustomEntity:                  featureGates.Enabled(featuregates.KongCustomEntity),
+		IncrementalTranslation:            featureGates.Enabled(featuregates.IncrementalTranslation),
 	}
 }
 
@@ -92,6 +103,11 @@ type Translator struct {
 
 	failuresCollector          *failures.ResourceFailuresCollector
 	translatedObjectsCollector *ObjectsCollector
+	// hostnamePolicyViolations are objects with hostnames rejected by KongHostnamePolicies in the current translation.
+	hostnamePolicyViolations []client.Object
+
+	// translationCache is set only when IncrementalTranslation is enabled.
+	translationCache *translationCache
 }
 
 // NewTranslator produces a new Translator object provided a logging mechanism
@@ -111,6 +127,11 @@ func NewTranslator(
 		translatedObjectsCollector = NewObjectsCollector()
 	}
 
+	var translationCache *translationCache
+	if featureFlags.IncrementalTranslation {
+		translationCache = newTranslationCache(logger)
+	}
+
 	return &Translator{
 		logger:                     logger,
 		storer:                     storer,
@@ -119,6 +140,7 @@ func NewTranslator(
 		schemaServiceProvider:      schemaServiceProvider,
 		failuresCollector:          failuresCollector,
 		translatedObjectsCollector: translatedObjectsCollector,
+		translationCache:           translationCache,
 	}, nil
 }
 
@@ -137,6 +159,13 @@ type KongConfigBuildingResult struct {
 
 	// ConfiguredKubernetesObjects is a list of Kubernetes objects that were successfully translated.
 	ConfiguredKubernetesObjects []client.Object
+
+	// RouteConflicts is a list of conflicts between routes translated from different Kubernetes objects.
+	RouteConflicts []RouteConflict
+
+	// HostnamePolicyViolations is a list of Kubernetes objects with hostnames not allowed in their namespaces by
+	// KongHostnamePolicies. Each of them has a translation failure registered for every rejected hostname.
+	HostnamePolicyViolations []client.Object
 }
 
 // UpdateCache updates the store cache used by the translator.
@@ -146,102 +175,144 @@ func (t *Translator) UpdateCache(c store.CacheStores) {
 }
 
 // BuildKongConfig creates a Kong configuration from Ingress and Custom resources
-// defined in Kubernetes.
-func (t *Translator) BuildKongConfig() KongConfigBuildingResult {
+// defined in Kubernetes. Every phase of the translation is traced in a span that's a child of a span in ctx.
+func (t *Translator) BuildKongConfig(ctx context.Context) KongConfigBuildingResult {
+	ctx, span := tracing.StartSpan(ctx, "Translator.BuildKongConfig")
+	defer span.End()
+
+	if t.translationCache != nil {
+		t.translationCache.startRound(t.storer.CacheStores())
+	}
+
 	// Translate and merge all rules together from all Kubernetes API sources
 	ingressRules := mergeIngressRules(
-		t.ingressRulesFromIngressV1(),
-		t.ingressRulesFromTCPIngressV1beta1(),
-		t.ingressRulesFromUDPIngressV1beta1(),
-		t.ingressRulesFromHTTPRoutes(),
-		t.ingressRulesFromUDPRoutes(),
-		t.ingressRulesFromTCPRoutes(),
-		t.ingressRulesFromTLSRoutes(),
-		t.ingressRulesFromGRPCRoutes(),
+		traceIngressRules(ctx, "ingressRulesFromIngressV1", t.ingressRulesFromIngressV1),
+		traceIngressRules(ctx, "ingressRulesFromTCPIngressV1beta1", t.ingressRulesFromTCPIngressV1beta1),
+		traceIngressRules(ctx, "ingressRulesFromUDPIngressV1beta1", t.ingressRulesFromUDPIngressV1beta1),
+		traceIngressRules(ctx, "ingressRulesFromHTTPRoutes", t.ingressRulesFromHTTPRoutes),
+		traceIngressRules(ctx, "ingressRulesFromUDPRoutes", t.ingressRulesFromUDPRoutes),
+		traceIngressRules(ctx, "ingressRulesFromTCPRoutes", t.ingressRulesFromTCPRoutes),
+		traceIngressRules(ctx, "ingressRulesFromTLSRoutes", t.ingressRulesFromTLSRoutes),
+		traceIngressRules(ctx, "ingressRulesFromGRPCRoutes", t.ingressRulesFromGRPCRoutes),
 	)
+	if t.translationCache != nil {
+		t.translationCache.finishRound()
+	}
+
+	tracePhase(ctx, "applyGatewayClassDefaultPlugins", func() {
+		t.applyGatewayClassDefaultPlugins(&ingressRules)
+	})
 
-	// populate any Kubernetes Service objects relevant objects and get the
-	// services to be skipped because of annotations inconsistency
-	servicesToBeSkipped := ingressRules.populateServices(t.logger, t.storer, t.failuresCollector, t.translatedObjectsCollector)
+	tracePhase(ctx, "applyListenerHostnameIsolation", func() {
+		t.applyListenerHostnameIsolation(&ingressRules)
+	})
+
+	var routeConflicts []RouteConflict
+	tracePhase(ctx, "detectRouteConflicts", func() {
+		routeConflicts = t.detectRouteConflicts(&ingressRules)
+	})
 
 	// add the routes and services to the state
 	var result kongstate.KongState
 
-	// generate Upstreams and Targets from service defs
-	// update ServiceNameToServices with resolved ports (translating any name references to their number, as Kong
-	// services require a number)
-	result.Upstreams, ingressRules.ServiceNameToServices = t.getUpstreams(ingressRules.ServiceNameToServices)
-
-	for key, service := range ingressRules.ServiceNameToServices {
-		// if the service doesn't need to be skipped, then add it to the
-		// list of services.
-		if _, ok := servicesToBeSkipped[key]; !ok {
-			result.Services = append(result.Services, service)
+	tracePhase(ctx, "populateServices", func() {
+		// populate any Kubernetes Service objects relevant objects and get the
+		// services to be skipped because of annotations inconsistency
+		servicesToBeSkipped := ingressRules.populateServices(t.logger, t.storer, t.failuresCollector, t.translatedObjectsCollector)
+
+		// generate Upstreams and Targets from service defs
+		// update ServiceNameToServices with resolved ports (translating any name references to their number, as Kong
+		// services require a number)
+		result.Upstreams, ingressRules.ServiceNameToServices = t.getUpstreams(ingressRules.ServiceNameToServices)
+
+		for key, service := range ingressRules.ServiceNameToServices {
+			// if the service doesn't need to be skipped, then add it to the
+			// list of services.
+			if _, ok := servicesToBeSkipped[key]; !ok {
+				result.Services = append(result.Services, service)
+			}
 		}
-	}
-
-	// merge KongIngress with Routes, Services and Upstream
-	result.FillOverrides(t.logger, t.storer, t.failuresCollector)
-
-	// generate consumers and credentials
-	result.FillConsumersAndCredentials(t.logger, t.storer, t.failuresCollector)
-	for i := range result.Consumers {
-		t.registerSuccessfullyTranslatedObject(&result.Consumers[i].K8sKongConsumer)
-	}
+	})
+
+	tracePhase(ctx, "FillOverrides", func() {
+		// merge KongIngress with Routes, Services and Upstream
+		result.FillOverrides(t.logger, t.storer, t.failuresCollector)
+	})
+
+	tracePhase(ctx, "FillConsumersAndCredentials", func() {
+		// generate consumers and credentials
+		result.FillConsumersAndCredentials(t.logger, t.storer, t.failuresCollector)
+		for i := range result.Consumers {
+			t.registerSuccessfullyTranslatedObject(&result.Consumers[i].K8sKongConsumer)
+		}
+	})
 
-	// generate vaults
-	result.FillVaults(t.logger, t.storer, t.failuresCollector)
-	for i := range result.Vaults {
-		t.registerSuccessfullyTranslatedObject(result.Vaults[i].K8sKongVault)
-	}
+	tracePhase(ctx, "FillVaults", func() {
+		// generate vaults
+		result.FillVaults(t.logger, t.storer, t.failuresCollector)
+		for i := range result.Vaults {
+			t.registerSuccessfullyTranslatedObject(result.Vaults[i].K8sKongVault)
+		}
+	})
 
-	// process consumer groups
-	result.FillConsumerGroups(t.logger, t.storer)
-	for i := range result.ConsumerGroups {
-		t.registerSuccessfullyTranslatedObject(&result.ConsumerGroups[i].K8sKongConsumerGroup)
-	}
+	tracePhase(ctx, "FillConsumerGroups", func() {
+		// process consumer groups
+		result.FillConsumerGroups(t.logger, t.storer)
+		for i := range result.ConsumerGroups {
+			t.registerSuccessfullyTranslatedObject(&result.ConsumerGroups[i].K8sKongConsumerGroup)
+		}
+	})
 
-	// process annotation plugins
-	result.FillPlugins(t.logger, t.storer, t.failuresCollector)
-	for i := range result.Plugins {
-		t.registerSuccessfullyTranslatedObject(result.Plugins[i].K8sParent)
-	}
+	tracePhase(ctx, "FillPlugins", func() {
+		// process annotation plugins
+		result.FillPlugins(t.logger, t.storer, t.failuresCollector)
+		for i := range result.Plugins {
+			t.registerSuccessfullyTranslatedObject(result.Plugins[i].K8sParent)
+		}
+		// consumers' plugins and consumer groups' members and plugins are reported in their status
+		result.FillConsumersPlugins()
+		result.FillConsumerGroupsMembersAndPlugins()
+	})
 
 	// process custom entities
 	if t.featureFlags.KongCustomEntity {
-		result.FillCustomEntities(t.logger, t.storer, t.failuresCollector, t.schemaServiceProvider.GetSchemaService(), t.workspace)
-		// Register successcully translated KCEs to set the status of these KCEs.
-		for _, collection := range result.CustomEntities {
-			for i := range collection.Entities {
-				t.registerSuccessfullyTranslatedObject(collection.Entities[i].K8sKongCustomEntity)
+		tracePhase(ctx, "FillCustomEntities", func() {
+			result.FillCustomEntities(t.logger, t.storer, t.failuresCollector, t.schemaServiceProvider.GetSchemaService(), t.workspace)
+			// Register successcully translated KCEs to set the status of these KCEs.
+			for _, collection := range result.CustomEntities {
+				for i := range collection.Entities {
+					t.registerSuccessfullyTranslatedObject(collection.Entities[i].K8sKongCustomEntity)
+				}
 			}
-		}
-		// Update types of translated custom entities in the round of translation
-		// for dumping them from Kong gateway in config fetcher,
-		// because running full build of Kong configuration to get KongState is a heavy operation.
-		t.customEntityTypes = result.CustomEntityTypes()
+			// Update types of translated custom entities in the round of translation
+			// for dumping them from Kong gateway in config fetcher,
+			// because running full build of Kong configuration to get KongState is a heavy operation.
+			t.customEntityTypes = result.CustomEntityTypes()
+		})
 	}
 
-	// generate Certificates and SNIs
-	ingressCerts := t.getCerts(ingressRules.SecretNameToSNIs)
-	gatewayCerts := t.getGatewayCerts()
-	// note that ingress-derived certificates will take precedence over gateway-derived certificates for SNI assignment
-	var certIDsSeen certIDToMergedCertID
-	result.Certificates, certIDsSeen = mergeCerts(t.logger, ingressCerts, gatewayCerts)
-
-	// re-fill client certificate IDs of services after certificates are merged.
-	for i, s := range result.Services {
-		if s.ClientCertificate != nil && s.ClientCertificate.ID != nil {
-			certID := s.ClientCertificate.ID
-			mergedCertID := certIDsSeen[*certID]
-			result.Services[i].ClientCertificate = &kong.Certificate{
-				ID: kong.String(mergedCertID),
+	tracePhase(ctx, "FillCertificates", func() {
+		// generate Certificates and SNIs
+		ingressCerts := t.getCerts(ingressRules.SecretNameToSNIs)
+		gatewayCerts := t.getGatewayCerts()
+		// note that ingress-derived certificates will take precedence over gateway-derived certificates for SNI assignment
+		var certIDsSeen certIDToMergedCertID
+		result.Certificates, certIDsSeen = mergeCerts(t.logger, ingressCerts, gatewayCerts)
+
+		// re-fill client certificate IDs of services after certificates are merged.
+		for i, s := range result.Services {
+			if s.ClientCertificate != nil && s.ClientCertificate.ID != nil {
+				certID := s.ClientCertificate.ID
+				mergedCertID := certIDsSeen[*certID]
+				result.Services[i].ClientCertificate = &kong.Certificate{
+					ID: kong.String(mergedCertID),
+				}
 			}
 		}
-	}
 
-	// populate CA certificates in Kong
-	result.CACertificates = t.getCACerts()
+		// populate CA certificates in Kong
+		result.CACertificates = t.getCACerts()
+	})
 
 	if t.licenseGetter != nil && t.featureFlags.EnterpriseEdition {
 		optionalLicense := t.licenseGetter.GetLicense()
@@ -251,17 +322,35 @@ func (t *Translator) BuildKongConfig() KongConfigBuildingResult {
 	}
 
 	if t.featureFlags.FillIDs {
-		// generate IDs for Kong entities
-		result.FillIDs(t.logger, t.workspace)
+		tracePhase(ctx, "FillIDs", func() {
+			// generate IDs for Kong entities
+			result.FillIDs(t.logger, t.workspace)
+		})
 	}
 
 	return KongConfigBuildingResult{
 		KongState:                   &result,
 		TranslationFailures:         t.popTranslationFailures(),
 		ConfiguredKubernetesObjects: t.popConfiguredKubernetesObjects(),
+		RouteConflicts:              routeConflicts,
+		HostnamePolicyViolations:    t.popHostnamePolicyViolations(),
 	}
 }
 
+// traceIngressRules translates ingress rules from a single source of Kubernetes objects in a dedicated span.
+func traceIngressRules(ctx context.Context, name string, translate func() ingressRules) ingressRules {
+	_, span := tracing.StartSpan(ctx, "Translator."+name)
+	defer span.End()
+	return translate()
+}
+
+// tracePhase runs a single phase of building Kong configuration in a dedicated span.
+func tracePhase(ctx context.Context, name string, phase func()) {
+	_, span := tracing.StartSpan(ctx, "Translator."+name)
+	defer span.End()
+	phase()
+}
+
 // -----------------------------------------------------------------------------
 // Translator - Public Methods - Other Optional Features
 // -----------------------------------------------------------------------------
@@ -303,6 +392,20 @@ func (t *Translator) popConfiguredKubernetesObjects() []client.Object {
 	return t.translatedObjectsCollector.Pop()
 }
 
+// registerHostnamePolicyViolation should be called when any of the Kubernetes object's hostnames is rejected
+// by KongHostnamePolicies. It collects the object for reporting purposes.
+func (t *Translator) registerHostnamePolicyViolation(obj client.Object) {
+	t.hostnamePolicyViolations = append(t.hostnamePolicyViolations, obj)
+}
+
+// popHostnamePolicyViolations provides a list of all the Kubernetes objects with hostnames rejected by
+// KongHostnamePolicies as part of BuildKongConfig() call so far.
+func (t *Translator) popHostnamePolicyViolations() []client.Object {
+	violations := t.hostnamePolicyViolations
+	t.hostnamePolicyViolations = nil
+	return violations
+}
+
 // UnavailableSchemaService is a fake schema service used when no gateway admin API clients available.
 // It always returns error in its Get and Validate methods.
 type UnavailableSchemaService struct{}
@@ -1,6 +1,7 @@
 package translator
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strings"
@@ -13,6 +14,9 @@ import (
 	"github.com/samber/mo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
 	discoveryv1 "k8s.io/api/discovery/v1"
@@ -62,7 +66,7 @@ func TestGlobalPlugin(t *testing.T) {
 		})
 		require.NoError(t, err)
 		p := mustNewTranslator(t, store)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Empty(t, result.TranslationFailures)
 		state := result.KongState
 		require.NotNil(t, state)
@@ -252,7 +256,7 @@ func TestSecretConfigurationPlugin(t *testing.T) {
 			store, err := store.NewFakeStore(objects)
 			require.NoError(t, err)
 			p := mustNewTranslator(t, store)
-			result := p.BuildKongConfig()
+			result := p.BuildKongConfig(context.Background())
 			require.Empty(t, result.TranslationFailures)
 			require.NoError(t, err)
 			state := result.KongState
@@ -359,7 +363,7 @@ func TestSecretConfigurationPlugin(t *testing.T) {
 			store, err := store.NewFakeStore(objects)
 			require.NoError(t, err)
 			p := mustNewTranslator(t, store)
-			result := p.BuildKongConfig()
+			result := p.BuildKongConfig(context.Background())
 			require.Empty(t, result.TranslationFailures)
 			require.NoError(t, err)
 			state := result.KongState
@@ -463,7 +467,7 @@ func TestSecretConfigurationPlugin(t *testing.T) {
 			store, err := store.NewFakeStore(objects)
 			require.NoError(t, err)
 			p := mustNewTranslator(t, store)
-			result := p.BuildKongConfig()
+			result := p.BuildKongConfig(context.Background())
 			require.Empty(t, result.TranslationFailures)
 			state := result.KongState
 			require.NotNil(t, state)
@@ -516,7 +520,7 @@ func TestSecretConfigurationPlugin(t *testing.T) {
 		store, err := store.NewFakeStore(objects)
 		require.NoError(t, err)
 		p := mustNewTranslator(t, store)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Empty(t, result.TranslationFailures)
 		state := result.KongState
 		require.NotNil(t, state)
@@ -616,7 +620,7 @@ func TestSecretConfigurationPlugin(t *testing.T) {
 			store, err := store.NewFakeStore(objects)
 			require.NoError(t, err)
 			p := mustNewTranslator(t, store)
-			result := p.BuildKongConfig()
+			result := p.BuildKongConfig(context.Background())
 			require.Empty(t, result.TranslationFailures)
 			state := result.KongState
 			require.NotNil(t, state)
@@ -654,7 +658,7 @@ func TestCACertificate(t *testing.T) {
 		})
 		require.NoError(t, err)
 		p := mustNewTranslator(t, store)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Empty(t, result.TranslationFailures)
 		state := result.KongState
 		require.NotNil(t, state)
@@ -712,7 +716,7 @@ func TestCACertificate(t *testing.T) {
 		})
 		require.NoError(t, err)
 		p := mustNewTranslator(t, store)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Empty(t, result.TranslationFailures)
 		state := result.KongState
 		require.NotNil(t, state)
@@ -815,7 +819,7 @@ func TestCACertificate(t *testing.T) {
 		})
 		require.NoError(t, err)
 		p := mustNewTranslator(t, store)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		assert.Len(result.TranslationFailures, 4)
 		state := result.KongState
 		require.NotNil(t, state)
@@ -939,7 +943,7 @@ func TestServiceClientCertificate(t *testing.T) {
 		})
 		require.NoError(t, err)
 		p := mustNewTranslator(t, store)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Empty(t, result.TranslationFailures)
 		state := result.KongState
 		require.NotNil(t, state)
@@ -1016,7 +1020,7 @@ func TestServiceClientCertificate(t *testing.T) {
 		})
 		require.NoError(t, err)
 		p := mustNewTranslator(t, store)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Len(t, result.TranslationFailures, 1)
 		state := result.KongState
 		require.NotNil(t, state)
@@ -1107,7 +1111,7 @@ func TestServiceClientCertificate(t *testing.T) {
 		})
 		require.NoError(t, err)
 		p := mustNewTranslator(t, store)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 
 		require.Len(t, result.TranslationFailures, 1)
 		failure := result.TranslationFailures[0]
@@ -1173,7 +1177,7 @@ func TestKongRouteAnnotations(t *testing.T) {
 		})
 		require.NoError(t, err)
 		p := mustNewTranslator(t, store)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Empty(t, result.TranslationFailures)
 		state := result.KongState
 		require.NotNil(t, state)
@@ -1262,7 +1266,7 @@ func TestKongRouteAnnotations(t *testing.T) {
 		})
 		assert.NoError(t, err)
 		p := mustNewTranslator(t, store)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Empty(t, result.TranslationFailures)
 		state := result.KongState
 		require.NotNil(t, state)
@@ -1351,7 +1355,7 @@ func TestKongRouteAnnotations(t *testing.T) {
 		})
 		require.NoError(t, err)
 		p := mustNewTranslator(t, store)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Empty(t, result.TranslationFailures)
 		state := result.KongState
 		require.NotNil(t, state)
@@ -1443,7 +1447,7 @@ func TestKongRouteAnnotations(t *testing.T) {
 			})
 			require.NoError(t, err)
 			p := mustNewTranslator(t, store)
-			result := p.BuildKongConfig()
+			result := p.BuildKongConfig(context.Background())
 			require.Empty(t, result.TranslationFailures)
 			state := result.KongState
 			require.NotNil(t, state)
@@ -1532,7 +1536,7 @@ func TestKongRouteAnnotations(t *testing.T) {
 		})
 		require.NoError(t, err)
 		p := mustNewTranslator(t, store)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Empty(t, result.TranslationFailures)
 		state := result.KongState
 		require.NotNil(t, state)
@@ -1623,7 +1627,7 @@ func TestKongRouteAnnotations(t *testing.T) {
 		})
 		require.NoError(t, err)
 		p := mustNewTranslator(t, store)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Empty(t, result.TranslationFailures)
 		state := result.KongState
 		require.NotNil(t, state)
@@ -1713,7 +1717,7 @@ func TestKongRouteAnnotations(t *testing.T) {
 		})
 		require.NoError(t, err)
 		p := mustNewTranslator(t, store)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Empty(t, result.TranslationFailures)
 		state := result.KongState
 		require.NotNil(t, state)
@@ -1803,7 +1807,7 @@ func TestKongRouteAnnotations(t *testing.T) {
 		})
 		require.NoError(t, err)
 		p := mustNewTranslator(t, store)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Empty(t, result.TranslationFailures)
 		state := result.KongState
 		require.NotNil(t, state)
@@ -1894,7 +1898,7 @@ func TestKongRouteAnnotations(t *testing.T) {
 		})
 		require.NoError(t, err)
 		p := mustNewTranslator(t, store)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Empty(t, result.TranslationFailures)
 		state := result.KongState
 		require.NotNil(t, state)
@@ -1984,7 +1988,7 @@ func TestKongRouteAnnotations(t *testing.T) {
 		})
 		require.NoError(t, err)
 		p := mustNewTranslator(t, store)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Empty(t, result.TranslationFailures)
 		state := result.KongState
 		require.NotNil(t, state)
@@ -2074,7 +2078,7 @@ func TestKongRouteAnnotations(t *testing.T) {
 		})
 		require.NoError(t, err)
 		p := mustNewTranslator(t, store)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Empty(t, result.TranslationFailures)
 		state := result.KongState
 		require.NotNil(t, state)
@@ -2140,7 +2144,7 @@ func TestKongProcessClasslessIngress(t *testing.T) {
 		})
 		require.NoError(t, err)
 		p := mustNewTranslator(t, store)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Empty(t, result.TranslationFailures)
 		state := result.KongState
 		require.NotNil(t, state)
@@ -2195,7 +2199,7 @@ func TestKongProcessClasslessIngress(t *testing.T) {
 		})
 		require.NoError(t, err)
 		p := mustNewTranslator(t, store)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Empty(t, result.TranslationFailures)
 		state := result.KongState
 		require.NotNil(t, state)
@@ -2260,7 +2264,7 @@ func TestKongServiceAnnotations(t *testing.T) {
 		})
 		require.NoError(t, err)
 		p := mustNewTranslator(t, store)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Empty(t, result.TranslationFailures)
 		state := result.KongState
 		require.NotNil(t, state)
@@ -2352,7 +2356,7 @@ func TestKongServiceAnnotations(t *testing.T) {
 		})
 		require.NoError(t, err)
 		p := mustNewTranslator(t, store)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Empty(t, result.TranslationFailures)
 		state := result.KongState
 		require.NotNil(t, state)
@@ -2450,7 +2454,7 @@ func TestKongServiceAnnotations(t *testing.T) {
 		})
 		require.NoError(t, err)
 		p := mustNewTranslator(t, store)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Empty(t, result.TranslationFailures)
 		state := result.KongState
 		require.NotNil(t, state)
@@ -2540,7 +2544,7 @@ func TestDefaultBackend(t *testing.T) {
 		})
 		require.NoError(t, err)
 		p := mustNewTranslator(t, store)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Empty(t, result.TranslationFailures)
 		state := result.KongState
 		require.NotNil(t, state)
@@ -2622,7 +2626,7 @@ func TestDefaultBackend(t *testing.T) {
 		})
 		require.NoError(t, err)
 		p := mustNewTranslator(t, store)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Len(t, result.TranslationFailures, 1)
 		state := result.KongState
 		require.NotNil(t, state)
@@ -2675,7 +2679,7 @@ func TestDefaultBackend(t *testing.T) {
 
 		translator := mustNewTranslator(t, storer)
 		translator.featureFlags.KongServiceFacade = true
-		result := translator.BuildKongConfig()
+		result := translator.BuildKongConfig(context.Background())
 		require.Empty(t, result.TranslationFailures)
 		require.Len(t, result.KongState.Services, 1)
 		service := result.KongState.Services[0]
@@ -2745,7 +2749,7 @@ func TestTranslatorSecret(t *testing.T) {
 		})
 		require.NoError(t, err)
 		p := mustNewTranslator(t, store)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Empty(t, result.TranslationFailures)
 		state := result.KongState
 		require.NotNil(t, state)
@@ -2830,7 +2834,7 @@ func TestTranslatorSecret(t *testing.T) {
 		})
 		require.NoError(t, err)
 		p := mustNewTranslator(t, store)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Empty(t, result.TranslationFailures)
 		state := result.KongState
 		require.NotNil(t, state)
@@ -2959,7 +2963,7 @@ func TestTranslatorSecret(t *testing.T) {
 		})
 		require.NoError(t, err)
 		p := mustNewTranslator(t, store)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Empty(t, result.TranslationFailures)
 		state := result.KongState
 		require.NotNil(t, state)
@@ -3044,7 +3048,7 @@ func TestTranslatorSecret(t *testing.T) {
 		})
 		require.NoError(t, err)
 		p := mustNewTranslator(t, store)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Empty(t, result.TranslationFailures)
 		state := result.KongState
 		require.NotNil(t, state)
@@ -3134,7 +3138,7 @@ func TestTranslatorSNI(t *testing.T) {
 		})
 		require.NoError(t, err)
 		p := mustNewTranslator(t, store)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Empty(t, result.TranslationFailures)
 		state := result.KongState
 		require.NotNil(t, state)
@@ -3229,7 +3233,7 @@ func TestTranslatorSNI(t *testing.T) {
 		})
 		require.NoError(t, err)
 		p := mustNewTranslator(t, store)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Empty(t, result.TranslationFailures)
 		state := result.KongState
 		require.NotNil(t, state)
@@ -3296,7 +3300,7 @@ func TestTranslatorHostAliases(t *testing.T) {
 		})
 		require.NoError(t, err)
 		p := mustNewTranslator(t, store)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Empty(t, result.TranslationFailures)
 		state := result.KongState
 		require.NotNil(t, state)
@@ -3357,7 +3361,7 @@ func TestTranslatorHostAliases(t *testing.T) {
 		})
 		require.NoError(t, err)
 		p := mustNewTranslator(t, store)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Empty(t, result.TranslationFailures)
 		state := result.KongState
 		require.NotNil(t, state)
@@ -3419,7 +3423,7 @@ func TestTranslatorHostAliases(t *testing.T) {
 		})
 		require.NoError(t, err)
 		p := mustNewTranslator(t, store)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Empty(t, result.TranslationFailures)
 		state := result.KongState
 		require.NotNil(t, state)
@@ -3516,7 +3520,7 @@ func TestPluginAnnotations(t *testing.T) {
 		})
 		require.NoError(t, err)
 		p := mustNewTranslator(t, store)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Empty(t, result.TranslationFailures)
 		state := result.KongState
 		require.NotNil(t, state)
@@ -3620,7 +3624,7 @@ func TestPluginAnnotations(t *testing.T) {
 		})
 		require.NoError(t, err)
 		p := mustNewTranslator(t, store)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Empty(t, result.TranslationFailures)
 		state := result.KongState
 		require.NotNil(t, state)
@@ -3695,7 +3699,7 @@ func TestPluginAnnotations(t *testing.T) {
 		})
 		require.NoError(t, err)
 		p := mustNewTranslator(t, store)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Empty(t, result.TranslationFailures)
 		state := result.KongState
 		require.NotNil(t, state)
@@ -3747,7 +3751,7 @@ func TestPluginAnnotations(t *testing.T) {
 		})
 		require.NoError(t, err)
 		p := mustNewTranslator(t, store)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Empty(t, result.TranslationFailures)
 		state := result.KongState
 		require.NotNil(t, state)
@@ -4387,7 +4391,7 @@ func TestPickPort(t *testing.T) {
 			require.NoError(t, err)
 
 			p := mustNewTranslator(t, store)
-			result := p.BuildKongConfig()
+			result := p.BuildKongConfig(context.Background())
 			require.Empty(t, result.TranslationFailures)
 
 			require.Equal(t, tt.wantTarget, *result.KongState.Upstreams[0].Targets[0].Target.Target)
@@ -4510,7 +4514,7 @@ func TestCertificate(t *testing.T) {
 		})
 		require.NoError(t, err)
 		p := mustNewTranslator(t, store)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Empty(t, result.TranslationFailures)
 		state := result.KongState
 		require.NotNil(t, state)
@@ -4604,7 +4608,7 @@ func TestCertificate(t *testing.T) {
 		})
 		require.NoError(t, err)
 		p := mustNewTranslator(t, store)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Empty(t, result.TranslationFailures)
 		state := result.KongState
 		require.NotNil(t, state)
@@ -4684,7 +4688,7 @@ func TestTranslator_FillsEntitiesIDs(t *testing.T) {
 	require.NoError(t, err)
 	p := mustNewTranslator(t, s)
 
-	result := p.BuildKongConfig()
+	result := p.BuildKongConfig(context.Background())
 	require.Empty(t, result.TranslationFailures)
 	state := result.KongState
 	require.NotNil(t, state)
@@ -4765,13 +4769,13 @@ func TestTranslator_License(t *testing.T) {
 	p := mustNewTranslator(t, s)
 	p.featureFlags.EnterpriseEdition = true
 	t.Run("no license is populated by default", func(t *testing.T) {
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Empty(t, result.KongState.Licenses)
 	})
 
 	t.Run("no license is populated when license getter returns no license", func(t *testing.T) {
 		p.InjectLicenseGetter(&mockLicenseGetter{})
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Empty(t, result.KongState.Licenses)
 	})
 
@@ -4783,7 +4787,7 @@ func TestTranslator_License(t *testing.T) {
 			}),
 		}
 		p.InjectLicenseGetter(licenseGetterWithLicense)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Len(t, result.KongState.Licenses, 1)
 		license := result.KongState.Licenses[0]
 		require.Equal(t, "license-id", *license.ID)
@@ -4799,7 +4803,7 @@ func TestTranslator_License(t *testing.T) {
 			}),
 		}
 		p.InjectLicenseGetter(licenseGetterWithLicense)
-		result := p.BuildKongConfig()
+		result := p.BuildKongConfig(context.Background())
 		require.Empty(t, result.KongState.Licenses)
 	})
 }
@@ -5041,7 +5045,7 @@ func TestTranslator_ConfiguredKubernetesObjects(t *testing.T) {
 			s, _ := store.NewFakeStore(tc.objectsInStore)
 			p := mustNewTranslator(t, s)
 
-			result := p.BuildKongConfig()
+			result := p.BuildKongConfig(context.Background())
 			require.Len(t, result.ConfiguredKubernetesObjects, len(tc.expectedObjectsToBeConfigured))
 
 			for _, expectedObj := range tc.expectedObjectsToBeConfigured {
@@ -5209,7 +5213,7 @@ func TestTranslator_UpdateStore(t *testing.T) {
 	require.NoError(t, err)
 	translator := mustNewTranslator(t, originalStore)
 
-	originalBuildConfigResult := translator.BuildKongConfig()
+	originalBuildConfigResult := translator.BuildKongConfig(context.Background())
 
 	newStore, err := store.NewCacheStoresFromObjs(
 		&kongv1.KongConsumer{
@@ -5230,7 +5234,53 @@ func TestTranslator_UpdateStore(t *testing.T) {
 	require.NoError(t, err)
 	translator.UpdateCache(newStore)
 
-	newBuildConfigResult := translator.BuildKongConfig()
+	newBuildConfigResult := translator.BuildKongConfig(context.Background())
 	require.NotEqual(t, originalBuildConfigResult.KongState, newBuildConfigResult.KongState, "KongState should be different after updating the store")
 	require.Len(t, newBuildConfigResult.KongState.Consumers, 1, "expected 1 consumer in the KongState")
 }
+
+func TestTranslator_BuildKongConfigTracing(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	previousProvider := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	t.Cleanup(func() { otel.SetTracerProvider(previousProvider) })
+
+	s, err := store.NewFakeStore(store.FakeObjects{})
+	require.NoError(t, err)
+	translator := mustNewTranslator(t, s)
+
+	ctx, parent := otel.Tracer("test").Start(context.Background(), "parent")
+	translator.BuildKongConfig(ctx)
+	parent.End()
+
+	spans := exporter.GetSpans()
+	buildSpan, ok := lo.Find(spans, func(s tracetest.SpanStub) bool { return s.Name == "Translator.BuildKongConfig" })
+	require.True(t, ok, "expected Translator.BuildKongConfig span")
+	require.Equal(t, parent.SpanContext().SpanID(), buildSpan.Parent.SpanID())
+
+	phaseSpans := lo.Filter(spans, func(s tracetest.SpanStub, _ int) bool {
+		return s.Parent.SpanID() == buildSpan.SpanContext.SpanID()
+	})
+	phaseNames := lo.Map(phaseSpans, func(s tracetest.SpanStub, _ int) string { return s.Name })
+	require.Equal(t, []string{
+		"Translator.ingressRulesFromIngressV1",
+		"Translator.ingressRulesFromTCPIngressV1beta1",
+		"Translator.ingressRulesFromUDPIngressV1beta1",
+		"Translator.ingressRulesFromHTTPRoutes",
+		"Translator.ingressRulesFromUDPRoutes",
+		"Translator.ingressRulesFromTCPRoutes",
+		"Translator.ingressRulesFromTLSRoutes",
+		"Translator.ingressRulesFromGRPCRoutes",
+		"Translator.applyGatewayClassDefaultPlugins",
+		"Translator.applyListenerHostnameIsolation",
+		"Translator.detectRouteConflicts",
+		"Translator.populateServices",
+		"Translator.FillOverrides",
+		"Translator.FillConsumersAndCredentials",
+		"Translator.FillVaults",
+		"Translator.FillConsumerGroups",
+		"Translator.FillPlugins",
+		"Translator.FillCertificates",
+		"Translator.FillIDs",
+	}, phaseNames)
+}
@@ -18,6 +18,9 @@ type FallbackResponse struct {
 	ExcludedObjects []FallbackAffectedObjectMeta `json:"excludedObjects,omitempty"`
 	// BackfilledObjects is the list of objects that were backfilled from the last valid cache state.
 	BackfilledObjects []FallbackAffectedObjectMeta `json:"backfilledObjects,omitempty"`
+	// FailedObjects is the list of objects with the fail fallback policy that prevented generating the fallback
+	// configuration.
+	FailedObjects []FallbackAffectedObjectMeta `json:"failedObjects,omitempty"`
 }
 
 // FallbackStatus describes whether the fallback configuration generation was triggered or not.
@@ -48,4 +51,73 @@ type FallbackAffectedObjectMeta struct {
 	ID string `json:"id"`
 	// CausingObjects is the object that triggered this
 	CausingObjects []string `json:"causingObjects,omitempty"`
+	// Policy is the fallback policy the object was handled with.
+	Policy string `json:"policy,omitempty"`
+}
+
+// ConfigGraphResponse is the GET /debug/config/fallback/graph response schema.
+type ConfigGraphResponse struct {
+	// Vertices are the objects in the graph.
+	Vertices []ConfigGraphVertex `json:"vertices"`
+	// Edges are the dependencies between the objects. An edge goes from a dependency to its dependant.
+	Edges []ConfigGraphEdge `json:"edges"`
+}
+
+// ConfigGraphVertex is an object in the configuration graph.
+type ConfigGraphVertex struct {
+	// ID is the object identifier used in edges, e.g. configuration.konghq.com/KongPlugin:default/plugin.
+	ID string `json:"id"`
+	// Group is the resource group.
+	Group string `json:"group"`
+	// Kind is the resource kind.
+	Kind string `json:"kind"`
+	// Namespace is the object namespace.
+	Namespace string `json:"namespace,omitempty"`
+	// Name is the object name.
+	Name string `json:"name"`
+	// UID is the object UID.
+	UID string `json:"uid"`
+}
+
+// ConfigGraphEdge is a dependency between two objects in the configuration graph.
+type ConfigGraphEdge struct {
+	// From is the ID of the dependency.
+	From string `json:"from"`
+	// To is the ID of the dependant.
+	To string `json:"to"`
+}
+
+// RouteConflictsResponse is the GET /debug/config/route-conflicts response schema.
+type RouteConflictsResponse struct {
+	// Conflicts are the conflicts between routes found in the last translation.
+	Conflicts []RouteConflict `json:"conflicts"`
+}
+
+// RouteConflict describes routes of two Kubernetes objects that have the same or shadowing matches.
+type RouteConflict struct {
+	// Type is the conflict type: Exact when the routes have identical matches, Partial when only some of their
+	// matches are identical or Shadowed when some matches of one route shadow less specific matches of the other.
+	Type string `json:"type"`
+	// Objects are the objects the conflicting routes were translated from, the older object first.
+	Objects []RouteConflictObject `json:"objects"`
+	// Matches are descriptions of the matches both routes have, or of the shadowing matches.
+	Matches []string `json:"matches"`
+	// Rejected indicates that the matches were removed from the route of the newer object.
+	Rejected bool `json:"rejected"`
+}
+
+// RouteConflictObject is a route conflict object metadata.
+type RouteConflictObject struct {
+	// Group is the resource group.
+	Group string `json:"group"`
+	// Kind is the resource kind.
+	Kind string `json:"kind"`
+	// Namespace is the object namespace.
+	Namespace string `json:"namespace"`
+	// Name is the object name.
+	Name string `json:"name"`
+	// Route is the name of the conflicting Kong route translated from the object.
+	Route string `json:"route"`
+	// Shadowing indicates that the route has the shadowing matches of a Shadowed conflict.
+	Shadowing bool `json:"shadowing,omitempty"`
 }
@@ -0,0 +1,42 @@
+package diagnostics
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/fallback"
+)
+
+const (
+	// configGraphFormatJSON is the format of the configuration graph rendered as ConfigGraphResponse JSON.
+	configGraphFormatJSON = "json"
+	// configGraphFormatDOT is the format of the configuration graph rendered in the Graphviz DOT language.
+	configGraphFormatDOT = "dot"
+)
+
+// findObjectHash finds a vertex of the graph whose string representation (see fallback.ObjectHash.String) is
+// equal to the given one.
+func findObjectHash(am fallback.AdjacencyMap, object string) (fallback.ObjectHash, bool) {
+	for objHash := range am {
+		if objHash.String() == object {
+			return objHash, true
+		}
+	}
+	return fallback.ObjectHash{}, false
+}
+
+// renderConfigGraphDOT renders the configuration graph in the Graphviz DOT language.
+func renderConfigGraphDOT(graph ConfigGraphResponse) string {
+	var b strings.Builder
+	b.WriteString("digraph config {\n")
+	b.WriteString("\trankdir=LR;\n")
+	b.WriteString("\tnode [shape=box];\n")
+	for _, v := range graph.Vertices {
+		fmt.Fprintf(&b, "\t%q;\n", v.ID)
+	}
+	for _, e := range graph.Edges {
+		fmt.Fprintf(&b, "\t%q -> %q;\n", e.From, e.To)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
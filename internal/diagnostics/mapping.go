@@ -1,6 +1,9 @@
 package diagnostics
 
 import (
+	"cmp"
+	"slices"
+
 	"github.com/samber/lo"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/fallback"
@@ -36,6 +39,7 @@ func mapFallbackCacheMetadataIntoFallbackResponse(meta *fallback.GeneratedCacheM
 				CausingObjects: lo.Map(affectedObject.CausingObjects, func(objHash fallback.ObjectHash, _ int) string {
 					return objHash.String()
 				}),
+				Policy: string(affectedObject.Policy),
 			}
 		})
 	}
@@ -44,5 +48,38 @@ func mapFallbackCacheMetadataIntoFallbackResponse(meta *fallback.GeneratedCacheM
 		BrokenObjects:     brokenObjects,
 		ExcludedObjects:   mapAffectedObjectsMeta(meta.ExcludedObjects),
 		BackfilledObjects: mapAffectedObjectsMeta(meta.BackfilledObjects),
+		FailedObjects:     mapAffectedObjectsMeta(meta.FailedObjects),
+	}
+}
+
+// mapAdjacencyMapIntoConfigGraphResponse maps the configuration graph adjacency map into a ConfigGraphResponse.
+// Vertices and edges are sorted to make the response stable.
+func mapAdjacencyMapIntoConfigGraphResponse(am fallback.AdjacencyMap) ConfigGraphResponse {
+	resp := ConfigGraphResponse{
+		Vertices: make([]ConfigGraphVertex, 0, len(am)),
+		Edges:    []ConfigGraphEdge{},
 	}
+	for objHash, neighbours := range am {
+		resp.Vertices = append(resp.Vertices, ConfigGraphVertex{
+			ID:        objHash.String(),
+			Group:     objHash.Group,
+			Kind:      objHash.Kind,
+			Namespace: objHash.Namespace,
+			Name:      objHash.Name,
+			UID:       string(objHash.UID),
+		})
+		for _, neighbour := range neighbours {
+			resp.Edges = append(resp.Edges, ConfigGraphEdge{
+				From: objHash.String(),
+				To:   neighbour.String(),
+			})
+		}
+	}
+	slices.SortFunc(resp.Vertices, func(a, b ConfigGraphVertex) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+	slices.SortFunc(resp.Edges, func(a, b ConfigGraphEdge) int {
+		return cmp.Or(cmp.Compare(a.From, b.From), cmp.Compare(a.To, b.To))
+	})
+	return resp
 }
@@ -43,8 +43,17 @@ type Server struct {
 
 	currentFallbackCacheMetadata *fallback.GeneratedCacheMetadata
 
+	lastStagedRolloutStatus StagedRolloutStatus
+
+	currentConfigGraph *fallback.ConfigGraph
+
+	lastRouteConflicts []RouteConflict
+
 	configLock   *sync.RWMutex
 	fallbackLock *sync.RWMutex
+	rolloutLock  *sync.RWMutex
+	graphLock    *sync.RWMutex
+	conflictLock *sync.RWMutex
 }
 
 // ServerConfig contains configuration for the diagnostics server.
@@ -66,6 +75,12 @@ func NewServer(logger logr.Logger, cfg ServerConfig) Server {
 		profilingEnabled: cfg.ProfilingEnabled,
 		configLock:       &sync.RWMutex{},
 		fallbackLock:     &sync.RWMutex{},
+		rolloutLock:      &sync.RWMutex{},
+		graphLock:        &sync.RWMutex{},
+		conflictLock:     &sync.RWMutex{},
+		lastStagedRolloutStatus: StagedRolloutStatus{
+			Phase: StagedRolloutPhaseNone,
+		},
 	}
 
 	if cfg.ConfigDumpsEnabled {
@@ -73,6 +88,9 @@ func NewServer(logger logr.Logger, cfg ServerConfig) Server {
 			DumpsIncludeSensitive: cfg.DumpSensitiveConfig,
 			Configs:               make(chan ConfigDump, diagnosticConfigBufferDepth),
 			FallbackCacheMetadata: make(chan fallback.GeneratedCacheMetadata, diagnosticConfigBufferDepth),
+			StagedRollouts:        make(chan StagedRolloutStatus, diagnosticConfigBufferDepth),
+			ConfigGraphs:          make(chan *fallback.ConfigGraph, diagnosticConfigBufferDepth),
+			RouteConflicts:        make(chan []RouteConflict, diagnosticConfigBufferDepth),
 		}
 	}
 
@@ -133,6 +151,12 @@ func (s *Server) receiveConfig(ctx context.Context) {
 			s.onConfigDump(dump)
 		case meta := <-s.configDumps.FallbackCacheMetadata:
 			s.onFallbackCacheMetadata(meta)
+		case rollout := <-s.configDumps.StagedRollouts:
+			s.onStagedRolloutStatus(rollout)
+		case graph := <-s.configDumps.ConfigGraphs:
+			s.onConfigGraph(graph)
+		case conflicts := <-s.configDumps.RouteConflicts:
+			s.onRouteConflicts(conflicts)
 		case <-ctx.Done():
 			if err := ctx.Err(); err != nil && !errors.Is(err, context.Canceled) {
 				s.logger.Error(err, "Shutting down diagnostic config collection: context completed with error")
@@ -174,6 +198,24 @@ func (s *Server) onFallbackCacheMetadata(meta fallback.GeneratedCacheMetadata) {
 	s.currentFallbackCacheMetadata = &meta
 }
 
+func (s *Server) onStagedRolloutStatus(status StagedRolloutStatus) {
+	s.rolloutLock.Lock()
+	defer s.rolloutLock.Unlock()
+	s.lastStagedRolloutStatus = status
+}
+
+func (s *Server) onConfigGraph(graph *fallback.ConfigGraph) {
+	s.graphLock.Lock()
+	defer s.graphLock.Unlock()
+	s.currentConfigGraph = graph
+}
+
+func (s *Server) onRouteConflicts(conflicts []RouteConflict) {
+	s.conflictLock.Lock()
+	defer s.conflictLock.Unlock()
+	s.lastRouteConflicts = conflicts
+}
+
 // installProfilingHandlers adds the Profiling webservice to the given mux.
 func installProfilingHandlers(mux *http.ServeMux) {
 	mux.HandleFunc("/debug/pprof", redirectTo("/debug/pprof/"))
@@ -194,7 +236,10 @@ func (s *Server) installConfigDebugHandlers(mux *http.ServeMux) {
 	mux.HandleFunc("/debug/config/successful", s.handleLastValidConfig)
 	mux.HandleFunc("/debug/config/failed", s.handleLastFailedConfig)
 	mux.HandleFunc("/debug/config/fallback", s.handleCurrentFallback)
+	mux.HandleFunc("/debug/config/fallback/graph", s.handleConfigGraph)
 	mux.HandleFunc("/debug/config/raw-error", s.handleLastErrBody)
+	mux.HandleFunc("/debug/config/rollout", s.handleStagedRollout)
+	mux.HandleFunc("/debug/config/route-conflicts", s.handleRouteConflicts)
 }
 
 // redirectTo redirects request to a certain destination.
@@ -252,3 +297,80 @@ func (s *Server) handleLastErrBody(rw http.ResponseWriter, _ *http.Request) {
 		rw.WriteHeader(http.StatusInternalServerError)
 	}
 }
+
+func (s *Server) handleStagedRollout(rw http.ResponseWriter, _ *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	s.rolloutLock.RLock()
+	defer s.rolloutLock.RUnlock()
+	if err := json.NewEncoder(rw).Encode(s.lastStagedRolloutStatus); err != nil {
+		rw.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+func (s *Server) handleRouteConflicts(rw http.ResponseWriter, _ *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	s.conflictLock.RLock()
+	defer s.conflictLock.RUnlock()
+	resp := RouteConflictsResponse{Conflicts: s.lastRouteConflicts}
+	if resp.Conflicts == nil {
+		resp.Conflicts = []RouteConflict{}
+	}
+	if err := json.NewEncoder(rw).Encode(resp); err != nil {
+		rw.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+// handleConfigGraph serves the dependency graph of objects in the last processed cache snapshot. When the object
+// query parameter is set (e.g. object=configuration.konghq.com/KongPlugin:default/plugin), only the subgraph of
+// objects affected by that object being broken is served. The graph is rendered as JSON by default or as Graphviz
+// DOT when the format query parameter is set to dot.
+func (s *Server) handleConfigGraph(rw http.ResponseWriter, req *http.Request) {
+	s.graphLock.RLock()
+	graph := s.currentConfigGraph
+	s.graphLock.RUnlock()
+	if graph == nil {
+		http.Error(rw, "No configuration graph available. It's built only when the FallbackConfiguration feature gate is enabled.", http.StatusNotFound)
+		return
+	}
+
+	format := req.URL.Query().Get("format")
+	if format != "" && format != configGraphFormatJSON && format != configGraphFormatDOT {
+		http.Error(rw, fmt.Sprintf("Unsupported format %q, expected one of: %s, %s.", format, configGraphFormatJSON, configGraphFormatDOT), http.StatusBadRequest)
+		return
+	}
+
+	am, err := graph.AdjacencyMap()
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if object := req.URL.Query().Get("object"); object != "" {
+		hash, ok := findObjectHash(am, object)
+		if !ok {
+			http.Error(rw, fmt.Sprintf("Object %q not found in the configuration graph.", object), http.StatusNotFound)
+			return
+		}
+		subgraph, err := graph.Subgraph(hash)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if am, err = subgraph.AdjacencyMap(); err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	resp := mapAdjacencyMapIntoConfigGraphResponse(am)
+	if format == configGraphFormatDOT {
+		rw.Header().Set("Content-Type", "text/vnd.graphviz")
+		if _, err := rw.Write([]byte(renderConfigGraphDOT(resp))); err != nil {
+			rw.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(resp); err != nil {
+		rw.WriteHeader(http.StatusInternalServerError)
+	}
+}
@@ -2,16 +2,24 @@ package diagnostics
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"sync"
 	"testing"
 
 	"github.com/go-logr/logr"
 	"github.com/kong/go-database-reconciler/pkg/file"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/fallback"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
 	testhelpers "github.com/kong/kubernetes-ingress-controller/v3/test/helpers"
 )
 
@@ -142,4 +150,106 @@ func TestServer_EventsHandling(t *testing.T) {
 		require.Equal(t, successfulDump.Meta.Hash, s.lastSuccessHash)
 		require.Nil(t, s.currentFallbackCacheMetadata, "expected fallback cache metadata to be dropped as it's no more relevant")
 	})
+	t.Run("on staged rollout status", func(t *testing.T) {
+		require.Equal(t, StagedRolloutPhaseNone, s.lastStagedRolloutStatus.Phase)
+		status := StagedRolloutStatus{
+			Phase:    StagedRolloutPhaseSoaking,
+			Canaries: []string{"https://10.0.0.1:8444"},
+		}
+		s.onStagedRolloutStatus(status)
+		require.Equal(t, status, s.lastStagedRolloutStatus)
+	})
+	t.Run("on route conflicts", func(t *testing.T) {
+		get := func() RouteConflictsResponse {
+			rw := httptest.NewRecorder()
+			s.handleRouteConflicts(rw, httptest.NewRequest(http.MethodGet, "/debug/config/route-conflicts", nil))
+			require.Equal(t, http.StatusOK, rw.Code)
+			var resp RouteConflictsResponse
+			require.NoError(t, json.NewDecoder(rw.Body).Decode(&resp))
+			return resp
+		}
+		require.Equal(t, RouteConflictsResponse{Conflicts: []RouteConflict{}}, get())
+
+		conflicts := []RouteConflict{{
+			Type: "Exact",
+			Objects: []RouteConflictObject{
+				{Group: "networking.k8s.io", Kind: "Ingress", Namespace: "default", Name: "older", Route: "older-route"},
+				{Group: "gateway.networking.k8s.io", Kind: "HTTPRoute", Namespace: "default", Name: "newer", Route: "newer-route"},
+			},
+			Matches: []string{"https api.example.com / * *"},
+		}}
+		s.onRouteConflicts(conflicts)
+		require.Equal(t, RouteConflictsResponse{Conflicts: conflicts}, get())
+	})
+}
+
+func TestServer_HandleConfigGraph(t *testing.T) {
+	var (
+		plugin = &kongv1.KongPlugin{
+			TypeMeta:   metav1.TypeMeta{Kind: "KongPlugin", APIVersion: kongv1.GroupVersion.String()},
+			ObjectMeta: metav1.ObjectMeta{Name: "plugin", Namespace: "default", UID: "plugin-uid"},
+		}
+		service = &corev1.Service{
+			TypeMeta:   metav1.TypeMeta{Kind: "Service", APIVersion: "v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "service", Namespace: "default", UID: "service-uid"},
+		}
+		route = &gatewayapi.HTTPRoute{
+			TypeMeta:   metav1.TypeMeta{Kind: "HTTPRoute", APIVersion: gatewayapi.GroupVersion.String()},
+			ObjectMeta: metav1.ObjectMeta{Name: "route", Namespace: "default", UID: "route-uid"},
+		}
+	)
+	graph := fallback.NewConfigGraph()
+	for _, obj := range []client.Object{plugin, service, route} {
+		require.NoError(t, graph.AddVertex(obj))
+	}
+	require.NoError(t, graph.AddEdge(fallback.GetObjectHash(service), fallback.GetObjectHash(route)))
+
+	s := NewServer(logr.Discard(), ServerConfig{
+		ConfigDumpsEnabled: true,
+	})
+	get := func(t *testing.T, query string) *httptest.ResponseRecorder {
+		rw := httptest.NewRecorder()
+		s.handleConfigGraph(rw, httptest.NewRequest(http.MethodGet, "/debug/config/fallback/graph"+query, nil))
+		return rw
+	}
+
+	t.Run("no graph available yet", func(t *testing.T) {
+		require.Equal(t, http.StatusNotFound, get(t, "").Code)
+	})
+
+	s.onConfigGraph(graph)
+
+	t.Run("whole graph as JSON", func(t *testing.T) {
+		rw := get(t, "")
+		require.Equal(t, http.StatusOK, rw.Code)
+		var resp ConfigGraphResponse
+		require.NoError(t, json.NewDecoder(rw.Body).Decode(&resp))
+		require.Equal(t, []string{
+			"configuration.konghq.com/KongPlugin:default/plugin",
+			"core/Service:default/service",
+			"gateway.networking.k8s.io/HTTPRoute:default/route",
+		}, lo.Map(resp.Vertices, func(v ConfigGraphVertex, _ int) string { return v.ID }))
+		require.Equal(t, []ConfigGraphEdge{
+			{From: "core/Service:default/service", To: "gateway.networking.k8s.io/HTTPRoute:default/route"},
+		}, resp.Edges)
+	})
+	t.Run("subgraph of an object as DOT", func(t *testing.T) {
+		rw := get(t, "?format=dot&object=core/Service:default/service")
+		require.Equal(t, http.StatusOK, rw.Code)
+		require.Equal(t, "text/vnd.graphviz", rw.Header().Get("Content-Type"))
+		require.Equal(t, `digraph config {
+	rankdir=LR;
+	node [shape=box];
+	"core/Service:default/service";
+	"gateway.networking.k8s.io/HTTPRoute:default/route";
+	"core/Service:default/service" -> "gateway.networking.k8s.io/HTTPRoute:default/route";
+}
+`, rw.Body.String())
+	})
+	t.Run("unknown object", func(t *testing.T) {
+		require.Equal(t, http.StatusNotFound, get(t, "?object=core/Service:default/unknown").Code)
+	})
+	t.Run("unsupported format", func(t *testing.T) {
+		require.Equal(t, http.StatusBadRequest, get(t, "?format=yaml").Code)
+	})
 }
@@ -1,6 +1,8 @@
 package diagnostics
 
 import (
+	"time"
+
 	"github.com/kong/go-database-reconciler/pkg/file"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/fallback"
@@ -35,4 +37,45 @@ type ConfigDumpDiagnostic struct {
 	Configs chan ConfigDump
 	// FallbackCacheMetadata is the channel that receives fallback metadata from the fallback cache generator.
 	FallbackCacheMetadata chan fallback.GeneratedCacheMetadata
+	// StagedRollouts is the channel that receives statuses of staged configuration rollouts.
+	StagedRollouts chan StagedRolloutStatus
+	// ConfigGraphs is the channel that receives dependency graphs of objects in the processed cache snapshots.
+	ConfigGraphs chan *fallback.ConfigGraph
+	// RouteConflicts is the channel that receives conflicts between routes found in the last translation.
+	RouteConflicts chan []RouteConflict
+}
+
+// StagedRolloutStatus describes the state of the most recent staged configuration rollout.
+type StagedRolloutStatus struct {
+	// Phase is the phase of the rollout.
+	Phase StagedRolloutPhase `json:"phase"`
+	// Canaries are URLs of gateways that received the configuration first.
+	Canaries []string `json:"canaries,omitempty"`
+	// StartedAt is the time the configuration was pushed to the canaries.
+	StartedAt *time.Time `json:"startedAt,omitempty"`
+	// FinishedAt is the time the rollout was completed, rolled back or canceled.
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+	// Error describes why the rollout was rolled back or canceled.
+	Error string `json:"error,omitempty"`
 }
+
+// StagedRolloutPhase describes the phase of a staged configuration rollout.
+type StagedRolloutPhase string
+
+const (
+	// StagedRolloutPhaseNone indicates that no staged rollout has been performed yet.
+	StagedRolloutPhaseNone StagedRolloutPhase = "none"
+
+	// StagedRolloutPhaseSoaking indicates that the configuration was pushed to the canaries and their health is being checked.
+	StagedRolloutPhaseSoaking StagedRolloutPhase = "soaking"
+
+	// StagedRolloutPhaseCompleted indicates that the configuration was pushed to all the gateways.
+	StagedRolloutPhaseCompleted StagedRolloutPhase = "completed"
+
+	// StagedRolloutPhaseRolledBack indicates that the canaries were reverted to the last valid configuration.
+	StagedRolloutPhaseRolledBack StagedRolloutPhase = "rolled-back"
+
+	// StagedRolloutPhaseCanceled indicates that the rollout was stopped before completing as a newer configuration
+	// superseded it.
+	StagedRolloutPhaseCanceled StagedRolloutPhase = "canceled"
+)
@@ -15,17 +15,20 @@ var (
 // one version of the Gateway API to another with minimal changes to the codebase.
 
 type (
+	AbsoluteURI               = gatewayv1.AbsoluteURI
 	AllowedRoutes             = gatewayv1.AllowedRoutes
 	BackendObjectReference    = gatewayv1.BackendObjectReference
+	AddressType               = gatewayv1.AddressType
 	BackendRef                = gatewayv1.BackendRef
 	CommonRouteSpec           = gatewayv1.CommonRouteSpec
 	Duration                  = gatewayv1.Duration
 	Gateway                   = gatewayv1.Gateway
-	GatewayAddress            = gatewayv1.GatewayAddress
+	GatewayAddress            = gatewayv1.GatewaySpecAddress
 	GatewayClass              = gatewayv1.GatewayClass
 	GatewayClassList          = gatewayv1.GatewayClassList
 	GatewayClassSpec          = gatewayv1.GatewayClassSpec
 	GatewayClassStatus        = gatewayv1.GatewayClassStatus
+	GatewayConditionReason    = gatewayv1.GatewayConditionReason
 	GatewayController         = gatewayv1.GatewayController
 	GatewayList               = gatewayv1.GatewayList
 	GatewaySpec               = gatewayv1.GatewaySpec
@@ -33,12 +36,15 @@ type (
 	GatewayStatusAddress      = gatewayv1.GatewayStatusAddress
 	GatewayTLSConfig          = gatewayv1.GatewayTLSConfig
 	Group                     = gatewayv1.Group
+	HeaderMatchType           = gatewayv1.HeaderMatchType
 	HTTPBackendRef            = gatewayv1.HTTPBackendRef
+	HTTPCORSFilter            = gatewayv1.HTTPCORSFilter
 	HTTPHeader                = gatewayv1.HTTPHeader
 	HTTPHeaderFilter          = gatewayv1.HTTPHeaderFilter
 	HTTPHeaderMatch           = gatewayv1.HTTPHeaderMatch
 	HTTPHeaderName            = gatewayv1.HTTPHeaderName
 	HTTPMethod                = gatewayv1.HTTPMethod
+	HTTPMethodWithWildcard    = gatewayv1.HTTPMethodWithWildcard
 	HTTPPathMatch             = gatewayv1.HTTPPathMatch
 	HTTPQueryParamMatch       = gatewayv1.HTTPQueryParamMatch
 	HTTPRequestRedirectFilter = gatewayv1.HTTPRequestRedirectFilter
@@ -49,6 +55,8 @@ type (
 	HTTPPathModifier          = gatewayv1.HTTPPathModifier
 	HTTPRouteList             = gatewayv1.HTTPRouteList
 	HTTPRouteMatch            = gatewayv1.HTTPRouteMatch
+	HTTPRouteRetry            = gatewayv1.HTTPRouteRetry
+	HTTPRouteRetryStatusCode  = gatewayv1.HTTPRouteRetryStatusCode
 	HTTPRouteRule             = gatewayv1.HTTPRouteRule
 	HTTPRouteTimeouts         = gatewayv1.HTTPRouteTimeouts
 	LocalObjectReference      = gatewayv1.LocalObjectReference
@@ -62,6 +70,7 @@ type (
 	ListenerStatus            = gatewayv1.ListenerStatus
 	Namespace                 = gatewayv1.Namespace
 	ObjectName                = gatewayv1.ObjectName
+	ParametersReference       = gatewayv1.ParametersReference
 	ParentReference           = gatewayv1.ParentReference
 	PathMatchType             = gatewayv1.PathMatchType
 	PortNumber                = gatewayv1.PortNumber
@@ -79,8 +88,10 @@ type (
 	RouteStatus               = gatewayv1.RouteStatus
 	SecretObjectReference     = gatewayv1.SecretObjectReference
 	SectionName               = gatewayv1.SectionName
+	TLSModeType               = gatewayv1.TLSModeType
 	GRPCBackendRef            = gatewayv1.GRPCBackendRef
 	GRPCHeaderMatch           = gatewayv1.GRPCHeaderMatch
+	GRPCHeaderMatchType       = gatewayv1.GRPCHeaderMatchType
 	GRPCHeaderName            = gatewayv1.GRPCHeaderName
 	GRPCMethodMatch           = gatewayv1.GRPCMethodMatch
 	GRPCMethodMatchType       = gatewayv1.GRPCMethodMatchType
@@ -115,14 +126,21 @@ const (
 	PrefixMatchHTTPPathModifier           = gatewayv1.PrefixMatchHTTPPathModifier
 	GatewayClassConditionStatusAccepted   = gatewayv1.GatewayClassConditionStatusAccepted
 	GatewayClassReasonAccepted            = gatewayv1.GatewayClassReasonAccepted
+	GatewayClassReasonInvalidParameters   = gatewayv1.GatewayClassReasonInvalidParameters
 	GatewayConditionAccepted              = gatewayv1.GatewayConditionAccepted
 	GatewayConditionProgrammed            = gatewayv1.GatewayConditionProgrammed
 	GatewayReasonAccepted                 = gatewayv1.GatewayReasonAccepted
+	GatewayReasonAddressNotAssigned       = gatewayv1.GatewayReasonAddressNotAssigned
+	GatewayReasonAddressNotUsable         = gatewayv1.GatewayReasonAddressNotUsable
+	GatewayReasonInvalid                  = gatewayv1.GatewayReasonInvalid
 	GatewayReasonPending                  = gatewayv1.GatewayReasonPending
 	GatewayReasonProgrammed               = gatewayv1.GatewayReasonProgrammed
+	GatewayReasonUnsupportedAddress       = gatewayv1.GatewayReasonUnsupportedAddress
+	GRPCHeaderMatchExact                  = gatewayv1.GRPCHeaderMatchExact
 	HTTPMethodDelete                      = gatewayv1.HTTPMethodDelete
 	HTTPMethodGet                         = gatewayv1.HTTPMethodGet
 	HTTPProtocolType                      = gatewayv1.HTTPProtocolType
+	HTTPRouteFilterCORS                   = gatewayv1.HTTPRouteFilterCORS
 	HTTPRouteFilterExtensionRef           = gatewayv1.HTTPRouteFilterExtensionRef
 	HTTPRouteFilterRequestHeaderModifier  = gatewayv1.HTTPRouteFilterRequestHeaderModifier
 	HTTPRouteFilterRequestMirror          = gatewayv1.HTTPRouteFilterRequestMirror
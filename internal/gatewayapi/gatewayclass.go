@@ -0,0 +1,15 @@
+package gatewayapi
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GatewayClassHasInvalidParameters tells whether the GatewayClass is not accepted because of its invalid
+// parametersRef, so the parameters it references must not be used.
+func GatewayClassHasInvalidParameters(gwc *GatewayClass) bool {
+	accepted := meta.FindStatusCondition(gwc.Status.Conditions, string(GatewayClassConditionStatusAccepted))
+	return accepted != nil &&
+		accepted.Status == metav1.ConditionFalse &&
+		accepted.Reason == string(GatewayClassReasonInvalidParameters)
+}
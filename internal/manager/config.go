@@ -24,6 +24,7 @@ import (
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/manager/featuregates"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/manager/flags"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/manager/metadata"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/tracing"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/kubernetes/object/status"
 )
 
@@ -56,7 +57,9 @@ type Config struct {
 	KongWorkspace                     string
 	AnonymousReports                  bool
 	EnableReverseSync                 bool
+	CompressDBLessConfig              bool
 	UseLastValidConfigForFallback     bool
+	RejectConflictingRoutes           bool
 	SyncPeriod                        time.Duration
 	SkipCACertificates                bool
 	CacheSyncTimeout                  time.Duration
@@ -76,6 +79,11 @@ type Config struct {
 	GatewayDiscoveryDNSStrategy cfgtypes.DNSStrategy
 	KongAdminSvcPortNames       []string
 	ProxySyncSeconds            float32
+	ProxySyncDebounce           time.Duration
+	ProxySyncMaxDelay           time.Duration
+	StagedRolloutCanaryReplicas int
+	StagedRolloutSoakPeriod     time.Duration
+	StagedRolloutHealthCheckURL []string
 	InitCacheSyncDuration       time.Duration
 	ProxyTimeoutSeconds         float32
 
@@ -117,6 +125,10 @@ type Config struct {
 	KongVaultEnabled              bool
 	KongLicenseEnabled            bool
 	KongCustomEntityEnabled       bool
+	KongPluginPolicyEnabled       bool
+	KongExternalBackendEnabled    bool
+	KongHostnamePolicyEnabled     bool
+	GatewayClassParametersEnabled bool
 
 	// Gateway API toggling.
 	GatewayAPIGatewayController        bool
@@ -136,6 +148,9 @@ type Config struct {
 	DumpSensitiveConfig  bool
 	DiagnosticServerPort int
 
+	// Tracing of the configuration translation and synchronization
+	Tracing tracing.Config
+
 	// Feature Gates
 	FeatureGates map[string]bool
 
@@ -181,9 +196,11 @@ func (c *Config) FlagSet() *pflag.FlagSet {
 	flagSet.StringVar(&c.KongWorkspace, "kong-workspace", "", "Kong Enterprise workspace to configure. Leave this empty if not using Kong workspaces.")
 	flagSet.BoolVar(&c.AnonymousReports, "anonymous-reports", true, `Send anonymized usage data to help improve Kong.`)
 	flagSet.BoolVar(&c.EnableReverseSync, "enable-reverse-sync", false, `Send configuration to Kong even if the configuration checksum has not changed since previous update.`)
+	flagSet.BoolVar(&c.CompressDBLessConfig, "compress-dbless-config", false, `Compress configuration sent to Kong in DB-less mode with gzip. Configuration is sent uncompressed to gateways that fail to decode it.`)
 	// TODO: When FallbackConfiguration graduates we should remove the feature gate mention from the help text.
 	// https://github.com/Kong/kubernetes-ingress-controller/issues/6170
 	flagSet.BoolVar(&c.UseLastValidConfigForFallback, "use-last-valid-config-for-fallback", false, fmt.Sprintf(`When recovering from config push failures, use the last valid configuration cache to backfill broken objects. It can only be used with the %s feature gate enabled.`, featuregates.FallbackConfiguration))
+	flagSet.BoolVar(&c.RejectConflictingRoutes, "reject-conflicting-routes", false, `Remove the matches of the newer object's routes that are identical to or shadow matches of routes of other Ingresses, HTTPRoutes, GRPCRoutes or TCPIngresses. Conflicts are only reported as warnings when disabled.`)
 	// Default has to be explicitly passed to generate the proper docs. See https://github.com/kubernetes-sigs/controller-runtime/blob/f1c5dd3851ce3df8b4b7830d9b6eae6271f6932d/pkg/cache/cache.go#L146-L151.
 	flagSet.DurationVar(&c.SyncPeriod, "sync-period", 10*time.Hour, `Determine the minimum frequency at which watched resources are reconciled. Set to 0 to use default from controller-runtime.`)
 	flagSet.BoolVar(&c.SkipCACertificates, "skip-ca-certificates", false, `Disable syncing CA certificate syncing (for use with multi-workspace environments).`)
@@ -212,7 +229,17 @@ func (c *Config) FlagSet() *pflag.FlagSet {
 	flagSet.StringVar(&c.MetricsAddr, "metrics-bind-address", fmt.Sprintf(":%v", MetricsPort), "The address the metric endpoint binds to.")
 	flagSet.StringVar(&c.ProbeAddr, "health-probe-bind-address", fmt.Sprintf(":%v", HealthzPort), "The address the probe endpoint binds to.")
 	flagSet.Float32Var(&c.ProxySyncSeconds, "proxy-sync-seconds", dataplane.DefaultSyncSeconds,
-		"Define the rate (in seconds) in which configuration updates will be applied to the Kong Admin API.")
+		"Define the rate (in seconds) in which configuration updates will be applied to the Kong Admin API regardless of changes of Kubernetes objects.")
+	flagSet.DurationVar(&c.ProxySyncDebounce, "proxy-sync-debounce", dataplane.DefaultSyncDebounce,
+		"The period without changes of Kubernetes objects after which the changes are applied to the Kong Admin API. Set to 0 to apply configuration updates only periodically (see --proxy-sync-seconds).")
+	flagSet.DurationVar(&c.ProxySyncMaxDelay, "proxy-sync-max-delay", dataplane.DefaultSyncMaxDelay,
+		"The maximum period changes of Kubernetes objects can be delayed for by --proxy-sync-debounce when they keep coming.")
+	flagSet.IntVar(&c.StagedRolloutCanaryReplicas, "staged-rollout-canary-replicas", 0,
+		"Number of gateway replicas receiving a new configuration first, before it's pushed to the rest of them. Set to 0 to disable staged rollouts. Supported only in DB-less mode.")
+	flagSet.DurationVar(&c.StagedRolloutSoakPeriod, "staged-rollout-soak-period", dataplane.DefaultStagedRolloutSoakPeriod,
+		"The period canary gateway replicas have to stay healthy for after receiving a new configuration before it's pushed to the rest of them.")
+	flagSet.StringSliceVar(&c.StagedRolloutHealthCheckURL, "staged-rollout-health-check-url", nil,
+		`URL(s) in comma-separated format (or specify this flag multiple times) that have to respond with a status code lower than 500 during a staged rollout soak period for canary gateway replicas to be considered healthy. Canaries' Admin API /status endpoint is always checked.`)
 	flagSet.DurationVar(&c.InitCacheSyncDuration, "init-cache-sync-duration", dataplane.DefaultCacheSyncWaitDuration, `The initial delay to wait for Kubernetes object caches to be synced before the initial configuration.`)
 	flagSet.Float32Var(&c.ProxyTimeoutSeconds, "proxy-timeout-seconds", dataplane.DefaultTimeoutSeconds,
 		"Sets the timeout (in seconds) for all requests to Kong's Admin API.")
@@ -272,6 +299,10 @@ func (c *Config) FlagSet() *pflag.FlagSet {
 	flagSet.BoolVar(&c.KongVaultEnabled, "enable-controller-kong-vault", true, "Enable the KongVault controller.")
 	flagSet.BoolVar(&c.KongLicenseEnabled, "enable-controller-kong-license", true, "Enable the KongLicense controller.")
 	flagSet.BoolVar(&c.KongCustomEntityEnabled, "enable-controller-kong-custom-entity", true, "Enable the KongCustomEntity controller.")
+	flagSet.BoolVar(&c.KongPluginPolicyEnabled, "enable-controller-kong-plugin-policy", true, "Enable the KongPluginPolicy controller.")
+	flagSet.BoolVar(&c.KongExternalBackendEnabled, "enable-controller-kong-external-backend", true, "Enable the KongExternalBackend controller.")
+	flagSet.BoolVar(&c.KongHostnamePolicyEnabled, "enable-controller-kong-hostname-policy", true, "Enable the KongHostnamePolicy controller.")
+	flagSet.BoolVar(&c.GatewayClassParametersEnabled, "enable-controller-gateway-class-parameters", true, "Enable the GatewayClassParameters controller.")
 
 	// Admission Webhook server config
 	flagSet.StringVar(&c.AdmissionServer.ListenAddr, "admission-webhook-listen", "off",
@@ -294,6 +325,11 @@ func (c *Config) FlagSet() *pflag.FlagSet {
 	flagSet.IntVar(&c.DiagnosticServerPort, "diagnostic-server-port", DiagnosticsPort, "The port to listen on for the profiling and config dump server.")
 	_ = flagSet.MarkHidden("diagnostic-server-port")
 
+	// Tracing
+	flagSet.StringVar(&c.Tracing.OTLPEndpoint, "tracing-otlp-endpoint", "",
+		`OTLP gRPC collector address in "host:port" format to export traces of translating and pushing configuration to. Tracing is disabled when empty.`)
+	flagSet.BoolVar(&c.Tracing.OTLPInsecure, "tracing-otlp-insecure", false, "Disable TLS when exporting traces to the OTLP collector.")
+
 	// Feature Gates (see FEATURE_GATES.md).
 	flagSet.Var(cliflag.NewMapStringBool(&c.FeatureGates), "feature-gates", "A set of comma separated key=value pairs that describe feature gates for alpha/beta/experimental features. "+
 		fmt.Sprintf("See the Feature Gates documentation for information and available options: %s.", featuregates.DocsURL))
@@ -74,6 +74,12 @@ func (c *Config) Validate() error {
 	if err := c.validateFallbackConfiguration(); err != nil {
 		return fmt.Errorf("invalid fallback config settings: %w", err)
 	}
+	if err := c.validateProxySync(); err != nil {
+		return fmt.Errorf("invalid proxy sync settings: %w", err)
+	}
+	if err := c.validateStagedRollout(); err != nil {
+		return fmt.Errorf("invalid staged rollout settings: %w", err)
+	}
 
 	return nil
 }
@@ -119,6 +125,27 @@ func (c *Config) validateFallbackConfiguration() error {
 	return nil
 }
 
+func (c *Config) validateProxySync() error {
+	if c.ProxySyncDebounce < 0 {
+		return errors.New("--proxy-sync-debounce can't be negative")
+	}
+	if c.ProxySyncDebounce > 0 && c.ProxySyncMaxDelay < c.ProxySyncDebounce {
+		return fmt.Errorf("--proxy-sync-max-delay (%s) can't be shorter than --proxy-sync-debounce (%s)",
+			c.ProxySyncMaxDelay, c.ProxySyncDebounce)
+	}
+	return nil
+}
+
+func (c *Config) validateStagedRollout() error {
+	if c.StagedRolloutCanaryReplicas < 0 {
+		return errors.New("--staged-rollout-canary-replicas can't be negative")
+	}
+	if c.StagedRolloutCanaryReplicas > 0 && c.StagedRolloutSoakPeriod <= 0 {
+		return errors.New("--staged-rollout-soak-period has to be positive when staged rollouts are enabled")
+	}
+	return nil
+}
+
 func validateClientTLS(clientTLS adminapi.TLSClientConfig) error {
 	if clientTLS.Cert != "" && clientTLS.CertFile != "" {
 		return errors.New("both client certificate and client certificate file specified, only one allowed")
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/samber/mo"
 	"github.com/stretchr/testify/require"
@@ -320,4 +321,55 @@ func TestConfigValidate(t *testing.T) {
 			require.NoError(t, c.Validate())
 		})
 	})
+
+	t.Run("--proxy-sync-debounce and --proxy-sync-max-delay", func(t *testing.T) {
+		t.Run("max delay shorter than debounce is rejected", func(t *testing.T) {
+			c := manager.Config{
+				ProxySyncDebounce: 2 * time.Second,
+				ProxySyncMaxDelay: time.Second,
+			}
+			require.ErrorContains(t, c.Validate(), "--proxy-sync-max-delay (1s) can't be shorter than --proxy-sync-debounce (2s)")
+		})
+		t.Run("negative debounce is rejected", func(t *testing.T) {
+			c := manager.Config{
+				ProxySyncDebounce: -time.Second,
+			}
+			require.ErrorContains(t, c.Validate(), "--proxy-sync-debounce can't be negative")
+		})
+		t.Run("disabled debounce is accepted regardless of max delay", func(t *testing.T) {
+			c := manager.Config{
+				ProxySyncDebounce: 0,
+			}
+			require.NoError(t, c.Validate())
+		})
+		t.Run("max delay longer than debounce is accepted", func(t *testing.T) {
+			c := manager.Config{
+				ProxySyncDebounce: time.Second,
+				ProxySyncMaxDelay: 5 * time.Second,
+			}
+			require.NoError(t, c.Validate())
+		})
+	})
+
+	t.Run("staged rollout", func(t *testing.T) {
+		t.Run("negative canary replicas are rejected", func(t *testing.T) {
+			c := manager.Config{
+				StagedRolloutCanaryReplicas: -1,
+			}
+			require.ErrorContains(t, c.Validate(), "--staged-rollout-canary-replicas can't be negative")
+		})
+		t.Run("non-positive soak period is rejected when enabled", func(t *testing.T) {
+			c := manager.Config{
+				StagedRolloutCanaryReplicas: 1,
+			}
+			require.ErrorContains(t, c.Validate(), "--staged-rollout-soak-period has to be positive when staged rollouts are enabled")
+		})
+		t.Run("enabled with soak period is accepted", func(t *testing.T) {
+			c := manager.Config{
+				StagedRolloutCanaryReplicas: 1,
+				StagedRolloutSoakPeriod:     time.Minute,
+			}
+			require.NoError(t, c.Validate())
+		})
+	})
 }
@@ -22,3 +22,6 @@ const DiagnosticsPort = 10256
 
 // KongClientEventRecorderComponentName is a KongClient component name used to identify the events recording component.
 const KongClientEventRecorderComponentName = "kong-client"
+
+// KongLicenseEventRecorderComponentName is a KongLicense controller component name used to identify the events recording component.
+const KongLicenseEventRecorderComponentName = "kong-license-controller"
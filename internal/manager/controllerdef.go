@@ -18,6 +18,7 @@ import (
 	ctrlref "github.com/kong/kubernetes-ingress-controller/v3/internal/controllers/reference"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/controllers/utils"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane"
+	dpconf "github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/config"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/manager/featuregates"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/kubernetes/object/status"
 )
@@ -63,6 +64,7 @@ func setupControllers(
 	dataplaneAddressFinder *dataplane.AddressFinder,
 	udpDataplaneAddressFinder *dataplane.AddressFinder,
 	kubernetesStatusQueue *status.Queue,
+	routerFlavor dpconf.RouterFlavor,
 	c *Config,
 	featureGates featuregates.FeatureGates,
 	kongAdminAPIEndpointsNotifier configuration.EndpointsNotifier,
@@ -310,6 +312,46 @@ func setupControllers(
 				StatusQueue:                kubernetesStatusQueue,
 			},
 		},
+		{
+			Enabled: c.KongPluginPolicyEnabled,
+			Controller: &configuration.KongV1Alpha1KongPluginPolicyReconciler{
+				Client:           mgr.GetClient(),
+				Log:              ctrl.LoggerFrom(ctx).WithName("controllers").WithName("KongPluginPolicy"),
+				Scheme:           mgr.GetScheme(),
+				DataplaneClient:  dataplaneClient,
+				CacheSyncTimeout: c.CacheSyncTimeout,
+			},
+		},
+		{
+			Enabled: c.KongExternalBackendEnabled,
+			Controller: &configuration.KongV1Alpha1KongExternalBackendReconciler{
+				Client:           mgr.GetClient(),
+				Log:              ctrl.LoggerFrom(ctx).WithName("controllers").WithName("KongExternalBackend"),
+				Scheme:           mgr.GetScheme(),
+				DataplaneClient:  dataplaneClient,
+				CacheSyncTimeout: c.CacheSyncTimeout,
+			},
+		},
+		{
+			Enabled: c.KongHostnamePolicyEnabled,
+			Controller: &configuration.KongV1Alpha1KongHostnamePolicyReconciler{
+				Client:           mgr.GetClient(),
+				Log:              ctrl.LoggerFrom(ctx).WithName("controllers").WithName("KongHostnamePolicy"),
+				Scheme:           mgr.GetScheme(),
+				DataplaneClient:  dataplaneClient,
+				CacheSyncTimeout: c.CacheSyncTimeout,
+			},
+		},
+		{
+			Enabled: c.GatewayClassParametersEnabled,
+			Controller: &configuration.KongV1Alpha1GatewayClassParametersReconciler{
+				Client:           mgr.GetClient(),
+				Log:              ctrl.LoggerFrom(ctx).WithName("controllers").WithName("GatewayClassParameters"),
+				Scheme:           mgr.GetScheme(),
+				DataplaneClient:  dataplaneClient,
+				CacheSyncTimeout: c.CacheSyncTimeout,
+			},
+		},
 		// ---------------------------------------------------------------------------
 		// Gateway API Controllers
 		// ---------------------------------------------------------------------------
@@ -333,6 +375,7 @@ func setupControllers(
 					CacheSyncTimeout:     c.CacheSyncTimeout,
 					ReferenceIndexers:    referenceIndexers,
 					GatewayNN:            controllers.NewOptionalNamespacedName(c.GatewayToReconcile),
+					RouterFlavor:         routerFlavor,
 				},
 			},
 		},
@@ -39,6 +39,11 @@ const (
 	// https://github.com/Kong/kubernetes-ingress-controller/issues/6124
 	KongCustomEntity = "KongCustomEntity"
 
+	// IncrementalTranslation is the name of the feature-gate that enables caching results of translating
+	// HTTPRoutes and GRPCRoutes, so only routes that changed (or whose dependencies changed) since the previous
+	// translation are translated again.
+	IncrementalTranslation = "IncrementalTranslation"
+
 	// DocsURL provides a link to the documentation for feature gates in the KIC repository.
 	DocsURL = "https://github.com/Kong/kubernetes-ingress-controller/blob/main/FEATURE_GATES.md"
 )
@@ -86,5 +91,6 @@ func GetFeatureGatesDefaults() FeatureGates {
 		SanitizeKonnectConfigDumps: true,
 		FallbackConfiguration:      false,
 		KongCustomEntity:           false,
+		IncrementalTranslation:     false,
 	}
 }
@@ -38,6 +38,7 @@ import (
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/manager/telemetry"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/manager/utils/kongconfig"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/tracing"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/kubernetes/object/status"
 )
@@ -64,6 +65,16 @@ func Run(
 	if err != nil {
 		return fmt.Errorf("failed to configure feature gates: %w", err)
 	}
+	shutdownTracing, err := tracing.Setup(ctx, c.Tracing, metadata.Release)
+	if err != nil {
+		return fmt.Errorf("failed to set up tracing: %w", err)
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			setupLog.Error(err, "Failed to shut down tracing")
+		}
+	}()
+
 	setupLog.Info("Getting the kubernetes client configuration")
 	kubeconfig, err := c.GetKubeconfig()
 	if err != nil {
@@ -117,6 +128,7 @@ func Run(
 	kongConfig := sendconfig.Config{
 		Version:                       kongSemVersion,
 		InMemory:                      dbMode.IsDBLessMode(),
+		CompressDBLessConfig:          c.CompressDBLessConfig,
 		Concurrency:                   c.Concurrency,
 		FilterTags:                    c.FilterTags,
 		SkipCACertificates:            c.SkipCACertificates,
@@ -125,6 +137,11 @@ func Run(
 		SanitizeKonnectConfigDumps:    featureGates.Enabled(featuregates.SanitizeKonnectConfigDumps),
 		FallbackConfiguration:         featureGates.Enabled(featuregates.FallbackConfiguration),
 		UseLastValidConfigForFallback: c.UseLastValidConfigForFallback,
+		StagedRollout: sendconfig.StagedRolloutConfig{
+			CanaryReplicas:  c.StagedRolloutCanaryReplicas,
+			SoakPeriod:      c.StagedRolloutSoakPeriod,
+			HealthCheckURLs: c.StagedRolloutHealthCheckURL,
+		},
 	}
 
 	setupLog.Info("Configuring and building the controller manager")
@@ -176,6 +193,7 @@ func Run(
 		c.UpdateStatus,
 		kongStartUpConfig.Version.IsKongGatewayEnterprise(),
 	)
+	translatorFeatureFlags.RejectConflictingRoutes = c.RejectConflictingRoutes
 
 	referenceIndexers := ctrlref.NewCacheIndexers(setupLog.WithName("reference-indexers"))
 	cache := store.NewCacheStores()
@@ -214,7 +232,7 @@ func Run(
 	}
 
 	setupLog.Info("Initializing Dataplane Synchronizer")
-	synchronizer, err := setupDataplaneSynchronizer(logger, mgr, dataplaneClient, c.ProxySyncSeconds, c.InitCacheSyncDuration)
+	synchronizer, err := setupDataplaneSynchronizer(logger, mgr, dataplaneClient, c.ProxySyncSeconds, c.ProxySyncDebounce, c.ProxySyncMaxDelay, c.InitCacheSyncDuration)
 	if err != nil {
 		return fmt.Errorf("unable to initialize dataplane synchronizer: %w", err)
 	}
@@ -243,6 +261,7 @@ func Run(
 		dataplaneAddressFinder,
 		udpDataplaneAddressFinder,
 		kubernetesStatusQueue,
+		routerFlavor,
 		c,
 		featureGates,
 		clientsManager,
@@ -35,6 +35,7 @@ import (
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/license"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/logging"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/manager/scheme"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/metrics"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/kubernetes/object/status"
 )
@@ -158,6 +159,8 @@ func setupDataplaneSynchronizer(
 	mgr manager.Manager,
 	dataplaneClient dataplane.Client,
 	proxySyncSeconds float32,
+	proxySyncDebounce time.Duration,
+	proxySyncMaxDelay time.Duration,
 	initCacheSyncWait time.Duration,
 ) (*dataplane.Synchronizer, error) {
 	if proxySyncSeconds < dataplane.DefaultSyncSeconds {
@@ -173,6 +176,7 @@ func setupDataplaneSynchronizer(
 		dataplaneClient,
 		dataplane.WithStagger(time.Duration(proxySyncSeconds*float32(time.Second))),
 		dataplane.WithInitCacheSyncDuration(initCacheSyncWait),
+		dataplane.WithSyncDebounce(proxySyncDebounce, proxySyncMaxDelay),
 	)
 	if err != nil {
 		return nil, err
@@ -451,6 +455,14 @@ func setupLicenseGetter(
 	// Enable KongLicense controller if license synchornizition from Konnect is disabled.
 	if c.KongLicenseEnabled && !c.Konnect.LicenseSynchronizationEnabled {
 		setupLog.Info("Starting KongLicense controller")
+		licenseControllerOpts := []ctrllicense.ReconcilerOpt{
+			ctrllicense.WithExpirationRecorder(metrics.NewLicenseMetrics()),
+		}
+		if c.EmitKubernetesEvents {
+			licenseControllerOpts = append(licenseControllerOpts,
+				ctrllicense.WithEventRecorder(mgr.GetEventRecorderFor(KongLicenseEventRecorderComponentName)),
+			)
+		}
 		licenseController := ctrllicense.NewKongV1Alpha1KongLicenseReconciler(
 			mgr.GetClient(),
 			ctrl.LoggerFrom(ctx).WithName("controllers").WithName("KongLicense"),
@@ -461,6 +473,7 @@ func setupLicenseGetter(
 			ctrllicense.LicenseControllerTypeKIC,
 			mo.Some(c.LeaderElectionID),
 			mo.None[ctrllicense.ValidatorFunc](),
+			licenseControllerOpts...,
 		)
 		dynamicLicenseController := ctrllicense.WrapKongLicenseReconcilerToDynamicCRDController(
 			ctx, mgr, licenseController,
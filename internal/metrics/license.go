@@ -0,0 +1,68 @@
+package metrics
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// KongLicenseKey defines the key of the metric label indicating the name of a KongLicense.
+	KongLicenseKey string = "kong_license"
+)
+
+// License metrics names.
+const (
+	MetricNameKongLicenseExpirationTime = "ingress_controller_kong_license_expiration_timestamp_seconds"
+)
+
+// LicenseMetrics are the metrics of KongLicenses reconciled by the controller.
+type LicenseMetrics struct {
+	KongLicenseExpirationTime *prometheus.GaugeVec
+}
+
+func NewLicenseMetrics() *LicenseMetrics {
+	_lock.Lock()
+	defer _lock.Unlock()
+
+	licenseMetrics := &LicenseMetrics{}
+
+	licenseMetrics.KongLicenseExpirationTime = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: MetricNameKongLicenseExpirationTime,
+			Help: fmt.Sprintf(
+				"Expiration time of KongLicenses in seconds since the Unix epoch, parsed from their payloads. "+
+					"`%s` describes the name of the KongLicense. "+
+					"KongLicenses without an expiration date are not reported.",
+				KongLicenseKey,
+			),
+		},
+		[]string{KongLicenseKey},
+	)
+
+	allMetrics := []prometheus.Collector{
+		licenseMetrics.KongLicenseExpirationTime,
+	}
+	for _, m := range allMetrics {
+		metrics.Registry.Unregister(m)
+		metrics.Registry.MustRegister(m)
+	}
+
+	return licenseMetrics
+}
+
+// RecordKongLicenseExpiration records the expiration time of a KongLicense.
+func (m *LicenseMetrics) RecordKongLicenseExpiration(name string, expiresAt time.Time) {
+	m.KongLicenseExpirationTime.With(prometheus.Labels{
+		KongLicenseKey: name,
+	}).Set(float64(expiresAt.Unix()))
+}
+
+// DeleteKongLicenseExpiration removes the expiration time of a KongLicense, e.g. when it's deleted.
+func (m *LicenseMetrics) DeleteKongLicenseExpiration(name string) {
+	m.KongLicenseExpirationTime.Delete(prometheus.Labels{
+		KongLicenseKey: name,
+	})
+}
@@ -0,0 +1,22 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordKongLicenseExpiration(t *testing.T) {
+	m := NewLicenseMetrics()
+	expiresAt := time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	m.RecordKongLicenseExpiration("license-1", expiresAt)
+	m.RecordKongLicenseExpiration("license-2", expiresAt.AddDate(1, 0, 0))
+	require.Equal(t, float64(expiresAt.Unix()), testutil.ToFloat64(m.KongLicenseExpirationTime.WithLabelValues("license-1")))
+	require.Equal(t, 2, testutil.CollectAndCount(m.KongLicenseExpirationTime))
+
+	m.DeleteKongLicenseExpiration("license-2")
+	require.Equal(t, 1, testutil.CollectAndCount(m.KongLicenseExpirationTime), "deleted license should not be reported")
+}
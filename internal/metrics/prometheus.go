@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"strconv"
 	"sync"
 	"time"
 
@@ -23,6 +24,17 @@ type CtrlFuncMetrics struct {
 	TranslationBrokenResources prometheus.Gauge
 	ConfigPushDuration         *prometheus.HistogramVec
 	ConfigPushSuccessTime      *prometheus.GaugeVec
+	ConfigSyncLatency          prometheus.Histogram
+
+	// Config push payload metrics.
+	ConfigPushConfigSize   *prometheus.HistogramVec
+	ConfigPushPayloadSize  *prometheus.HistogramVec
+	ConfigPushBufferedSize *prometheus.HistogramVec
+
+	// Config contents metrics.
+	ConfigEntityCount       *prometheus.GaugeVec
+	ConfigPluginCount       *prometheus.GaugeVec
+	ConfigCustomEntityCount *prometheus.GaugeVec
 
 	// Fallback config push metrics.
 	FallbackTranslationCount           *prometheus.CounterVec
@@ -34,6 +46,10 @@ type CtrlFuncMetrics struct {
 	FallbackCacheGeneratingDuration    *prometheus.HistogramVec
 	ProcessedConfigSnapshotCacheHit    prometheus.Counter
 	ProcessedConfigSnapshotCacheMiss   prometheus.Counter
+
+	// Staged rollout metrics.
+	StagedRolloutCount      *prometheus.CounterVec
+	StagedRolloutInProgress prometheus.Gauge
 }
 
 const (
@@ -72,6 +88,62 @@ const (
 	FailureReasonKey string = "failure_reason"
 )
 
+const (
+	// StagedRolloutResultCompleted indicates that a staged rollout reached all the gateways.
+	StagedRolloutResultCompleted string = "completed"
+
+	// StagedRolloutResultRolledBack indicates that a staged rollout was stopped and the canaries were reverted.
+	StagedRolloutResultRolledBack string = "rolled_back"
+
+	// StagedRolloutResultCanceled indicates that a staged rollout was stopped as a newer configuration superseded it.
+	StagedRolloutResultCanceled string = "canceled"
+
+	// StagedRolloutResultKey defines the key of the metric label indicating the result of a staged rollout.
+	StagedRolloutResultKey string = "result"
+)
+
+const (
+	// EncodingGzip indicates that the configuration payload was gzip-compressed.
+	EncodingGzip string = "gzip"
+
+	// EncodingIdentity indicates that the configuration payload was not compressed.
+	EncodingIdentity string = "identity"
+
+	// EncodingKey defines the key of the metric label indicating the encoding of a configuration payload.
+	EncodingKey string = "encoding"
+)
+
+const (
+	// FallbackKey defines the key of the metric label indicating whether the configuration is a fallback one
+	// (`true`) or not (`false`).
+	FallbackKey string = "fallback"
+)
+
+const (
+	// EntityTypeService indicates Kong services.
+	EntityTypeService string = "service"
+	// EntityTypeRoute indicates Kong routes.
+	EntityTypeRoute string = "route"
+	// EntityTypeUpstream indicates Kong upstreams.
+	EntityTypeUpstream string = "upstream"
+	// EntityTypeTarget indicates Kong targets.
+	EntityTypeTarget string = "target"
+	// EntityTypePlugin indicates Kong plugins.
+	EntityTypePlugin string = "plugin"
+	// EntityTypeConsumer indicates Kong consumers.
+	EntityTypeConsumer string = "consumer"
+	// EntityTypeCertificate indicates Kong certificates.
+	EntityTypeCertificate string = "certificate"
+	// EntityTypeConsumerGroup indicates Kong consumer groups.
+	EntityTypeConsumerGroup string = "consumer_group"
+
+	// EntityTypeKey defines the key of the metric label indicating the type of Kong entities.
+	EntityTypeKey string = "entity_type"
+
+	// PluginNameKey defines the key of the metric label indicating the name of Kong plugins.
+	PluginNameKey string = "plugin_name"
+)
+
 const (
 	// DataplaneKey defines the name of the metric label indicating which dataplane this time series is relevant for.
 	DataplaneKey string = "dataplane"
@@ -85,6 +157,21 @@ const (
 	MetricNameTranslationCount           = "ingress_controller_translation_count"
 	MetricNameTranslationBrokenResources = "ingress_controller_translation_broken_resource_count"
 	MetricNameConfigPushDuration         = "ingress_controller_configuration_push_duration_milliseconds"
+	MetricNameConfigSyncLatency          = "ingress_controller_configuration_sync_latency_milliseconds"
+)
+
+// Config push payload metrics names.
+const (
+	MetricNameConfigPushConfigSize   = "ingress_controller_configuration_push_config_size_bytes"
+	MetricNameConfigPushPayloadSize  = "ingress_controller_configuration_push_payload_size_bytes"
+	MetricNameConfigPushBufferedSize = "ingress_controller_configuration_push_buffered_size_bytes"
+)
+
+// Config contents metrics names.
+const (
+	MetricNameConfigEntityCount       = "ingress_controller_configuration_entity_count"
+	MetricNameConfigPluginCount       = "ingress_controller_configuration_plugin_count"
+	MetricNameConfigCustomEntityCount = "ingress_controller_configuration_custom_entity_count"
 )
 
 // Fallback config push metrics names.
@@ -100,6 +187,12 @@ const (
 	MetricNameProcessedConfigSnapshotCacheMiss   = "ingress_controller_processed_config_snapshot_cache_miss"
 )
 
+// Staged rollout metrics names.
+const (
+	MetricNameStagedRolloutCount      = "ingress_controller_staged_rollout_count"
+	MetricNameStagedRolloutInProgress = "ingress_controller_staged_rollout_in_progress"
+)
+
 var _lock sync.Mutex
 
 func NewCtrlFuncMetrics() *CtrlFuncMetrics {
@@ -189,6 +282,113 @@ func NewCtrlFuncMetrics() *CtrlFuncMetrics {
 		[]string{DataplaneKey},
 	)
 
+	controllerMetrics.ConfigSyncLatency = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Name: MetricNameConfigSyncLatency,
+			Help: "How long it took from a change of a Kubernetes object to a successful configuration push " +
+				"including the change, in milliseconds.",
+			Buckets: prometheus.ExponentialBuckets(100, 1.33, 30),
+		},
+	)
+
+	controllerMetrics.ConfigPushConfigSize = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: MetricNameConfigPushConfigSize,
+			Help: fmt.Sprintf(
+				"Size of the marshaled configuration pushed to Kong in DB-less mode, in bytes. "+
+					"`%s` describes the dataplane that was the target of configuration push. "+
+					"`%s` describes whether the configuration was a fallback one (`true`) or not (`false`).",
+				DataplaneKey,
+				FallbackKey,
+			),
+			Buckets: prometheus.ExponentialBuckets(1024, 4, 12),
+		},
+		[]string{DataplaneKey, FallbackKey},
+	)
+
+	controllerMetrics.ConfigPushPayloadSize = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: MetricNameConfigPushPayloadSize,
+			Help: fmt.Sprintf(
+				"Size of the request body sent to Kong when pushing configuration in DB-less mode, in bytes. "+
+					"`%s` describes the dataplane that was the target of configuration push. "+
+					"`%s` describes whether the configuration was a fallback one (`true`) or not (`false`). "+
+					"`%s` describes whether the payload was compressed (`%s`) or not (`%s`).",
+				DataplaneKey,
+				FallbackKey,
+				EncodingKey, EncodingGzip, EncodingIdentity,
+			),
+			Buckets: prometheus.ExponentialBuckets(1024, 4, 12),
+		},
+		[]string{DataplaneKey, FallbackKey, EncodingKey},
+	)
+
+	controllerMetrics.ConfigPushBufferedSize = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: MetricNameConfigPushBufferedSize,
+			Help: fmt.Sprintf(
+				"Size of the largest part of the marshaled configuration held in memory at once when streaming it "+
+					"to Kong in DB-less mode, in bytes. "+
+					"`%s` describes the dataplane that was the target of configuration push. "+
+					"`%s` describes whether the configuration was a fallback one (`true`) or not (`false`).",
+				DataplaneKey,
+				FallbackKey,
+			),
+			Buckets: prometheus.ExponentialBuckets(1024, 4, 12),
+		},
+		[]string{DataplaneKey, FallbackKey},
+	)
+
+	controllerMetrics.ConfigEntityCount = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: MetricNameConfigEntityCount,
+			Help: fmt.Sprintf(
+				"The number of Kong entities in the latest configuration pushed to Kong. "+
+					"`%s` describes the dataplane that was the target of configuration push. "+
+					"`%s` describes whether the configuration was a fallback one (`true`) or not (`false`). "+
+					"`%s` describes the type of entities (one of `%s`, `%s`, `%s`, `%s`, `%s`, `%s`, `%s`, `%s`).",
+				DataplaneKey,
+				FallbackKey,
+				EntityTypeKey,
+				EntityTypeService, EntityTypeRoute, EntityTypeUpstream, EntityTypeTarget,
+				EntityTypePlugin, EntityTypeConsumer, EntityTypeConsumerGroup, EntityTypeCertificate,
+			),
+		},
+		[]string{DataplaneKey, FallbackKey, EntityTypeKey},
+	)
+
+	controllerMetrics.ConfigPluginCount = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: MetricNameConfigPluginCount,
+			Help: fmt.Sprintf(
+				"The number of Kong plugins in the latest configuration pushed to Kong. "+
+					"`%s` describes the dataplane that was the target of configuration push. "+
+					"`%s` describes whether the configuration was a fallback one (`true`) or not (`false`). "+
+					"`%s` describes the name of plugins.",
+				DataplaneKey,
+				FallbackKey,
+				PluginNameKey,
+			),
+		},
+		[]string{DataplaneKey, FallbackKey, PluginNameKey},
+	)
+
+	controllerMetrics.ConfigCustomEntityCount = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: MetricNameConfigCustomEntityCount,
+			Help: fmt.Sprintf(
+				"The number of Kong custom entities in the latest configuration pushed to Kong. "+
+					"`%s` describes the dataplane that was the target of configuration push. "+
+					"`%s` describes whether the configuration was a fallback one (`true`) or not (`false`). "+
+					"`%s` describes the type of custom entities.",
+				DataplaneKey,
+				FallbackKey,
+				EntityTypeKey,
+			),
+		},
+		[]string{DataplaneKey, FallbackKey, EntityTypeKey},
+	)
+
 	controllerMetrics.FallbackTranslationCount = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: MetricNameFallbackTranslationCount,
@@ -295,6 +495,28 @@ func NewCtrlFuncMetrics() *CtrlFuncMetrics {
 		},
 	)
 
+	controllerMetrics.StagedRolloutCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: MetricNameStagedRolloutCount,
+			Help: fmt.Sprintf(
+				"Count of staged configuration rollouts. "+
+					"`%s` describes whether the configuration reached all the gateways (`%s`), the canaries "+
+					"were reverted to the last valid configuration (`%s`) or the rollout was superseded by "+
+					"a newer configuration (`%s`).",
+				StagedRolloutResultKey, StagedRolloutResultCompleted, StagedRolloutResultRolledBack, StagedRolloutResultCanceled,
+			),
+		},
+		[]string{StagedRolloutResultKey},
+	)
+
+	controllerMetrics.StagedRolloutInProgress = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: MetricNameStagedRolloutInProgress,
+			Help: "Whether a staged configuration rollout is in progress (1), i.e. canaries received a new " +
+				"configuration and their health is being checked, or not (0).",
+		},
+	)
+
 	allMetrics := []prometheus.Collector{
 		controllerMetrics.ConfigPushCount,
 		controllerMetrics.ConfigPushBrokenResources,
@@ -302,6 +524,13 @@ func NewCtrlFuncMetrics() *CtrlFuncMetrics {
 		controllerMetrics.TranslationBrokenResources,
 		controllerMetrics.ConfigPushDuration,
 		controllerMetrics.ConfigPushSuccessTime,
+		controllerMetrics.ConfigSyncLatency,
+		controllerMetrics.ConfigPushConfigSize,
+		controllerMetrics.ConfigPushPayloadSize,
+		controllerMetrics.ConfigPushBufferedSize,
+		controllerMetrics.ConfigEntityCount,
+		controllerMetrics.ConfigPluginCount,
+		controllerMetrics.ConfigCustomEntityCount,
 		controllerMetrics.FallbackTranslationBrokenResources,
 		controllerMetrics.FallbackTranslationCount,
 		controllerMetrics.FallbackConfigPushCount,
@@ -311,6 +540,8 @@ func NewCtrlFuncMetrics() *CtrlFuncMetrics {
 		controllerMetrics.FallbackCacheGeneratingDuration,
 		controllerMetrics.ProcessedConfigSnapshotCacheHit,
 		controllerMetrics.ProcessedConfigSnapshotCacheMiss,
+		controllerMetrics.StagedRolloutCount,
+		controllerMetrics.StagedRolloutInProgress,
 	}
 	for _, m := range allMetrics {
 		metrics.Registry.Unregister(m)
@@ -337,6 +568,102 @@ func (c *CtrlFuncMetrics) RecordPushFailure(p Protocol, d time.Duration, datapla
 	c.recordPushBrokenResources(count, dpOpt)
 }
 
+// RecordConfigSyncLatency records the time between a change of a Kubernetes object and a successful
+// configuration push including it.
+func (c *CtrlFuncMetrics) RecordConfigSyncLatency(d time.Duration) {
+	c.ConfigSyncLatency.Observe(float64(d.Milliseconds()))
+}
+
+// RecordPushPayload records sizes of a configuration payload (a fallback one or not) pushed to a dataplane.
+func (c *CtrlFuncMetrics) RecordPushPayload(
+	dataplane string, isFallback bool, compressed bool, configSize, payloadSize, bufferedSize int64,
+) {
+	encoding := EncodingIdentity
+	if compressed {
+		encoding = EncodingGzip
+	}
+	fallback := strconv.FormatBool(isFallback)
+	c.ConfigPushConfigSize.With(prometheus.Labels{
+		DataplaneKey: dataplane,
+		FallbackKey:  fallback,
+	}).Observe(float64(configSize))
+	c.ConfigPushPayloadSize.With(prometheus.Labels{
+		DataplaneKey: dataplane,
+		FallbackKey:  fallback,
+		EncodingKey:  encoding,
+	}).Observe(float64(payloadSize))
+	c.ConfigPushBufferedSize.With(prometheus.Labels{
+		DataplaneKey: dataplane,
+		FallbackKey:  fallback,
+	}).Observe(float64(bufferedSize))
+}
+
+// ConfigEntityCounts holds the numbers of Kong entities in a configuration.
+type ConfigEntityCounts struct {
+	Services       int
+	Routes         int
+	Upstreams      int
+	Targets        int
+	Consumers      int
+	ConsumerGroups int
+	Certificates   int
+	// PluginsByName holds the numbers of plugins by their names.
+	PluginsByName map[string]int
+	// CustomEntitiesByType holds the numbers of custom entities by their types.
+	CustomEntitiesByType map[string]int
+}
+
+// RecordConfigEntityCounts records the numbers of Kong entities in a configuration pushed to a dataplane.
+func (c *CtrlFuncMetrics) RecordConfigEntityCounts(dataplane string, isFallback bool, counts ConfigEntityCounts) {
+	fallback := strconv.FormatBool(isFallback)
+
+	// Only the latest configuration is reported, so series of the other kind of configuration (e.g. of the fallback
+	// configuration after recovering from it) must not be reported anymore.
+	otherKind := prometheus.Labels{DataplaneKey: dataplane, FallbackKey: strconv.FormatBool(!isFallback)}
+	c.ConfigEntityCount.DeletePartialMatch(otherKind)
+	c.ConfigPluginCount.DeletePartialMatch(otherKind)
+	c.ConfigCustomEntityCount.DeletePartialMatch(otherKind)
+
+	pluginsCount := 0
+	for _, count := range counts.PluginsByName {
+		pluginsCount += count
+	}
+	for entityType, count := range map[string]int{
+		EntityTypeService:       counts.Services,
+		EntityTypeRoute:         counts.Routes,
+		EntityTypeUpstream:      counts.Upstreams,
+		EntityTypeTarget:        counts.Targets,
+		EntityTypePlugin:        pluginsCount,
+		EntityTypeConsumer:      counts.Consumers,
+		EntityTypeConsumerGroup: counts.ConsumerGroups,
+		EntityTypeCertificate:   counts.Certificates,
+	} {
+		c.ConfigEntityCount.With(prometheus.Labels{
+			DataplaneKey:  dataplane,
+			FallbackKey:   fallback,
+			EntityTypeKey: entityType,
+		}).Set(float64(count))
+	}
+
+	// Plugins and custom entities that are no longer in the configuration must not be reported anymore.
+	c.ConfigPluginCount.DeletePartialMatch(prometheus.Labels{DataplaneKey: dataplane, FallbackKey: fallback})
+	for pluginName, count := range counts.PluginsByName {
+		c.ConfigPluginCount.With(prometheus.Labels{
+			DataplaneKey:  dataplane,
+			FallbackKey:   fallback,
+			PluginNameKey: pluginName,
+		}).Set(float64(count))
+	}
+	c.ConfigCustomEntityCount.DeletePartialMatch(prometheus.Labels{DataplaneKey: dataplane, FallbackKey: fallback})
+	for entityType, count := range counts.CustomEntitiesByType {
+		c.ConfigCustomEntityCount.With(prometheus.Labels{
+			DataplaneKey:  dataplane,
+			FallbackKey:   fallback,
+			EntityTypeKey: entityType,
+		}).Set(float64(count))
+	}
+}
+
 // RecordTranslationSuccess records a successful configuration translation.
 func (c *CtrlFuncMetrics) RecordTranslationSuccess() {
 	c.TranslationCount.With(prometheus.Labels{
@@ -402,6 +729,19 @@ func (c *CtrlFuncMetrics) RecordFallbackPushFailure(p Protocol, duration time.Du
 	c.recordFallbackPushBrokenResources(brokenResourcesCount, dpOpt)
 }
 
+// RecordStagedRolloutStarted records a start of a staged configuration rollout.
+func (c *CtrlFuncMetrics) RecordStagedRolloutStarted() {
+	c.StagedRolloutInProgress.Set(1)
+}
+
+// RecordStagedRolloutFinished records a completed, rolled back or canceled staged configuration rollout.
+func (c *CtrlFuncMetrics) RecordStagedRolloutFinished(result string) {
+	c.StagedRolloutInProgress.Set(0)
+	c.StagedRolloutCount.With(prometheus.Labels{
+		StagedRolloutResultKey: result,
+	}).Inc()
+}
+
 // RecordFallbackCacheGenerationDuration records the duration of a fallback cache generation.
 func (c *CtrlFuncMetrics) RecordFallbackCacheGenerationDuration(d time.Duration, err error) {
 	labels := prometheus.Labels{
@@ -10,6 +10,7 @@ import (
 
 	deckutils "github.com/kong/go-database-reconciler/pkg/utils"
 	"github.com/kong/go-kong/kong"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/require"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/deckerrors"
@@ -50,6 +51,75 @@ func TestRecordPush(t *testing.T) {
 	})
 }
 
+func TestRecordConfigSyncLatency(t *testing.T) {
+	m := NewCtrlFuncMetrics()
+	require.NotPanics(t, func() {
+		m.RecordConfigSyncLatency(1500 * time.Millisecond)
+	})
+}
+
+func TestRecordPushPayload(t *testing.T) {
+	m := NewCtrlFuncMetrics()
+	const dataplane = "https://localhost:8444"
+	require.NotPanics(t, func() {
+		m.RecordPushPayload(dataplane, false, false, 2048, 2048, 1024)
+		m.RecordPushPayload(dataplane, false, true, 2048, 512, 1024)
+		m.RecordPushPayload(dataplane, true, true, 1024, 256, 512)
+	})
+	require.Equal(t, 2, testutil.CollectAndCount(m.ConfigPushConfigSize), "regular and fallback configuration series expected")
+	require.Equal(t, 3, testutil.CollectAndCount(m.ConfigPushPayloadSize))
+	require.Equal(t, 2, testutil.CollectAndCount(m.ConfigPushBufferedSize))
+}
+
+func TestRecordConfigEntityCounts(t *testing.T) {
+	m := NewCtrlFuncMetrics()
+	const dataplane = "https://localhost:8444"
+
+	m.RecordConfigEntityCounts(dataplane, false, ConfigEntityCounts{
+		Services: 2,
+		Routes:   3,
+		PluginsByName: map[string]int{
+			"key-auth":   2,
+			"rate-limit": 1,
+		},
+		CustomEntitiesByType: map[string]int{
+			"degraphql_routes": 4,
+		},
+	})
+	require.Equal(t, 2.0, testutil.ToFloat64(m.ConfigEntityCount.WithLabelValues(dataplane, "false", EntityTypeService)))
+	require.Equal(t, 3.0, testutil.ToFloat64(m.ConfigEntityCount.WithLabelValues(dataplane, "false", EntityTypeRoute)))
+	require.Equal(t, 3.0, testutil.ToFloat64(m.ConfigEntityCount.WithLabelValues(dataplane, "false", EntityTypePlugin)))
+	require.Equal(t, 0.0, testutil.ToFloat64(m.ConfigEntityCount.WithLabelValues(dataplane, "false", EntityTypeConsumer)))
+	require.Equal(t, 2.0, testutil.ToFloat64(m.ConfigPluginCount.WithLabelValues(dataplane, "false", "key-auth")))
+	require.Equal(t, 4.0, testutil.ToFloat64(m.ConfigCustomEntityCount.WithLabelValues(dataplane, "false", "degraphql_routes")))
+
+	t.Log("Recording a fallback configuration without rate-limit plugins and custom entities")
+	m.RecordConfigEntityCounts(dataplane, true, ConfigEntityCounts{
+		PluginsByName: map[string]int{"key-auth": 1},
+	})
+	require.Equal(t, 1.0, testutil.ToFloat64(m.ConfigPluginCount.WithLabelValues(dataplane, "true", "key-auth")))
+	require.Equal(t, 1, testutil.CollectAndCount(m.ConfigPluginCount), "regular configuration plugin counts should be removed")
+	require.Equal(t, 0, testutil.CollectAndCount(m.ConfigCustomEntityCount), "custom entities counts should be removed")
+
+	t.Log("Recording a regular configuration after recovering from the fallback one")
+	m.RecordConfigEntityCounts(dataplane, false, ConfigEntityCounts{
+		PluginsByName: map[string]int{"key-auth": 2},
+	})
+	require.Equal(t, 2.0, testutil.ToFloat64(m.ConfigPluginCount.WithLabelValues(dataplane, "false", "key-auth")))
+	require.Equal(t, 1, testutil.CollectAndCount(m.ConfigPluginCount), "fallback configuration plugin counts should be removed")
+	require.Equal(t, 8, testutil.CollectAndCount(m.ConfigEntityCount), "fallback configuration entity counts should be removed")
+}
+
+func TestRecordStagedRollout(t *testing.T) {
+	m := NewCtrlFuncMetrics()
+	require.NotPanics(t, func() {
+		m.RecordStagedRolloutStarted()
+		m.RecordStagedRolloutFinished(StagedRolloutResultCompleted)
+		m.RecordStagedRolloutStarted()
+		m.RecordStagedRolloutFinished(StagedRolloutResultRolledBack)
+	})
+}
+
 func TestRecordTranslation(t *testing.T) {
 	m := NewCtrlFuncMetrics()
 	t.Run("recording translation success works", func(t *testing.T) {
@@ -39,6 +39,7 @@ type FakeObjects struct {
 	GRPCRoutes                     []*gatewayapi.GRPCRoute
 	ReferenceGrants                []*gatewayapi.ReferenceGrant
 	Gateways                       []*gatewayapi.Gateway
+	GatewayClasses                 []*gatewayapi.GatewayClass
 	TCPIngresses                   []*kongv1beta1.TCPIngress
 	UDPIngresses                   []*kongv1beta1.UDPIngress
 	IngressClassParametersV1alpha1 []*kongv1alpha1.IngressClassParameters
@@ -54,6 +55,10 @@ type FakeObjects struct {
 	KongServiceFacades             []*incubatorv1alpha1.KongServiceFacade
 	KongVaults                     []*kongv1alpha1.KongVault
 	KongCustomEntities             []*kongv1alpha1.KongCustomEntity
+	KongPluginPolicies             []*kongv1alpha1.KongPluginPolicy
+	KongExternalBackends           []*kongv1alpha1.KongExternalBackend
+	KongHostnamePolicies           []*kongv1alpha1.KongHostnamePolicy
+	GatewayClassParameters         []*kongv1alpha1.GatewayClassParameters
 }
 
 // NewFakeStore creates a store backed by the objects passed in as arguments.
@@ -125,6 +130,12 @@ func NewFakeStore(
 			return nil, err
 		}
 	}
+	gatewayClassStore := cache.NewStore(clusterWideKeyFunc)
+	for _, gwc := range objects.GatewayClasses {
+		if err := gatewayClassStore.Add(gwc); err != nil {
+			return nil, err
+		}
+	}
 	tcpIngressStore := cache.NewStore(namespacedKeyFunc)
 	for _, ingress := range objects.TCPIngresses {
 		err := tcpIngressStore.Add(ingress)
@@ -221,6 +232,30 @@ func NewFakeStore(
 			return nil, err
 		}
 	}
+	kongPluginPolicyStore := cache.NewStore(clusterWideKeyFunc)
+	for _, p := range objects.KongPluginPolicies {
+		if err := kongPluginPolicyStore.Add(p); err != nil {
+			return nil, err
+		}
+	}
+	kongExternalBackendStore := cache.NewStore(namespacedKeyFunc)
+	for _, b := range objects.KongExternalBackends {
+		if err := kongExternalBackendStore.Add(b); err != nil {
+			return nil, err
+		}
+	}
+	kongHostnamePolicyStore := cache.NewStore(clusterWideKeyFunc)
+	for _, p := range objects.KongHostnamePolicies {
+		if err := kongHostnamePolicyStore.Add(p); err != nil {
+			return nil, err
+		}
+	}
+	gatewayClassParametersStore := cache.NewStore(namespacedKeyFunc)
+	for _, p := range objects.GatewayClassParameters {
+		if err := gatewayClassParametersStore.Add(p); err != nil {
+			return nil, err
+		}
+	}
 
 	s = &Store{
 		stores: CacheStores{
@@ -233,6 +268,7 @@ func NewFakeStore(
 			GRPCRoute:                      grpcrouteStore,
 			ReferenceGrant:                 referencegrantStore,
 			Gateway:                        gatewayStore,
+			GatewayClass:                   gatewayClassStore,
 			TCPIngress:                     tcpIngressStore,
 			UDPIngress:                     udpIngressStore,
 			Service:                        serviceStore,
@@ -248,6 +284,10 @@ func NewFakeStore(
 			KongServiceFacade:              kongServiceFacade,
 			KongVault:                      kongVaultStore,
 			KongCustomEntity:               kongCustomEntityStore,
+			KongPluginPolicy:               kongPluginPolicyStore,
+			KongExternalBackend:            kongExternalBackendStore,
+			KongHostnamePolicy:             kongHostnamePolicyStore,
+			GatewayClassParameters:         gatewayClassParametersStore,
 		},
 		ingressClass:          annotations.DefaultIngressClass,
 		isValidIngressClass:   annotations.IngressClassValidatorFuncFromObjectMeta(annotations.DefaultIngressClass),
@@ -273,6 +313,7 @@ func (objects FakeObjects) MarshalToYAML() ([]byte, error) {
 		reflect.TypeOf(&gatewayapi.GRPCRoute{}):                gatewayv1.SchemeGroupVersion.WithKind("GRPCRoute"),
 		reflect.TypeOf(&gatewayapi.ReferenceGrant{}):           gatewayv1beta1.SchemeGroupVersion.WithKind("ReferenceGrant"),
 		reflect.TypeOf(&gatewayapi.Gateway{}):                  gatewayv1.SchemeGroupVersion.WithKind("Gateway"),
+		reflect.TypeOf(&gatewayapi.GatewayClass{}):             gatewayv1.SchemeGroupVersion.WithKind("GatewayClass"),
 		reflect.TypeOf(&kongv1beta1.TCPIngress{}):              kongv1beta1.SchemeGroupVersion.WithKind("TCPIngress"),
 		reflect.TypeOf(&kongv1beta1.UDPIngress{}):              kongv1beta1.SchemeGroupVersion.WithKind("UDPIngress"),
 		reflect.TypeOf(&kongv1alpha1.IngressClassParameters{}): kongv1alpha1.SchemeGroupVersion.WithKind("IngressClassParameters"),
@@ -286,6 +327,10 @@ func (objects FakeObjects) MarshalToYAML() ([]byte, error) {
 		reflect.TypeOf(&kongv1beta1.KongConsumerGroup{}):       kongv1beta1.SchemeGroupVersion.WithKind("KongConsumerGroup"),
 		reflect.TypeOf(&kongv1alpha1.KongVault{}):              kongv1alpha1.SchemeGroupVersion.WithKind(kongv1alpha1.KongVaultKind),
 		reflect.TypeOf(&kongv1alpha1.KongCustomEntity{}):       kongv1alpha1.SchemeGroupVersion.WithKind(kongv1alpha1.KongCustomEntityKind),
+		reflect.TypeOf(&kongv1alpha1.KongPluginPolicy{}):       kongv1alpha1.SchemeGroupVersion.WithKind(kongv1alpha1.KongPluginPolicyKind),
+		reflect.TypeOf(&kongv1alpha1.KongExternalBackend{}):    kongv1alpha1.SchemeGroupVersion.WithKind(kongv1alpha1.KongExternalBackendKind),
+		reflect.TypeOf(&kongv1alpha1.KongHostnamePolicy{}):     kongv1alpha1.SchemeGroupVersion.WithKind(kongv1alpha1.KongHostnamePolicyKind),
+		reflect.TypeOf(&kongv1alpha1.GatewayClassParameters{}): kongv1alpha1.SchemeGroupVersion.WithKind(kongv1alpha1.GatewayClassParametersKind),
 	}
 
 	out := &bytes.Buffer{}
@@ -317,6 +362,7 @@ func (objects FakeObjects) MarshalToYAML() ([]byte, error) {
 	allObjects = append(allObjects, lo.ToAnySlice(objects.GRPCRoutes)...)
 	allObjects = append(allObjects, lo.ToAnySlice(objects.ReferenceGrants)...)
 	allObjects = append(allObjects, lo.ToAnySlice(objects.Gateways)...)
+	allObjects = append(allObjects, lo.ToAnySlice(objects.GatewayClasses)...)
 	allObjects = append(allObjects, lo.ToAnySlice(objects.TCPIngresses)...)
 	allObjects = append(allObjects, lo.ToAnySlice(objects.UDPIngresses)...)
 	allObjects = append(allObjects, lo.ToAnySlice(objects.IngressClassParametersV1alpha1)...)
@@ -330,6 +376,10 @@ func (objects FakeObjects) MarshalToYAML() ([]byte, error) {
 	allObjects = append(allObjects, lo.ToAnySlice(objects.KongConsumerGroups)...)
 	allObjects = append(allObjects, lo.ToAnySlice(objects.KongVaults)...)
 	allObjects = append(allObjects, lo.ToAnySlice(objects.KongCustomEntities)...)
+	allObjects = append(allObjects, lo.ToAnySlice(objects.KongPluginPolicies)...)
+	allObjects = append(allObjects, lo.ToAnySlice(objects.KongExternalBackends)...)
+	allObjects = append(allObjects, lo.ToAnySlice(objects.KongHostnamePolicies)...)
+	allObjects = append(allObjects, lo.ToAnySlice(objects.GatewayClassParameters)...)
 
 	for _, obj := range allObjects {
 		if err := fillGVKAndAppendToBuffer(obj.(runtime.Object)); err != nil {
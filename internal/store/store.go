@@ -57,6 +57,7 @@ const (
 // about ingresses, services, secrets and ingress annotations.
 type Storer interface {
 	UpdateCache(cs CacheStores)
+	CacheStores() CacheStores
 
 	GetSecret(namespace, name string) (*corev1.Secret, error)
 	GetService(namespace, name string) (*corev1.Service, error)
@@ -70,10 +71,13 @@ type Storer interface {
 	GetIngressClassV1(name string) (*netv1.IngressClass, error)
 	GetIngressClassParametersV1Alpha1(ingressClass *netv1.IngressClass) (*kongv1alpha1.IngressClassParameters, error)
 	GetGateway(namespace string, name string) (*gatewayapi.Gateway, error)
+	GetGatewayClass(name string) (*gatewayapi.GatewayClass, error)
+	GetGatewayClassParameters(gatewayClass *gatewayapi.GatewayClass) (*kongv1alpha1.GatewayClassParameters, error)
 	GetKongUpstreamPolicy(namespace, name string) (*kongv1beta1.KongUpstreamPolicy, error)
 	GetKongServiceFacade(namespace, name string) (*incubatorv1alpha1.KongServiceFacade, error)
 	GetKongVault(name string) (*kongv1alpha1.KongVault, error)
 	GetKongCustomEntity(namespace, name string) (*kongv1alpha1.KongCustomEntity, error)
+	GetKongExternalBackend(namespace, name string) (*kongv1alpha1.KongExternalBackend, error)
 
 	ListIngressesV1() []*netv1.Ingress
 	ListIngressClassesV1() []*netv1.IngressClass
@@ -95,6 +99,8 @@ type Storer interface {
 	ListCACerts() ([]*corev1.Secret, error)
 	ListKongVaults() []*kongv1alpha1.KongVault
 	ListKongCustomEntities() []*kongv1alpha1.KongCustomEntity
+	ListKongPluginPolicies() []*kongv1alpha1.KongPluginPolicy
+	ListKongHostnamePolicies() []*kongv1alpha1.KongHostnamePolicy
 }
 
 // Store implements Storer and can be used to list Ingress, Services
@@ -132,6 +138,11 @@ func (s *Store) UpdateCache(cs CacheStores) {
 	s.stores = cs
 }
 
+// CacheStores returns the cache stores backing the Store.
+func (s *Store) CacheStores() CacheStores {
+	return s.stores
+}
+
 // GetSecret returns a Secret using the namespace and name as key.
 func (s Store) GetSecret(namespace, name string) (*corev1.Secret, error) {
 	key := fmt.Sprintf("%v/%v", namespace, name)
@@ -289,6 +300,8 @@ func GetTypedStore[T client.Object](cs CacheStores) (cache.Store, error) {
 		return cs.Gateway, nil
 	case *kongv1.KongPlugin:
 		return cs.Plugin, nil
+	case *kongv1alpha1.KongHostnamePolicy:
+		return cs.KongHostnamePolicy, nil
 	default:
 	}
 	return nil, fmt.Errorf("unsupported type %T", obj)
@@ -502,6 +515,19 @@ func (s Store) GetKongServiceFacade(namespace, name string) (*incubatorv1alpha1.
 	return p.(*incubatorv1alpha1.KongServiceFacade), nil
 }
 
+// GetKongExternalBackend returns the KongExternalBackend with the given namespace and name.
+func (s Store) GetKongExternalBackend(namespace, name string) (*kongv1alpha1.KongExternalBackend, error) {
+	key := fmt.Sprintf("%v/%v", namespace, name)
+	b, exists, err := s.stores.KongExternalBackend.GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, NotFoundError{fmt.Sprintf("KongExternalBackend %v not found", key)}
+	}
+	return b.(*kongv1alpha1.KongExternalBackend), nil
+}
+
 // GetIngressClassParametersV1Alpha1 returns IngressClassParameters for provided
 // IngressClass.
 func (s Store) GetIngressClassParametersV1Alpha1(ingressClass *netv1.IngressClass) (*kongv1alpha1.IngressClassParameters, error) {
@@ -558,6 +584,54 @@ func (s Store) GetGateway(namespace string, name string) (*gatewayapi.Gateway, e
 	return obj.(*gatewayapi.Gateway), nil
 }
 
+// GetGatewayClass returns GatewayClass resource having specified name.
+func (s Store) GetGatewayClass(name string) (*gatewayapi.GatewayClass, error) {
+	obj, exists, err := s.stores.GatewayClass.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, NotFoundError{fmt.Sprintf("GatewayClass %v not found", name)}
+	}
+	return obj.(*gatewayapi.GatewayClass), nil
+}
+
+// GetGatewayClassParameters returns GatewayClassParameters referenced by provided GatewayClass.
+// An empty GatewayClassParameters is returned when the GatewayClass does not reference any parameters.
+func (s Store) GetGatewayClassParameters(gatewayClass *gatewayapi.GatewayClass) (*kongv1alpha1.GatewayClassParameters, error) {
+	if gatewayClass == nil {
+		return nil, fmt.Errorf("provided GatewayClass is nil")
+	}
+
+	ref := gatewayClass.Spec.ParametersRef
+	if ref == nil {
+		return &kongv1alpha1.GatewayClassParameters{}, nil
+	}
+
+	if string(ref.Group) != kongv1alpha1.GroupVersion.Group || string(ref.Kind) != kongv1alpha1.GatewayClassParametersKind {
+		return nil, fmt.Errorf(
+			"GatewayClass %s should reference parameters of kind %s in group %s",
+			gatewayClass.Name,
+			kongv1alpha1.GatewayClassParametersKind,
+			kongv1alpha1.GroupVersion.Group,
+		)
+	}
+
+	if ref.Namespace == nil {
+		return nil, fmt.Errorf("GatewayClass %s should reference namespaced parameters", gatewayClass.Name)
+	}
+
+	key := fmt.Sprintf("%v/%v", *ref.Namespace, ref.Name)
+	params, exists, err := s.stores.GatewayClassParameters.GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, NotFoundError{fmt.Sprintf("GatewayClassParameters %v not found", key)}
+	}
+	return params.(*kongv1alpha1.GatewayClassParameters), nil
+}
+
 // GetKongVault returns kongvault resource having specified name.
 func (s Store) GetKongVault(name string) (*kongv1alpha1.KongVault, error) {
 	p, exists, err := s.stores.KongVault.GetByKey(name)
@@ -705,6 +779,30 @@ func (s Store) ListKongCustomEntities() []*kongv1alpha1.KongCustomEntity {
 	return kongCustomEntities
 }
 
+// ListKongPluginPolicies returns the list of KongPluginPolicies.
+// Policies are not filtered by ingress class as they apply to all plugins used in the cluster.
+func (s Store) ListKongPluginPolicies() []*kongv1alpha1.KongPluginPolicy {
+	var kongPluginPolicies []*kongv1alpha1.KongPluginPolicy
+	for _, obj := range s.stores.KongPluginPolicy.List() {
+		if kongPluginPolicy, ok := obj.(*kongv1alpha1.KongPluginPolicy); ok {
+			kongPluginPolicies = append(kongPluginPolicies, kongPluginPolicy)
+		}
+	}
+	return kongPluginPolicies
+}
+
+// ListKongHostnamePolicies returns the list of KongHostnamePolicies.
+// Policies are not filtered by ingress class as they apply to all hostnames routed in the cluster.
+func (s Store) ListKongHostnamePolicies() []*kongv1alpha1.KongHostnamePolicy {
+	var kongHostnamePolicies []*kongv1alpha1.KongHostnamePolicy
+	for _, obj := range s.stores.KongHostnamePolicy.List() {
+		if kongHostnamePolicy, ok := obj.(*kongv1alpha1.KongHostnamePolicy); ok {
+			kongHostnamePolicies = append(kongHostnamePolicies, kongHostnamePolicy)
+		}
+	}
+	return kongHostnamePolicies
+}
+
 // getIngressClassHandling returns annotations.ExactOrEmptyClassMatch if an IngressClass is the default class, or
 // annotations.ExactClassMatch if the IngressClass is not default or does not exist.
 func (s Store) getIngressClassHandling() annotations.ClassMatching {
@@ -760,6 +858,10 @@ func mkObjFromGVK(gvk schema.GroupVersionKind) (runtime.Object, error) {
 	// ----------------------------------------------------------------------------
 	// Kubernetes Gateway APIs
 	// ----------------------------------------------------------------------------
+	case gatewayv1.SchemeGroupVersion.WithKind("Gateway"):
+		return &gatewayapi.Gateway{}, nil
+	case gatewayv1.SchemeGroupVersion.WithKind("GatewayClass"):
+		return &gatewayapi.GatewayClass{}, nil
 	case gatewayv1.SchemeGroupVersion.WithKind("HTTPRoute"):
 		return &gatewayapi.HTTPRoute{}, nil
 	case gatewayv1.SchemeGroupVersion.WithKind("GRPCRoute"):
@@ -801,6 +903,14 @@ func mkObjFromGVK(gvk schema.GroupVersionKind) (runtime.Object, error) {
 		return &kongv1alpha1.KongVault{}, nil
 	case kongv1alpha1.GroupVersion.WithKind("KongCustomEntity"):
 		return &kongv1alpha1.KongCustomEntity{}, nil
+	case kongv1alpha1.GroupVersion.WithKind(kongv1alpha1.KongPluginPolicyKind):
+		return &kongv1alpha1.KongPluginPolicy{}, nil
+	case kongv1alpha1.GroupVersion.WithKind(kongv1alpha1.KongExternalBackendKind):
+		return &kongv1alpha1.KongExternalBackend{}, nil
+	case kongv1alpha1.GroupVersion.WithKind(kongv1alpha1.KongHostnamePolicyKind):
+		return &kongv1alpha1.KongHostnamePolicy{}, nil
+	case kongv1alpha1.GroupVersion.WithKind(kongv1alpha1.GatewayClassParametersKind):
+		return &kongv1alpha1.GatewayClassParameters{}, nil
 	default:
 		return nil, fmt.Errorf("%s is not a supported runtime.Object", gvk)
 	}
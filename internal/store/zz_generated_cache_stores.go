@@ -35,6 +35,7 @@ type CacheStores struct {
 	GRPCRoute                      cache.Store
 	ReferenceGrant                 cache.Store
 	Gateway                        cache.Store
+	GatewayClass                   cache.Store
 	Plugin                         cache.Store
 	ClusterPlugin                  cache.Store
 	Consumer                       cache.Store
@@ -47,6 +48,10 @@ type CacheStores struct {
 	KongServiceFacade              cache.Store
 	KongVault                      cache.Store
 	KongCustomEntity               cache.Store
+	KongPluginPolicy               cache.Store
+	KongExternalBackend            cache.Store
+	KongHostnamePolicy             cache.Store
+	GatewayClassParameters         cache.Store
 
 	l *sync.RWMutex
 }
@@ -66,6 +71,7 @@ func NewCacheStores() CacheStores {
 		GRPCRoute:                      cache.NewStore(namespacedKeyFunc),
 		ReferenceGrant:                 cache.NewStore(namespacedKeyFunc),
 		Gateway:                        cache.NewStore(namespacedKeyFunc),
+		GatewayClass:                   cache.NewStore(clusterWideKeyFunc),
 		Plugin:                         cache.NewStore(namespacedKeyFunc),
 		ClusterPlugin:                  cache.NewStore(clusterWideKeyFunc),
 		Consumer:                       cache.NewStore(namespacedKeyFunc),
@@ -78,6 +84,10 @@ func NewCacheStores() CacheStores {
 		KongServiceFacade:              cache.NewStore(namespacedKeyFunc),
 		KongVault:                      cache.NewStore(clusterWideKeyFunc),
 		KongCustomEntity:               cache.NewStore(namespacedKeyFunc),
+		KongPluginPolicy:               cache.NewStore(clusterWideKeyFunc),
+		KongExternalBackend:            cache.NewStore(namespacedKeyFunc),
+		KongHostnamePolicy:             cache.NewStore(clusterWideKeyFunc),
+		GatewayClassParameters:         cache.NewStore(namespacedKeyFunc),
 
 		l: &sync.RWMutex{},
 	}
@@ -113,6 +123,8 @@ func (c CacheStores) Get(obj runtime.Object) (item interface{}, exists bool, err
 		return c.ReferenceGrant.Get(obj)
 	case *gatewayapi.Gateway:
 		return c.Gateway.Get(obj)
+	case *gatewayapi.GatewayClass:
+		return c.GatewayClass.Get(obj)
 	case *kongv1.KongPlugin:
 		return c.Plugin.Get(obj)
 	case *kongv1.KongClusterPlugin:
@@ -137,6 +149,14 @@ func (c CacheStores) Get(obj runtime.Object) (item interface{}, exists bool, err
 		return c.KongVault.Get(obj)
 	case *kongv1alpha1.KongCustomEntity:
 		return c.KongCustomEntity.Get(obj)
+	case *kongv1alpha1.KongPluginPolicy:
+		return c.KongPluginPolicy.Get(obj)
+	case *kongv1alpha1.KongExternalBackend:
+		return c.KongExternalBackend.Get(obj)
+	case *kongv1alpha1.KongHostnamePolicy:
+		return c.KongHostnamePolicy.Get(obj)
+	case *kongv1alpha1.GatewayClassParameters:
+		return c.GatewayClassParameters.Get(obj)
 	}
 	return nil, false, fmt.Errorf("%T is not a supported cache object type", obj)
 }
@@ -172,6 +192,8 @@ func (c CacheStores) Add(obj runtime.Object) error {
 		return c.ReferenceGrant.Add(obj)
 	case *gatewayapi.Gateway:
 		return c.Gateway.Add(obj)
+	case *gatewayapi.GatewayClass:
+		return c.GatewayClass.Add(obj)
 	case *kongv1.KongPlugin:
 		return c.Plugin.Add(obj)
 	case *kongv1.KongClusterPlugin:
@@ -196,6 +218,14 @@ func (c CacheStores) Add(obj runtime.Object) error {
 		return c.KongVault.Add(obj)
 	case *kongv1alpha1.KongCustomEntity:
 		return c.KongCustomEntity.Add(obj)
+	case *kongv1alpha1.KongPluginPolicy:
+		return c.KongPluginPolicy.Add(obj)
+	case *kongv1alpha1.KongExternalBackend:
+		return c.KongExternalBackend.Add(obj)
+	case *kongv1alpha1.KongHostnamePolicy:
+		return c.KongHostnamePolicy.Add(obj)
+	case *kongv1alpha1.GatewayClassParameters:
+		return c.GatewayClassParameters.Add(obj)
 	}
 	return fmt.Errorf("cannot add unsupported kind %q to the store", obj.GetObjectKind().GroupVersionKind())
 }
@@ -231,6 +261,8 @@ func (c CacheStores) Delete(obj runtime.Object) error {
 		return c.ReferenceGrant.Delete(obj)
 	case *gatewayapi.Gateway:
 		return c.Gateway.Delete(obj)
+	case *gatewayapi.GatewayClass:
+		return c.GatewayClass.Delete(obj)
 	case *kongv1.KongPlugin:
 		return c.Plugin.Delete(obj)
 	case *kongv1.KongClusterPlugin:
@@ -255,6 +287,14 @@ func (c CacheStores) Delete(obj runtime.Object) error {
 		return c.KongVault.Delete(obj)
 	case *kongv1alpha1.KongCustomEntity:
 		return c.KongCustomEntity.Delete(obj)
+	case *kongv1alpha1.KongPluginPolicy:
+		return c.KongPluginPolicy.Delete(obj)
+	case *kongv1alpha1.KongExternalBackend:
+		return c.KongExternalBackend.Delete(obj)
+	case *kongv1alpha1.KongHostnamePolicy:
+		return c.KongHostnamePolicy.Delete(obj)
+	case *kongv1alpha1.GatewayClassParameters:
+		return c.GatewayClassParameters.Delete(obj)
 	}
 	return fmt.Errorf("cannot delete unsupported kind %q from the store", obj.GetObjectKind().GroupVersionKind())
 }
@@ -274,6 +314,7 @@ func (c CacheStores) ListAllStores() []cache.Store {
 		c.GRPCRoute,
 		c.ReferenceGrant,
 		c.Gateway,
+		c.GatewayClass,
 		c.Plugin,
 		c.ClusterPlugin,
 		c.Consumer,
@@ -286,6 +327,10 @@ func (c CacheStores) ListAllStores() []cache.Store {
 		c.KongServiceFacade,
 		c.KongVault,
 		c.KongCustomEntity,
+		c.KongPluginPolicy,
+		c.KongExternalBackend,
+		c.KongHostnamePolicy,
+		c.GatewayClassParameters,
 	}
 }
 
@@ -304,6 +349,7 @@ func (c CacheStores) SupportedTypes() []client.Object {
 		&gatewayapi.GRPCRoute{},
 		&gatewayapi.ReferenceGrant{},
 		&gatewayapi.Gateway{},
+		&gatewayapi.GatewayClass{},
 		&kongv1.KongPlugin{},
 		&kongv1.KongClusterPlugin{},
 		&kongv1.KongConsumer{},
@@ -316,5 +362,9 @@ func (c CacheStores) SupportedTypes() []client.Object {
 		&incubatorv1alpha1.KongServiceFacade{},
 		&kongv1alpha1.KongVault{},
 		&kongv1alpha1.KongCustomEntity{},
+		&kongv1alpha1.KongPluginPolicy{},
+		&kongv1alpha1.KongExternalBackend{},
+		&kongv1alpha1.KongHostnamePolicy{},
+		&kongv1alpha1.GatewayClassParameters{},
 	}
 }
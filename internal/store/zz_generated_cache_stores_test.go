@@ -85,6 +85,11 @@ func TestCacheStores(t *testing.T) {
 			objectToStore: &gatewayapi.Gateway{},
 		},
 
+		{
+			name:          "GatewayClass",
+			objectToStore: &gatewayapi.GatewayClass{},
+		},
+
 		{
 			name:          "KongPlugin",
 			objectToStore: &kongv1.KongPlugin{},
@@ -144,6 +149,26 @@ func TestCacheStores(t *testing.T) {
 			name:          "KongCustomEntity",
 			objectToStore: &kongv1alpha1.KongCustomEntity{},
 		},
+
+		{
+			name:          "KongPluginPolicy",
+			objectToStore: &kongv1alpha1.KongPluginPolicy{},
+		},
+
+		{
+			name:          "KongExternalBackend",
+			objectToStore: &kongv1alpha1.KongExternalBackend{},
+		},
+
+		{
+			name:          "KongHostnamePolicy",
+			objectToStore: &kongv1alpha1.KongHostnamePolicy{},
+		},
+
+		{
+			name:          "GatewayClassParameters",
+			objectToStore: &kongv1alpha1.GatewayClassParameters{},
+		},
 	}
 
 	for _, tc := range testCases {
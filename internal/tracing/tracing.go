@@ -0,0 +1,93 @@
+// Package tracing provides OpenTelemetry tracing of the pipeline translating Kubernetes objects into Kong
+// configuration and pushing it to Kong.
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName is the name of the tracer used for all the spans created by the controller.
+const TracerName = "github.com/kong/kubernetes-ingress-controller/v3"
+
+// ServiceName is the name of the service reported in exported traces.
+const ServiceName = "kong-ingress-controller"
+
+// Attribute keys used to tag spans.
+const (
+	// AttributeConfigHash is the hash of the configuration pushed to Kong.
+	AttributeConfigHash = attribute.Key("kong.config.hash")
+	// AttributeClientURL is the URL of the Kong Admin API (or Konnect) client the configuration is pushed with.
+	AttributeClientURL = attribute.Key("kong.client.url")
+	// AttributeFallback tells whether the configuration is a fallback one.
+	AttributeFallback = attribute.Key("kong.config.fallback")
+	// AttributeBrokenObjects is the number of broken objects a fallback configuration is generated for.
+	AttributeBrokenObjects = attribute.Key("kong.fallback.broken_objects")
+)
+
+// Config is the configuration of traces exporting.
+type Config struct {
+	// OTLPEndpoint is the host:port of the OTLP gRPC collector traces are exported to.
+	// Traces are not exported when it's empty.
+	OTLPEndpoint string
+	// OTLPInsecure disables TLS when connecting to the collector.
+	OTLPInsecure bool
+}
+
+// Enabled tells whether traces should be exported.
+func (c Config) Enabled() bool {
+	return c.OTLPEndpoint != ""
+}
+
+// Setup configures the global tracer provider to export spans to the OTLP collector configured in cfg.
+// It returns a function flushing and shutting down the tracer provider that has to be called on exit.
+// When exporting is disabled, the global no-op tracer provider is left intact.
+func Setup(ctx context.Context, cfg Config, version string) (shutdown func(context.Context) error, err error) {
+	if !cfg.Enabled() {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.OTLPEndpoint)}
+	if cfg.OTLPInsecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceName(ServiceName),
+			semconv.ServiceVersion(version),
+		)),
+	)
+	otel.SetTracerProvider(tp)
+	return tp.Shutdown, nil
+}
+
+// StartSpan starts a span with the given name using the global tracer provider. The span is a child of
+// the span found in ctx (if any). The returned context carries the new span.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(TracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan ends the span, marking it as failed when err is not nil.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,48 @@
+package tracing_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/tracing"
+)
+
+func TestSpans(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	previousProvider := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	t.Cleanup(func() { otel.SetTracerProvider(previousProvider) })
+
+	ctx, parent := tracing.StartSpan(context.Background(), "parent", tracing.AttributeClientURL.String("https://localhost:8444"))
+	_, child := tracing.StartSpan(ctx, "child")
+	tracing.EndSpan(child, errors.New("failure"))
+	tracing.EndSpan(parent, nil)
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+
+	childSpan, parentSpan := spans[0], spans[1]
+	require.Equal(t, "child", childSpan.Name)
+	require.Equal(t, parentSpan.SpanContext.SpanID(), childSpan.Parent.SpanID())
+	require.Equal(t, codes.Error, childSpan.Status.Code)
+	require.Equal(t, "failure", childSpan.Status.Description)
+
+	require.Equal(t, "parent", parentSpan.Name)
+	require.Equal(t, codes.Unset, parentSpan.Status.Code)
+	require.Contains(t, parentSpan.Attributes, tracing.AttributeClientURL.String("https://localhost:8444"))
+}
+
+func TestSetup_Disabled(t *testing.T) {
+	previousProvider := otel.GetTracerProvider()
+	shutdown, err := tracing.Setup(context.Background(), tracing.Config{}, "v3.3.0")
+	require.NoError(t, err)
+	require.NoError(t, shutdown(context.Background()))
+	require.Equal(t, previousProvider, otel.GetTracerProvider(), "global tracer provider should be left intact")
+}
@@ -32,6 +32,8 @@ import (
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
+	incubatorv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/incubator/v1alpha1"
 )
 
 // ParseNameNS parses a string searching a namespace and name.
@@ -112,7 +114,7 @@ func GetPodDetails(ctx context.Context, kubeClient clientset.Interface) (*PodInf
 	}
 
 	pod, err := kubeClient.CoreV1().Pods(nn.Namespace).Get(ctx, nn.Name, metav1.GetOptions{})
-	if pod == nil {
+	if err != nil {
 		return nil, fmt.Errorf("unable to get POD information: %w", err)
 	}
 
@@ -124,16 +126,41 @@ func GetPodDetails(ctx context.Context, kubeClient clientset.Interface) (*PodInf
 	}, nil
 }
 
-// map of all the supported Group/Kinds for the backend. At the moment, only
-// core services are supported, but to provide support to other kinds, it is
-// enough to add entries to this map.
-var backendRefSupportedGroupKinds = map[string]struct{}{
-	"core/Service": {},
+var (
+	serviceGroupKind             = "core/Service"
+	kongServiceFacadeGroupKind   = fmt.Sprintf("%s/%s", incubatorv1alpha1.GroupVersion.Group, incubatorv1alpha1.KongServiceFacadeKind)
+	kongExternalBackendGroupKind = fmt.Sprintf("%s/%s", kongv1alpha1.GroupVersion.Group, kongv1alpha1.KongExternalBackendKind)
+)
+
+// map of all the supported Group/Kinds for the backend by the kind of the Gateway API route
+// referring to it. At the moment, core services are supported by all routes, KongServiceFacades
+// by HTTPRoutes and GRPCRoutes and KongExternalBackends by HTTPRoutes and TCPRoutes. To provide
+// support to other kinds, it is enough to add entries to this map.
+var backendRefSupportedGroupKinds = map[string]map[string]struct{}{
+	gatewayapi.V1HTTPRouteTypeMeta.Kind: {
+		serviceGroupKind:             {},
+		kongServiceFacadeGroupKind:   {},
+		kongExternalBackendGroupKind: {},
+	},
+	gatewayapi.GRPCRouteTypeMeta.Kind: {
+		serviceGroupKind:           {},
+		kongServiceFacadeGroupKind: {},
+	},
+	gatewayapi.TCPRouteTypeMeta.Kind: {
+		serviceGroupKind:             {},
+		kongExternalBackendGroupKind: {},
+	},
+	gatewayapi.UDPRouteTypeMeta.Kind: {
+		serviceGroupKind: {},
+	},
+	gatewayapi.TLSRouteTypeMeta.Kind: {
+		serviceGroupKind: {},
+	},
 }
 
 // IsBackendRefGroupKindSupported checks if the GroupKind of the object used as
-// BackendRef for the HTTPRoute is supported.
-func IsBackendRefGroupKindSupported(gatewayAPIGroup *gatewayapi.Group, gatewayAPIKind *gatewayapi.Kind) bool {
+// BackendRef for a Gateway API route of the given kind (e.g. HTTPRoute) is supported.
+func IsBackendRefGroupKindSupported(routeKind string, gatewayAPIGroup *gatewayapi.Group, gatewayAPIKind *gatewayapi.Kind) bool {
 	if gatewayAPIKind == nil {
 		return false
 	}
@@ -143,7 +170,7 @@ func IsBackendRefGroupKindSupported(gatewayAPIGroup *gatewayapi.Group, gatewayAP
 		group = string(*gatewayAPIGroup)
 	}
 
-	_, ok := backendRefSupportedGroupKinds[fmt.Sprintf("%s/%s", group, *gatewayAPIKind)]
+	_, ok := backendRefSupportedGroupKinds[routeKind][fmt.Sprintf("%s/%s", group, *gatewayAPIKind)]
 	return ok
 }
 
@@ -18,6 +18,7 @@ package util
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -282,3 +283,37 @@ func TestGenerateTagsForObject(t *testing.T) {
 		t.Fatalf("generated tags are not as expected, diff:\n%s", diff)
 	}
 }
+
+func TestIsBackendRefGroupKindSupported(t *testing.T) {
+	testCases := []struct {
+		routeKind string
+		group     string
+		kind      string
+		expected  bool
+	}{
+		{routeKind: "HTTPRoute", group: "", kind: "Service", expected: true},
+		{routeKind: "HTTPRoute", group: "core", kind: "Service", expected: true},
+		{routeKind: "HTTPRoute", group: "incubator.ingress-controller.konghq.com", kind: "KongServiceFacade", expected: true},
+		{routeKind: "HTTPRoute", group: "configuration.konghq.com", kind: "KongExternalBackend", expected: true},
+		{routeKind: "HTTPRoute", group: "", kind: "ConfigMap", expected: false},
+		{routeKind: "GRPCRoute", group: "incubator.ingress-controller.konghq.com", kind: "KongServiceFacade", expected: true},
+		{routeKind: "GRPCRoute", group: "configuration.konghq.com", kind: "KongExternalBackend", expected: false},
+		{routeKind: "TCPRoute", group: "configuration.konghq.com", kind: "KongExternalBackend", expected: true},
+		{routeKind: "TCPRoute", group: "incubator.ingress-controller.konghq.com", kind: "KongServiceFacade", expected: false},
+		{routeKind: "UDPRoute", group: "", kind: "Service", expected: true},
+		{routeKind: "UDPRoute", group: "incubator.ingress-controller.konghq.com", kind: "KongServiceFacade", expected: false},
+		{routeKind: "TLSRoute", group: "incubator.ingress-controller.konghq.com", kind: "KongServiceFacade", expected: false},
+		{routeKind: "TLSRoute", group: "configuration.konghq.com", kind: "KongExternalBackend", expected: false},
+		{routeKind: "Ingress", group: "", kind: "Service", expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%s backendRef %s/%s", tc.routeKind, tc.group, tc.kind), func(t *testing.T) {
+			group := gatewayapi.Group(tc.group)
+			kind := gatewayapi.Kind(tc.kind)
+			if supported := IsBackendRefGroupKindSupported(tc.routeKind, &group, &kind); supported != tc.expected {
+				t.Errorf("expected %t, but returned %t", tc.expected, supported)
+			}
+		})
+	}
+}
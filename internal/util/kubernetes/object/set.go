@@ -12,6 +12,9 @@ type (
 type objectConfigurationStatus struct {
 	generation int64
 	succeeded  bool
+	conflicted bool
+	// hostnameNotAllowed is set for objects with hostnames rejected by KongHostnamePolicies.
+	hostnameNotAllowed bool
 }
 
 type ConfigurationStatus string
@@ -20,6 +23,12 @@ const (
 	ConfigurationStatusSucceeded ConfigurationStatus = "Succeeded"
 	ConfigurationStatusFailed    ConfigurationStatus = "Failed"
 	ConfigurationStatusUnknown   ConfigurationStatus = "Unknown"
+	// ConfigurationStatusConflicted indicates that the object was configured, but its routes have the same or
+	// shadowing matches as routes of other objects.
+	ConfigurationStatusConflicted ConfigurationStatus = "Conflicted"
+	// ConfigurationStatusHostnameNotAllowed indicates that the object failed to be configured (at least partially),
+	// because some of its hostnames are not allowed in its namespace by KongHostnamePolicies.
+	ConfigurationStatusHostnameNotAllowed ConfigurationStatus = "HostnameNotAllowed"
 )
 
 // ConfigurationStatusSet is a de-duplicate set to store the configure status
@@ -53,6 +62,46 @@ func (s *ConfigurationStatusSet) Insert(obj client.Object, succeeded bool) {
 	}
 }
 
+// MarkConflicted marks the object as having routes conflicting with routes of other objects.
+// It has no effect on objects that were not inserted as succeeded.
+func (s *ConfigurationStatusSet) MarkConflicted(obj client.Object) {
+	if s.store == nil {
+		return
+	}
+
+	objGVK := gvk(obj.GetObjectKind().GroupVersionKind().String())
+	nsName := k8stypes.NamespacedName{
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+	}
+	status, ok := s.store[objGVK][nsName]
+	if !ok || !status.succeeded {
+		return
+	}
+	status.conflicted = true
+	s.store[objGVK][nsName] = status
+}
+
+// MarkHostnameNotAllowed marks the object as having hostnames rejected by KongHostnamePolicies.
+// It has no effect on objects that were not inserted as failed.
+func (s *ConfigurationStatusSet) MarkHostnameNotAllowed(obj client.Object) {
+	if s.store == nil {
+		return
+	}
+
+	objGVK := gvk(obj.GetObjectKind().GroupVersionKind().String())
+	nsName := k8stypes.NamespacedName{
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+	}
+	status, ok := s.store[objGVK][nsName]
+	if !ok || status.succeeded {
+		return
+	}
+	status.hostnameNotAllowed = true
+	s.store[objGVK][nsName] = status
+}
+
 func (s *ConfigurationStatusSet) Get(obj client.Object) ConfigurationStatus {
 	if s.store == nil {
 		return ConfigurationStatusUnknown
@@ -81,8 +130,15 @@ func (s *ConfigurationStatusSet) Get(obj client.Object) ConfigurationStatus {
 	}
 
 	if !status.succeeded {
+		if status.hostnameNotAllowed {
+			return ConfigurationStatusHostnameNotAllowed
+		}
 		return ConfigurationStatusFailed
 	}
 
+	if status.conflicted {
+		return ConfigurationStatusConflicted
+	}
+
 	return ConfigurationStatusSucceeded
 }
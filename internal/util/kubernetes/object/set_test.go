@@ -81,6 +81,15 @@ func TestObjectConfigurationStatusSet(t *testing.T) {
 	require.Equal(t, ConfigurationStatusFailed, set.Get(ing2))
 	require.Equal(t, ConfigurationStatusSucceeded, set.Get(ing3))
 	require.Equal(t, ConfigurationStatusSucceeded, set.Get(tcp))
+
+	t.Log("marking some objects as conflicted, only succeeded objects are affected")
+	set.MarkConflicted(ing2)
+	set.MarkConflicted(ing3)
+	require.Equal(t, ConfigurationStatusFailed, set.Get(ing2))
+	require.Equal(t, ConfigurationStatusConflicted, set.Get(ing3))
+	require.Equal(t, ConfigurationStatusSucceeded, set.Get(tcp))
+	set.Insert(ing3, true)
+	require.Equal(t, ConfigurationStatusSucceeded, set.Get(ing3), "inserting the object again resets the conflict")
 }
 
 // -----------------------------------------------------------------------------
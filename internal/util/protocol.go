@@ -9,3 +9,14 @@ func ValidateProtocol(protocol string) bool {
 		return false
 	}
 }
+
+// IsStreamProtocol returns true if the provided protocol is proxied by Kong's stream
+// (L4) subsystem. HTTP-only plugins cannot be used with services of such protocols.
+func IsStreamProtocol(protocol string) bool {
+	switch protocol {
+	case "tcp", "tls", "tls_passthrough", "udp":
+		return true
+	default:
+		return false
+	}
+}
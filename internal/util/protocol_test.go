@@ -31,6 +31,28 @@ func TestValidateProtocol(t *testing.T) {
 	}
 }
 
+func TestIsStreamProtocol(t *testing.T) {
+	testTable := []struct {
+		input    string
+		expected bool
+	}{
+		{"", false},
+		{"http", false},
+		{"https", false},
+		{"grpc", false},
+		{"ws", false},
+		{"tls", true},
+		{"tcp", true},
+		{"tls_passthrough", true},
+		{"udp", true},
+	}
+	for _, tc := range testTable {
+		t.Run(tc.input, func(t *testing.T) {
+			assert.Equal(t, tc.expected, IsStreamProtocol(tc.input))
+		})
+	}
+}
+
 func BenchmarkValidateProtocol(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		_ = ValidateProtocol("https")
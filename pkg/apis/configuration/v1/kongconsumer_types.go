@@ -79,6 +79,58 @@ type KongConsumerStatus struct {
 	// +kubebuilder:validation:MaxItems=8
 	// +kubebuilder:default={{type: "Programmed", status: "Unknown", reason:"Pending", message:"Waiting for controller", lastTransitionTime: "1970-01-01T00:00:00Z"}}
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Credentials are the credentials of the KongConsumer in the configuration last applied to Kong.
+	// Only the types of the credentials are reported, never their values.
+	//
+	// +optional
+	Credentials []KongConsumerCredentialStatus `json:"credentials,omitempty"`
+
+	// ConsumerGroups are the names of the KongConsumerGroups the KongConsumer is a member of
+	// in the configuration last applied to Kong.
+	//
+	// +optional
+	ConsumerGroups []string `json:"consumerGroups,omitempty"`
+
+	// Plugins are the plugins scoped to the KongConsumer or to its KongConsumerGroups
+	// in the configuration last applied to Kong.
+	//
+	// +optional
+	Plugins []KongConsumerPluginStatus `json:"plugins,omitempty"`
+}
+
+// KongConsumerCredentialStatus describes a credential of a KongConsumer.
+type KongConsumerCredentialStatus struct {
+	// Secret is the name of the Secret the credential is defined in.
+	Secret string `json:"secret"`
+
+	// Type is the type of the credential, e.g. key-auth.
+	Type string `json:"type"`
+}
+
+// KongConsumerPluginStatus describes a plugin scoped to a KongConsumer.
+type KongConsumerPluginStatus struct {
+	// Kind is the kind of the resource configuring the plugin, KongPlugin or KongClusterPlugin.
+	//
+	// +kubebuilder:validation:Enum=KongPlugin;KongClusterPlugin
+	Kind string `json:"kind"`
+
+	// Namespace is the namespace of the KongPlugin. It's empty for KongClusterPlugins.
+	//
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the name of the resource configuring the plugin.
+	Name string `json:"name"`
+
+	// PluginName is the name of the Kong plugin.
+	PluginName string `json:"pluginName"`
+
+	// ConsumerGroup is the name of the KongConsumerGroup the plugin is scoped to.
+	// It's empty for plugins scoped to the KongConsumer itself.
+	//
+	// +optional
+	ConsumerGroup string `json:"consumerGroup,omitempty"`
 }
 
 func init() {
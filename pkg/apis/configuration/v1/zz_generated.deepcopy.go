@@ -195,6 +195,21 @@ func (in *KongConsumer) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongConsumerCredentialStatus) DeepCopyInto(out *KongConsumerCredentialStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongConsumerCredentialStatus.
+func (in *KongConsumerCredentialStatus) DeepCopy() *KongConsumerCredentialStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KongConsumerCredentialStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KongConsumerList) DeepCopyInto(out *KongConsumerList) {
 	*out = *in
@@ -227,6 +242,21 @@ func (in *KongConsumerList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongConsumerPluginStatus) DeepCopyInto(out *KongConsumerPluginStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongConsumerPluginStatus.
+func (in *KongConsumerPluginStatus) DeepCopy() *KongConsumerPluginStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KongConsumerPluginStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KongConsumerStatus) DeepCopyInto(out *KongConsumerStatus) {
 	*out = *in
@@ -237,6 +267,21 @@ func (in *KongConsumerStatus) DeepCopyInto(out *KongConsumerStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = make([]KongConsumerCredentialStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.ConsumerGroups != nil {
+		in, out := &in.ConsumerGroups, &out.ConsumerGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Plugins != nil {
+		in, out := &in.Plugins, &out.Plugins
+		*out = make([]KongConsumerPluginStatus, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongConsumerStatus.
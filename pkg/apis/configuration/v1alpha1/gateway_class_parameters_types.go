@@ -0,0 +1,104 @@
+/*
+Copyright 2024 Kong, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	GatewayClassParametersKind = "GatewayClassParameters"
+)
+
+// +kubebuilder:object:root=true
+
+// GatewayClassParametersList contains a list of GatewayClassParameters.
+type GatewayClassParametersList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GatewayClassParameters `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:resource:categories=kong-ingress-controller
+// +kubebuilder:resource:path=gatewayclassparameterses
+
+// GatewayClassParameters is the Schema for the GatewayClassParameters API. It's referenced by a GatewayClass'
+// spec.parametersRef and carries defaults for the Gateways of the class.
+type GatewayClassParameters struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the GatewayClassParameters specification.
+	Spec GatewayClassParametersSpec `json:"spec,omitempty"`
+}
+
+// GatewayClassParametersSpec defines the desired state of GatewayClassParameters.
+type GatewayClassParametersSpec struct {
+	// RouterFlavor is the router flavor of Kong the routes of the class' Gateways are written for.
+	// It is only used to reject a mismatch: it doesn't change the router flavor used by Kong nor how routes are
+	// translated. When set and Kong uses a different router flavor, the GatewayClass is not accepted and none of
+	// the defaults of these parameters are applied.
+	// +optional
+	// +kubebuilder:validation:Enum=traditional;traditional_compatible;expressions
+	RouterFlavor *string `json:"routerFlavor,omitempty"`
+
+	// ListenerTLS are the default TLS settings of the listeners of the class' Gateways.
+	// +optional
+	ListenerTLS *GatewayClassListenerTLS `json:"listenerTLS,omitempty"`
+
+	// Plugins are the names of KongPlugins or KongClusterPlugins attached to the routes translated from HTTPRoutes
+	// and GRPCRoutes attached to the class' Gateways. KongPlugins are looked up in the namespace of each route.
+	// Routes referencing plugins on their own (with the `konghq.com/plugins` annotation or ExtensionRef filters)
+	// do not get the default plugins.
+	// +optional
+	Plugins []string `json:"plugins,omitempty"`
+
+	// PublishServices are the Services ("namespace/name") exposing Kong, used as the addresses of the class'
+	// Gateways that don't define the `konghq.com/publish-service` annotation on their own. When not set, the
+	// Services configured with the --publish-service and --publish-service-udp flags are used.
+	// +optional
+	// +kubebuilder:validation:MaxItems=2
+	// +kubebuilder:validation:items:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?/[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	PublishServices []string `json:"publishServices,omitempty"`
+}
+
+// GatewayClassListenerTLS defines the default TLS settings of Gateway listeners.
+type GatewayClassListenerTLS struct {
+	// CertificateRef is the Secret with the certificate served by the TLS-terminating listeners that don't
+	// reference any certificate on their own.
+	// +optional
+	CertificateRef *GatewayClassSecretReference `json:"certificateRef,omitempty"`
+}
+
+// GatewayClassSecretReference is a reference to a Secret.
+type GatewayClassSecretReference struct {
+	// Name is the name of the Secret.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the Secret.
+	// +kubebuilder:validation:MinLength=1
+	Namespace string `json:"namespace"`
+}
+
+func init() {
+	SchemeBuilder.Register(&GatewayClassParameters{}, &GatewayClassParametersList{})
+}
@@ -61,6 +61,72 @@ type IngressClassParametersSpec struct {
 	// 3.0 or higher.
 	// +kubebuilder:default:=false
 	EnableLegacyRegexDetection bool `json:"enableLegacyRegexDetection,omitempty"`
+
+	// Defaults are class-wide defaults for the Kong Routes and Services translated from the Ingresses of the class.
+	// Route defaults are overridden by the annotations of each Ingress (e.g. `konghq.com/strip-path`) and Service
+	// defaults by the annotations of each Kubernetes Service (e.g. `konghq.com/read-timeout`).
+	// +optional
+	Defaults *IngressClassParametersDefaults `json:"defaults,omitempty"`
+}
+
+// IngressClassParametersDefaults defines the defaults applied to the Kong Routes and Services translated from the
+// Ingresses of the class. Fields that are not set fall back to the controller's defaults.
+type IngressClassParametersDefaults struct {
+	// Protocols are the protocols of the Kong Routes, equivalent of the `konghq.com/protocols` annotation.
+	// +optional
+	// +kubebuilder:validation:items:Enum=http;https;grpc;grpcs;ws;wss;tls;tcp;tls_passthrough
+	Protocols []string `json:"protocols,omitempty"`
+
+	// StripPath is the strip_path setting of the Kong Routes, equivalent of the `konghq.com/strip-path` annotation.
+	// +optional
+	StripPath *bool `json:"stripPath,omitempty"`
+
+	// PreserveHost is the preserve_host setting of the Kong Routes, equivalent of the `konghq.com/preserve-host`
+	// annotation.
+	// +optional
+	PreserveHost *bool `json:"preserveHost,omitempty"`
+
+	// PathHandling is the path_handling setting of the Kong Routes, equivalent of the `konghq.com/path-handling`
+	// annotation.
+	// +optional
+	// +kubebuilder:validation:Enum=v0;v1
+	PathHandling *string `json:"pathHandling,omitempty"`
+
+	// HTTPSRedirectStatusCode is the status code Kong responds with when a request that should use HTTPS uses HTTP,
+	// equivalent of the `konghq.com/https-redirect-status-code` annotation.
+	// +optional
+	// +kubebuilder:validation:Enum=301;302;307;308;426
+	HTTPSRedirectStatusCode *int `json:"httpsRedirectStatusCode,omitempty"`
+
+	// Plugins are the names of KongPlugins or KongClusterPlugins attached to the Kong Routes, equivalent of the
+	// `konghq.com/plugins` annotation. KongPlugins are looked up in the namespace of each Ingress.
+	// +optional
+	Plugins []string `json:"plugins,omitempty"`
+
+	// ConnectTimeout is the timeout in milliseconds for establishing a connection to the upstream, equivalent of the
+	// `konghq.com/connect-timeout` Service annotation.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	ConnectTimeout *int `json:"connectTimeout,omitempty"`
+
+	// ReadTimeout is the timeout in milliseconds between two read operations from the upstream, equivalent of the
+	// `konghq.com/read-timeout` Service annotation.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	ReadTimeout *int `json:"readTimeout,omitempty"`
+
+	// WriteTimeout is the timeout in milliseconds between two write operations to the upstream, equivalent of the
+	// `konghq.com/write-timeout` Service annotation.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	WriteTimeout *int `json:"writeTimeout,omitempty"`
+
+	// Retries is the number of retries when proxying to the upstream fails, equivalent of the `konghq.com/retries`
+	// Service annotation.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=32767
+	Retries *int `json:"retries,omitempty"`
 }
 
 func init() {
@@ -0,0 +1,101 @@
+/*
+Copyright 2024 Kong, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// KongExternalBackendKind is the string value representing the KongExternalBackend kind in Kubernetes.
+	KongExternalBackendKind = "KongExternalBackend"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=keb,categories=kong-ingress-controller
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`,description="Age"
+
+// KongExternalBackend is the schema for kongexternalbackends API which describes a set of
+// targets running outside of the Kubernetes cluster. It can be used as Kubernetes Ingress'
+// backend (via its path's `backend.resource` field) and as HTTPRoute's or TCPRoute's backendRef.
+// Each KongExternalBackend is translated to a dedicated Kong Service with an Upstream containing
+// its targets. Annotations supported on Kubernetes Services (e.g. `konghq.com/plugins`
+// or `konghq.com/upstream-policy`) can be used on KongExternalBackends as well.
+type KongExternalBackend struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              KongExternalBackendSpec `json:"spec"`
+}
+
+// KongExternalBackendSpec defines specification of a KongExternalBackend.
+type KongExternalBackendSpec struct {
+	// Targets is the list of targets the traffic is load balanced across.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	Targets []KongExternalBackendTarget `json:"targets"`
+
+	// TLS configures TLS connections to the targets. When it is set, Kong connects to the targets using TLS.
+	TLS *KongExternalBackendTLS `json:"tls,omitempty"`
+}
+
+// KongExternalBackendTarget is a single target of a KongExternalBackend.
+type KongExternalBackendTarget struct {
+	// Host is the hostname or the IP address of the target.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Host string `json:"host"`
+
+	// Port is the port of the target.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+
+	// Weight is the weight of the target used for load balancing. Targets with weight 0 receive no traffic.
+	// Defaults to 100 when not set.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	Weight *int32 `json:"weight,omitempty"`
+}
+
+// KongExternalBackendTLS defines TLS settings of connections to the targets of a KongExternalBackend.
+type KongExternalBackendTLS struct {
+	// Verify enables verification of the certificates presented by the targets.
+	Verify bool `json:"verify,omitempty"`
+
+	// VerifyDepth is the maximum depth of the certificate chain verified when Verify is enabled.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=64
+	VerifyDepth *int32 `json:"verifyDepth,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KongExternalBackendList contains a list of KongExternalBackend.
+type KongExternalBackendList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KongExternalBackend `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&KongExternalBackend{}, &KongExternalBackendList{})
+}
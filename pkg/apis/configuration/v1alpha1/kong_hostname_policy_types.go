@@ -0,0 +1,72 @@
+/*
+Copyright 2024 Kong, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	KongHostnamePolicyKind = "KongHostnamePolicy"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=khp,categories=kong-ingress-controller,path=konghostnamepolicies
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`,description="Age"
+
+// KongHostnamePolicy is the schema for konghostnamepolicies API which restricts the namespaces
+// in which Ingresses and HTTPRoutes may route traffic for the selected hostnames.
+type KongHostnamePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              KongHostnamePolicySpec `json:"spec"`
+}
+
+// KongHostnamePolicySpec defines specification of a KongHostnamePolicy.
+type KongHostnamePolicySpec struct {
+	// Hostnames is the list of hostnames owned by the namespaces. A hostname may be prefixed with
+	// a wildcard label (e.g. "*.example.com") to select all its subdomains.
+	// When a hostname is selected by multiple policies, the most specific hostname entry
+	// (an exact one over a wildcard one, a longer wildcard one over a shorter one) decides
+	// which namespaces own it.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=256
+	// +kubebuilder:validation:items:Pattern=`^(\*\.)?[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	Hostnames []string `json:"hostnames"`
+	// Namespaces is the list of namespaces which are allowed to route traffic for the hostnames.
+	// Routes from other namespaces using the hostnames, or wildcard and empty hostnames covering them, are rejected.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=256
+	Namespaces []string `json:"namespaces"`
+}
+
+// +kubebuilder:object:root=true
+
+// KongHostnamePolicyList contains a list of KongHostnamePolicy.
+type KongHostnamePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KongHostnamePolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&KongHostnamePolicy{}, &KongHostnamePolicyList{})
+}
@@ -33,6 +33,10 @@ type KongLicenseStatus struct {
 	// +listType=map
 	// +listMapKey=controllerName
 	KongLicenseControllerStatuses []KongLicenseControllerStatus `json:"controllers,omitempty"`
+	// ExpiresAt is the expiration time of the license parsed from its payload.
+	// It is not set when the license does not carry an expiration date.
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
 }
 
 // KongLicenseControllerStatus is the status of owning KongLicense being processed
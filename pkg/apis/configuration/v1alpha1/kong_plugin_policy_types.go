@@ -0,0 +1,71 @@
+/*
+Copyright 2024 Kong, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	KongPluginPolicyKind = "KongPluginPolicy"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=kpp,categories=kong-ingress-controller,path=kongpluginpolicies
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`,description="Age"
+// +kubebuilder:validation:XValidation:rule="!has(self.spec.allowedPlugins) || !has(self.spec.deniedPlugins)", message="Using both allowedPlugins and deniedPlugins is not allowed"
+
+// KongPluginPolicy is the schema for kongpluginpolicies API which restricts the Kong plugins
+// that KongPlugins and KongClusterPlugins may configure for resources in the selected namespaces.
+type KongPluginPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              KongPluginPolicySpec `json:"spec"`
+}
+
+// KongPluginPolicySpec defines specification of a KongPluginPolicy.
+type KongPluginPolicySpec struct {
+	// Namespaces is the list of namespaces the policy applies to.
+	// The policy applies to all namespaces when it is not set.
+	// +kubebuilder:validation:MaxItems=256
+	Namespaces []string `json:"namespaces,omitempty"`
+	// AllowedPlugins is the list of names of Kong plugins (e.g. "rate-limiting") which are allowed
+	// to be used in the selected namespaces. All other plugins are denied.
+	// +kubebuilder:validation:MinItems=1
+	AllowedPlugins []string `json:"allowedPlugins,omitempty"`
+	// DeniedPlugins is the list of names of Kong plugins (e.g. "pre-function") which are not allowed
+	// to be used in the selected namespaces.
+	// +kubebuilder:validation:MinItems=1
+	DeniedPlugins []string `json:"deniedPlugins,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KongPluginPolicyList contains a list of KongPluginPolicy.
+type KongPluginPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KongPluginPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&KongPluginPolicy{}, &KongPluginPolicyList{})
+}
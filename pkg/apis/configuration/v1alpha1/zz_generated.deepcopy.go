@@ -55,12 +55,140 @@ func (in *ControllerReference) DeepCopy() *ControllerReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayClassListenerTLS) DeepCopyInto(out *GatewayClassListenerTLS) {
+	*out = *in
+	if in.CertificateRef != nil {
+		in, out := &in.CertificateRef, &out.CertificateRef
+		*out = new(GatewayClassSecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayClassListenerTLS.
+func (in *GatewayClassListenerTLS) DeepCopy() *GatewayClassListenerTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayClassListenerTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayClassParameters) DeepCopyInto(out *GatewayClassParameters) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayClassParameters.
+func (in *GatewayClassParameters) DeepCopy() *GatewayClassParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayClassParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GatewayClassParameters) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayClassParametersList) DeepCopyInto(out *GatewayClassParametersList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GatewayClassParameters, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayClassParametersList.
+func (in *GatewayClassParametersList) DeepCopy() *GatewayClassParametersList {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayClassParametersList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GatewayClassParametersList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayClassParametersSpec) DeepCopyInto(out *GatewayClassParametersSpec) {
+	*out = *in
+	if in.RouterFlavor != nil {
+		in, out := &in.RouterFlavor, &out.RouterFlavor
+		*out = new(string)
+		**out = **in
+	}
+	if in.ListenerTLS != nil {
+		in, out := &in.ListenerTLS, &out.ListenerTLS
+		*out = new(GatewayClassListenerTLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Plugins != nil {
+		in, out := &in.Plugins, &out.Plugins
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PublishServices != nil {
+		in, out := &in.PublishServices, &out.PublishServices
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayClassParametersSpec.
+func (in *GatewayClassParametersSpec) DeepCopy() *GatewayClassParametersSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayClassParametersSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayClassSecretReference) DeepCopyInto(out *GatewayClassSecretReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayClassSecretReference.
+func (in *GatewayClassSecretReference) DeepCopy() *GatewayClassSecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayClassSecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IngressClassParameters) DeepCopyInto(out *IngressClassParameters) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressClassParameters.
@@ -81,6 +209,71 @@ func (in *IngressClassParameters) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressClassParametersDefaults) DeepCopyInto(out *IngressClassParametersDefaults) {
+	*out = *in
+	if in.Protocols != nil {
+		in, out := &in.Protocols, &out.Protocols
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StripPath != nil {
+		in, out := &in.StripPath, &out.StripPath
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PreserveHost != nil {
+		in, out := &in.PreserveHost, &out.PreserveHost
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PathHandling != nil {
+		in, out := &in.PathHandling, &out.PathHandling
+		*out = new(string)
+		**out = **in
+	}
+	if in.HTTPSRedirectStatusCode != nil {
+		in, out := &in.HTTPSRedirectStatusCode, &out.HTTPSRedirectStatusCode
+		*out = new(int)
+		**out = **in
+	}
+	if in.Plugins != nil {
+		in, out := &in.Plugins, &out.Plugins
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ConnectTimeout != nil {
+		in, out := &in.ConnectTimeout, &out.ConnectTimeout
+		*out = new(int)
+		**out = **in
+	}
+	if in.ReadTimeout != nil {
+		in, out := &in.ReadTimeout, &out.ReadTimeout
+		*out = new(int)
+		**out = **in
+	}
+	if in.WriteTimeout != nil {
+		in, out := &in.WriteTimeout, &out.WriteTimeout
+		*out = new(int)
+		**out = **in
+	}
+	if in.Retries != nil {
+		in, out := &in.Retries, &out.Retries
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressClassParametersDefaults.
+func (in *IngressClassParametersDefaults) DeepCopy() *IngressClassParametersDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressClassParametersDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IngressClassParametersList) DeepCopyInto(out *IngressClassParametersList) {
 	*out = *in
@@ -116,6 +309,11 @@ func (in *IngressClassParametersList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IngressClassParametersSpec) DeepCopyInto(out *IngressClassParametersSpec) {
 	*out = *in
+	if in.Defaults != nil {
+		in, out := &in.Defaults, &out.Defaults
+		*out = new(IngressClassParametersDefaults)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressClassParametersSpec.
@@ -230,6 +428,214 @@ func (in *KongCustomEntityStatus) DeepCopy() *KongCustomEntityStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongExternalBackend) DeepCopyInto(out *KongExternalBackend) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongExternalBackend.
+func (in *KongExternalBackend) DeepCopy() *KongExternalBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(KongExternalBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KongExternalBackend) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongExternalBackendList) DeepCopyInto(out *KongExternalBackendList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KongExternalBackend, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongExternalBackendList.
+func (in *KongExternalBackendList) DeepCopy() *KongExternalBackendList {
+	if in == nil {
+		return nil
+	}
+	out := new(KongExternalBackendList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KongExternalBackendList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongExternalBackendSpec) DeepCopyInto(out *KongExternalBackendSpec) {
+	*out = *in
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]KongExternalBackendTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(KongExternalBackendTLS)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongExternalBackendSpec.
+func (in *KongExternalBackendSpec) DeepCopy() *KongExternalBackendSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KongExternalBackendSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongExternalBackendTLS) DeepCopyInto(out *KongExternalBackendTLS) {
+	*out = *in
+	if in.VerifyDepth != nil {
+		in, out := &in.VerifyDepth, &out.VerifyDepth
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongExternalBackendTLS.
+func (in *KongExternalBackendTLS) DeepCopy() *KongExternalBackendTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(KongExternalBackendTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongExternalBackendTarget) DeepCopyInto(out *KongExternalBackendTarget) {
+	*out = *in
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongExternalBackendTarget.
+func (in *KongExternalBackendTarget) DeepCopy() *KongExternalBackendTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(KongExternalBackendTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongHostnamePolicy) DeepCopyInto(out *KongHostnamePolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongHostnamePolicy.
+func (in *KongHostnamePolicy) DeepCopy() *KongHostnamePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(KongHostnamePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KongHostnamePolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongHostnamePolicyList) DeepCopyInto(out *KongHostnamePolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KongHostnamePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongHostnamePolicyList.
+func (in *KongHostnamePolicyList) DeepCopy() *KongHostnamePolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(KongHostnamePolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KongHostnamePolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongHostnamePolicySpec) DeepCopyInto(out *KongHostnamePolicySpec) {
+	*out = *in
+	if in.Hostnames != nil {
+		in, out := &in.Hostnames, &out.Hostnames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongHostnamePolicySpec.
+func (in *KongHostnamePolicySpec) DeepCopy() *KongHostnamePolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KongHostnamePolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KongLicense) DeepCopyInto(out *KongLicense) {
 	*out = *in
@@ -325,6 +731,10 @@ func (in *KongLicenseStatus) DeepCopyInto(out *KongLicenseStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongLicenseStatus.
@@ -337,6 +747,94 @@ func (in *KongLicenseStatus) DeepCopy() *KongLicenseStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongPluginPolicy) DeepCopyInto(out *KongPluginPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongPluginPolicy.
+func (in *KongPluginPolicy) DeepCopy() *KongPluginPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(KongPluginPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KongPluginPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongPluginPolicyList) DeepCopyInto(out *KongPluginPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KongPluginPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongPluginPolicyList.
+func (in *KongPluginPolicyList) DeepCopy() *KongPluginPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(KongPluginPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KongPluginPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongPluginPolicySpec) DeepCopyInto(out *KongPluginPolicySpec) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedPlugins != nil {
+		in, out := &in.AllowedPlugins, &out.AllowedPlugins
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeniedPlugins != nil {
+		in, out := &in.DeniedPlugins, &out.DeniedPlugins
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongPluginPolicySpec.
+func (in *KongPluginPolicySpec) DeepCopy() *KongPluginPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KongPluginPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KongVault) DeepCopyInto(out *KongVault) {
 	*out = *in
@@ -27,6 +27,7 @@ import (
 // +kubebuilder:storageversion
 // +kubebuilder:resource:shortName=kcg,categories=kong-ingress-controller
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`,description="Age"
+// +kubebuilder:printcolumn:name="Consumers",type=integer,JSONPath=`.status.consumers`,description="Number of member consumers"
 // +kubebuilder:printcolumn:name="Programmed",type=string,JSONPath=`.status.conditions[?(@.type=="Programmed")].status`
 
 // KongConsumerGroup is the Schema for the kongconsumergroups API.
@@ -60,6 +61,36 @@ type KongConsumerGroupStatus struct {
 	// +kubebuilder:validation:MaxItems=8
 	// +kubebuilder:default={{type: "Programmed", status: "Unknown", reason:"Pending", message:"Waiting for controller", lastTransitionTime: "1970-01-01T00:00:00Z"}}
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Consumers is the number of KongConsumers that are members of the KongConsumerGroup
+	// in the configuration last applied to Kong.
+	//
+	// +optional
+	Consumers int32 `json:"consumers,omitempty"`
+
+	// Plugins are the plugins scoped to the KongConsumerGroup in the configuration last applied to Kong.
+	//
+	// +optional
+	Plugins []KongConsumerGroupPluginStatus `json:"plugins,omitempty"`
+}
+
+// KongConsumerGroupPluginStatus describes a plugin scoped to a KongConsumerGroup.
+type KongConsumerGroupPluginStatus struct {
+	// Kind is the kind of the resource configuring the plugin, KongPlugin or KongClusterPlugin.
+	//
+	// +kubebuilder:validation:Enum=KongPlugin;KongClusterPlugin
+	Kind string `json:"kind"`
+
+	// Namespace is the namespace of the KongPlugin. It's empty for KongClusterPlugins.
+	//
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the name of the resource configuring the plugin.
+	Name string `json:"name"`
+
+	// PluginName is the name of the Kong plugin.
+	PluginName string `json:"pluginName"`
 }
 
 func init() {
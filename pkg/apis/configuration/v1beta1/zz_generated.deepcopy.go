@@ -134,6 +134,21 @@ func (in *KongConsumerGroupList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongConsumerGroupPluginStatus) DeepCopyInto(out *KongConsumerGroupPluginStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongConsumerGroupPluginStatus.
+func (in *KongConsumerGroupPluginStatus) DeepCopy() *KongConsumerGroupPluginStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KongConsumerGroupPluginStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KongConsumerGroupStatus) DeepCopyInto(out *KongConsumerGroupStatus) {
 	*out = *in
@@ -144,6 +159,11 @@ func (in *KongConsumerGroupStatus) DeepCopyInto(out *KongConsumerGroupStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Plugins != nil {
+		in, out := &in.Plugins, &out.Plugins
+		*out = make([]KongConsumerGroupPluginStatus, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongConsumerGroupStatus.
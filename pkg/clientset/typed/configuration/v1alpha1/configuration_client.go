@@ -28,9 +28,13 @@ import (
 
 type ConfigurationV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	GatewayClassParametersesGetter
 	IngressClassParametersesGetter
 	KongCustomEntitiesGetter
+	KongExternalBackendsGetter
+	KongHostnamePoliciesGetter
 	KongLicensesGetter
+	KongPluginPoliciesGetter
 	KongVaultsGetter
 }
 
@@ -39,6 +43,10 @@ type ConfigurationV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *ConfigurationV1alpha1Client) GatewayClassParameterses(namespace string) GatewayClassParametersInterface {
+	return newGatewayClassParameterses(c, namespace)
+}
+
 func (c *ConfigurationV1alpha1Client) IngressClassParameterses(namespace string) IngressClassParametersInterface {
 	return newIngressClassParameterses(c, namespace)
 }
@@ -47,10 +55,22 @@ func (c *ConfigurationV1alpha1Client) KongCustomEntities(namespace string) KongC
 	return newKongCustomEntities(c, namespace)
 }
 
+func (c *ConfigurationV1alpha1Client) KongExternalBackends(namespace string) KongExternalBackendInterface {
+	return newKongExternalBackends(c, namespace)
+}
+
+func (c *ConfigurationV1alpha1Client) KongHostnamePolicies() KongHostnamePolicyInterface {
+	return newKongHostnamePolicies(c)
+}
+
 func (c *ConfigurationV1alpha1Client) KongLicenses() KongLicenseInterface {
 	return newKongLicenses(c)
 }
 
+func (c *ConfigurationV1alpha1Client) KongPluginPolicies() KongPluginPolicyInterface {
+	return newKongPluginPolicies(c)
+}
+
 func (c *ConfigurationV1alpha1Client) KongVaults() KongVaultInterface {
 	return newKongVaults(c)
 }
@@ -28,6 +28,10 @@ type FakeConfigurationV1alpha1 struct {
 	*testing.Fake
 }
 
+func (c *FakeConfigurationV1alpha1) GatewayClassParameterses(namespace string) v1alpha1.GatewayClassParametersInterface {
+	return &FakeGatewayClassParameterses{c, namespace}
+}
+
 func (c *FakeConfigurationV1alpha1) IngressClassParameterses(namespace string) v1alpha1.IngressClassParametersInterface {
 	return &FakeIngressClassParameterses{c, namespace}
 }
@@ -36,10 +40,22 @@ func (c *FakeConfigurationV1alpha1) KongCustomEntities(namespace string) v1alpha
 	return &FakeKongCustomEntities{c, namespace}
 }
 
+func (c *FakeConfigurationV1alpha1) KongExternalBackends(namespace string) v1alpha1.KongExternalBackendInterface {
+	return &FakeKongExternalBackends{c, namespace}
+}
+
+func (c *FakeConfigurationV1alpha1) KongHostnamePolicies() v1alpha1.KongHostnamePolicyInterface {
+	return &FakeKongHostnamePolicies{c}
+}
+
 func (c *FakeConfigurationV1alpha1) KongLicenses() v1alpha1.KongLicenseInterface {
 	return &FakeKongLicenses{c}
 }
 
+func (c *FakeConfigurationV1alpha1) KongPluginPolicies() v1alpha1.KongPluginPolicyInterface {
+	return &FakeKongPluginPolicies{c}
+}
+
 func (c *FakeConfigurationV1alpha1) KongVaults() v1alpha1.KongVaultInterface {
 	return &FakeKongVaults{c}
 }
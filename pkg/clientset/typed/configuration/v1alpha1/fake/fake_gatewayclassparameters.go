@@ -0,0 +1,129 @@
+/*
+Copyright 2021 Kong, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeGatewayClassParameterses implements GatewayClassParametersInterface
+type FakeGatewayClassParameterses struct {
+	Fake *FakeConfigurationV1alpha1
+	ns   string
+}
+
+var gatewayclassparametersesResource = v1alpha1.SchemeGroupVersion.WithResource("gatewayclassparameterses")
+
+var gatewayclassparametersesKind = v1alpha1.SchemeGroupVersion.WithKind("GatewayClassParameters")
+
+// Get takes name of the gatewayClassParameters, and returns the corresponding gatewayClassParameters object, and an error if there is any.
+func (c *FakeGatewayClassParameterses) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.GatewayClassParameters, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(gatewayclassparametersesResource, c.ns, name), &v1alpha1.GatewayClassParameters{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.GatewayClassParameters), err
+}
+
+// List takes label and field selectors, and returns the list of GatewayClassParameterses that match those selectors.
+func (c *FakeGatewayClassParameterses) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.GatewayClassParametersList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(gatewayclassparametersesResource, gatewayclassparametersesKind, c.ns, opts), &v1alpha1.GatewayClassParametersList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.GatewayClassParametersList{ListMeta: obj.(*v1alpha1.GatewayClassParametersList).ListMeta}
+	for _, item := range obj.(*v1alpha1.GatewayClassParametersList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested gatewayClassParameterses.
+func (c *FakeGatewayClassParameterses) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(gatewayclassparametersesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a gatewayClassParameters and creates it.  Returns the server's representation of the gatewayClassParameters, and an error, if there is any.
+func (c *FakeGatewayClassParameterses) Create(ctx context.Context, gatewayClassParameters *v1alpha1.GatewayClassParameters, opts v1.CreateOptions) (result *v1alpha1.GatewayClassParameters, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(gatewayclassparametersesResource, c.ns, gatewayClassParameters), &v1alpha1.GatewayClassParameters{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.GatewayClassParameters), err
+}
+
+// Update takes the representation of a gatewayClassParameters and updates it. Returns the server's representation of the gatewayClassParameters, and an error, if there is any.
+func (c *FakeGatewayClassParameterses) Update(ctx context.Context, gatewayClassParameters *v1alpha1.GatewayClassParameters, opts v1.UpdateOptions) (result *v1alpha1.GatewayClassParameters, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(gatewayclassparametersesResource, c.ns, gatewayClassParameters), &v1alpha1.GatewayClassParameters{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.GatewayClassParameters), err
+}
+
+// Delete takes name of the gatewayClassParameters and deletes it. Returns an error if one occurs.
+func (c *FakeGatewayClassParameterses) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(gatewayclassparametersesResource, c.ns, name, opts), &v1alpha1.GatewayClassParameters{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeGatewayClassParameterses) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(gatewayclassparametersesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.GatewayClassParametersList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched gatewayClassParameters.
+func (c *FakeGatewayClassParameterses) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.GatewayClassParameters, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(gatewayclassparametersesResource, c.ns, name, pt, data, subresources...), &v1alpha1.GatewayClassParameters{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.GatewayClassParameters), err
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2021 Kong, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeKongExternalBackends implements KongExternalBackendInterface
+type FakeKongExternalBackends struct {
+	Fake *FakeConfigurationV1alpha1
+	ns   string
+}
+
+var kongexternalbackendsResource = v1alpha1.SchemeGroupVersion.WithResource("kongexternalbackends")
+
+var kongexternalbackendsKind = v1alpha1.SchemeGroupVersion.WithKind("KongExternalBackend")
+
+// Get takes name of the kongExternalBackend, and returns the corresponding kongExternalBackend object, and an error if there is any.
+func (c *FakeKongExternalBackends) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.KongExternalBackend, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(kongexternalbackendsResource, c.ns, name), &v1alpha1.KongExternalBackend{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.KongExternalBackend), err
+}
+
+// List takes label and field selectors, and returns the list of KongExternalBackends that match those selectors.
+func (c *FakeKongExternalBackends) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.KongExternalBackendList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(kongexternalbackendsResource, kongexternalbackendsKind, c.ns, opts), &v1alpha1.KongExternalBackendList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.KongExternalBackendList{ListMeta: obj.(*v1alpha1.KongExternalBackendList).ListMeta}
+	for _, item := range obj.(*v1alpha1.KongExternalBackendList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested kongExternalBackends.
+func (c *FakeKongExternalBackends) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(kongexternalbackendsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a kongExternalBackend and creates it.  Returns the server's representation of the kongExternalBackend, and an error, if there is any.
+func (c *FakeKongExternalBackends) Create(ctx context.Context, kongExternalBackend *v1alpha1.KongExternalBackend, opts v1.CreateOptions) (result *v1alpha1.KongExternalBackend, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(kongexternalbackendsResource, c.ns, kongExternalBackend), &v1alpha1.KongExternalBackend{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.KongExternalBackend), err
+}
+
+// Update takes the representation of a kongExternalBackend and updates it. Returns the server's representation of the kongExternalBackend, and an error, if there is any.
+func (c *FakeKongExternalBackends) Update(ctx context.Context, kongExternalBackend *v1alpha1.KongExternalBackend, opts v1.UpdateOptions) (result *v1alpha1.KongExternalBackend, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(kongexternalbackendsResource, c.ns, kongExternalBackend), &v1alpha1.KongExternalBackend{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.KongExternalBackend), err
+}
+
+// Delete takes name of the kongExternalBackend and deletes it. Returns an error if one occurs.
+func (c *FakeKongExternalBackends) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(kongexternalbackendsResource, c.ns, name, opts), &v1alpha1.KongExternalBackend{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeKongExternalBackends) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(kongexternalbackendsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.KongExternalBackendList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched kongExternalBackend.
+func (c *FakeKongExternalBackends) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.KongExternalBackend, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(kongexternalbackendsResource, c.ns, name, pt, data, subresources...), &v1alpha1.KongExternalBackend{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.KongExternalBackend), err
+}
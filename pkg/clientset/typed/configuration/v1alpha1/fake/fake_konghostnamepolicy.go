@@ -0,0 +1,121 @@
+/*
+Copyright 2021 Kong, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeKongHostnamePolicies implements KongHostnamePolicyInterface
+type FakeKongHostnamePolicies struct {
+	Fake *FakeConfigurationV1alpha1
+}
+
+var konghostnamepoliciesResource = v1alpha1.SchemeGroupVersion.WithResource("konghostnamepolicies")
+
+var konghostnamepoliciesKind = v1alpha1.SchemeGroupVersion.WithKind("KongHostnamePolicy")
+
+// Get takes name of the kongHostnamePolicy, and returns the corresponding kongHostnamePolicy object, and an error if there is any.
+func (c *FakeKongHostnamePolicies) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.KongHostnamePolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(konghostnamepoliciesResource, name), &v1alpha1.KongHostnamePolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.KongHostnamePolicy), err
+}
+
+// List takes label and field selectors, and returns the list of KongHostnamePolicies that match those selectors.
+func (c *FakeKongHostnamePolicies) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.KongHostnamePolicyList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(konghostnamepoliciesResource, konghostnamepoliciesKind, opts), &v1alpha1.KongHostnamePolicyList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.KongHostnamePolicyList{ListMeta: obj.(*v1alpha1.KongHostnamePolicyList).ListMeta}
+	for _, item := range obj.(*v1alpha1.KongHostnamePolicyList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested kongHostnamePolicies.
+func (c *FakeKongHostnamePolicies) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(konghostnamepoliciesResource, opts))
+}
+
+// Create takes the representation of a kongHostnamePolicy and creates it.  Returns the server's representation of the kongHostnamePolicy, and an error, if there is any.
+func (c *FakeKongHostnamePolicies) Create(ctx context.Context, kongHostnamePolicy *v1alpha1.KongHostnamePolicy, opts v1.CreateOptions) (result *v1alpha1.KongHostnamePolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(konghostnamepoliciesResource, kongHostnamePolicy), &v1alpha1.KongHostnamePolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.KongHostnamePolicy), err
+}
+
+// Update takes the representation of a kongHostnamePolicy and updates it. Returns the server's representation of the kongHostnamePolicy, and an error, if there is any.
+func (c *FakeKongHostnamePolicies) Update(ctx context.Context, kongHostnamePolicy *v1alpha1.KongHostnamePolicy, opts v1.UpdateOptions) (result *v1alpha1.KongHostnamePolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(konghostnamepoliciesResource, kongHostnamePolicy), &v1alpha1.KongHostnamePolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.KongHostnamePolicy), err
+}
+
+// Delete takes name of the kongHostnamePolicy and deletes it. Returns an error if one occurs.
+func (c *FakeKongHostnamePolicies) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteActionWithOptions(konghostnamepoliciesResource, name, opts), &v1alpha1.KongHostnamePolicy{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeKongHostnamePolicies) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(konghostnamepoliciesResource, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.KongHostnamePolicyList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched kongHostnamePolicy.
+func (c *FakeKongHostnamePolicies) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.KongHostnamePolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(konghostnamepoliciesResource, name, pt, data, subresources...), &v1alpha1.KongHostnamePolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.KongHostnamePolicy), err
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2021 Kong, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeKongPluginPolicies implements KongPluginPolicyInterface
+type FakeKongPluginPolicies struct {
+	Fake *FakeConfigurationV1alpha1
+}
+
+var kongpluginpoliciesResource = v1alpha1.SchemeGroupVersion.WithResource("kongpluginpolicies")
+
+var kongpluginpoliciesKind = v1alpha1.SchemeGroupVersion.WithKind("KongPluginPolicy")
+
+// Get takes name of the kongPluginPolicy, and returns the corresponding kongPluginPolicy object, and an error if there is any.
+func (c *FakeKongPluginPolicies) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.KongPluginPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(kongpluginpoliciesResource, name), &v1alpha1.KongPluginPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.KongPluginPolicy), err
+}
+
+// List takes label and field selectors, and returns the list of KongPluginPolicies that match those selectors.
+func (c *FakeKongPluginPolicies) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.KongPluginPolicyList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(kongpluginpoliciesResource, kongpluginpoliciesKind, opts), &v1alpha1.KongPluginPolicyList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.KongPluginPolicyList{ListMeta: obj.(*v1alpha1.KongPluginPolicyList).ListMeta}
+	for _, item := range obj.(*v1alpha1.KongPluginPolicyList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested kongPluginPolicies.
+func (c *FakeKongPluginPolicies) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(kongpluginpoliciesResource, opts))
+}
+
+// Create takes the representation of a kongPluginPolicy and creates it.  Returns the server's representation of the kongPluginPolicy, and an error, if there is any.
+func (c *FakeKongPluginPolicies) Create(ctx context.Context, kongPluginPolicy *v1alpha1.KongPluginPolicy, opts v1.CreateOptions) (result *v1alpha1.KongPluginPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(kongpluginpoliciesResource, kongPluginPolicy), &v1alpha1.KongPluginPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.KongPluginPolicy), err
+}
+
+// Update takes the representation of a kongPluginPolicy and updates it. Returns the server's representation of the kongPluginPolicy, and an error, if there is any.
+func (c *FakeKongPluginPolicies) Update(ctx context.Context, kongPluginPolicy *v1alpha1.KongPluginPolicy, opts v1.UpdateOptions) (result *v1alpha1.KongPluginPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(kongpluginpoliciesResource, kongPluginPolicy), &v1alpha1.KongPluginPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.KongPluginPolicy), err
+}
+
+// Delete takes name of the kongPluginPolicy and deletes it. Returns an error if one occurs.
+func (c *FakeKongPluginPolicies) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteActionWithOptions(kongpluginpoliciesResource, name, opts), &v1alpha1.KongPluginPolicy{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeKongPluginPolicies) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(kongpluginpoliciesResource, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.KongPluginPolicyList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched kongPluginPolicy.
+func (c *FakeKongPluginPolicies) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.KongPluginPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(kongpluginpoliciesResource, name, pt, data, subresources...), &v1alpha1.KongPluginPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.KongPluginPolicy), err
+}
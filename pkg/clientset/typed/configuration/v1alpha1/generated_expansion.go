@@ -18,10 +18,18 @@ limitations under the License.
 
 package v1alpha1
 
+type GatewayClassParametersExpansion interface{}
+
 type IngressClassParametersExpansion interface{}
 
 type KongCustomEntityExpansion interface{}
 
+type KongExternalBackendExpansion interface{}
+
+type KongHostnamePolicyExpansion interface{}
+
 type KongLicenseExpansion interface{}
 
+type KongPluginPolicyExpansion interface{}
+
 type KongVaultExpansion interface{}
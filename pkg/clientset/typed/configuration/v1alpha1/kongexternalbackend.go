@@ -0,0 +1,178 @@
+/*
+Copyright 2021 Kong, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
+	scheme "github.com/kong/kubernetes-ingress-controller/v3/pkg/clientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// KongExternalBackendsGetter has a method to return a KongExternalBackendInterface.
+// A group's client should implement this interface.
+type KongExternalBackendsGetter interface {
+	KongExternalBackends(namespace string) KongExternalBackendInterface
+}
+
+// KongExternalBackendInterface has methods to work with KongExternalBackend resources.
+type KongExternalBackendInterface interface {
+	Create(ctx context.Context, kongExternalBackend *v1alpha1.KongExternalBackend, opts v1.CreateOptions) (*v1alpha1.KongExternalBackend, error)
+	Update(ctx context.Context, kongExternalBackend *v1alpha1.KongExternalBackend, opts v1.UpdateOptions) (*v1alpha1.KongExternalBackend, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.KongExternalBackend, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.KongExternalBackendList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.KongExternalBackend, err error)
+	KongExternalBackendExpansion
+}
+
+// kongExternalBackends implements KongExternalBackendInterface
+type kongExternalBackends struct {
+	client rest.Interface
+	ns     string
+}
+
+// newKongExternalBackends returns a KongExternalBackends
+func newKongExternalBackends(c *ConfigurationV1alpha1Client, namespace string) *kongExternalBackends {
+	return &kongExternalBackends{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the kongExternalBackend, and returns the corresponding kongExternalBackend object, and an error if there is any.
+func (c *kongExternalBackends) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.KongExternalBackend, err error) {
+	result = &v1alpha1.KongExternalBackend{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("kongexternalbackends").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of KongExternalBackends that match those selectors.
+func (c *kongExternalBackends) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.KongExternalBackendList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.KongExternalBackendList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("kongexternalbackends").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested kongExternalBackends.
+func (c *kongExternalBackends) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("kongexternalbackends").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a kongExternalBackend and creates it.  Returns the server's representation of the kongExternalBackend, and an error, if there is any.
+func (c *kongExternalBackends) Create(ctx context.Context, kongExternalBackend *v1alpha1.KongExternalBackend, opts v1.CreateOptions) (result *v1alpha1.KongExternalBackend, err error) {
+	result = &v1alpha1.KongExternalBackend{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("kongexternalbackends").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(kongExternalBackend).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a kongExternalBackend and updates it. Returns the server's representation of the kongExternalBackend, and an error, if there is any.
+func (c *kongExternalBackends) Update(ctx context.Context, kongExternalBackend *v1alpha1.KongExternalBackend, opts v1.UpdateOptions) (result *v1alpha1.KongExternalBackend, err error) {
+	result = &v1alpha1.KongExternalBackend{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("kongexternalbackends").
+		Name(kongExternalBackend.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(kongExternalBackend).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the kongExternalBackend and deletes it. Returns an error if one occurs.
+func (c *kongExternalBackends) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("kongexternalbackends").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *kongExternalBackends) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("kongexternalbackends").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched kongExternalBackend.
+func (c *kongExternalBackends) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.KongExternalBackend, err error) {
+	result = &v1alpha1.KongExternalBackend{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("kongexternalbackends").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
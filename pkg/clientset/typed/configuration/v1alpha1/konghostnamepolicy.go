@@ -0,0 +1,168 @@
+/*
+Copyright 2021 Kong, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
+	scheme "github.com/kong/kubernetes-ingress-controller/v3/pkg/clientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// KongHostnamePoliciesGetter has a method to return a KongHostnamePolicyInterface.
+// A group's client should implement this interface.
+type KongHostnamePoliciesGetter interface {
+	KongHostnamePolicies() KongHostnamePolicyInterface
+}
+
+// KongHostnamePolicyInterface has methods to work with KongHostnamePolicy resources.
+type KongHostnamePolicyInterface interface {
+	Create(ctx context.Context, kongHostnamePolicy *v1alpha1.KongHostnamePolicy, opts v1.CreateOptions) (*v1alpha1.KongHostnamePolicy, error)
+	Update(ctx context.Context, kongHostnamePolicy *v1alpha1.KongHostnamePolicy, opts v1.UpdateOptions) (*v1alpha1.KongHostnamePolicy, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.KongHostnamePolicy, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.KongHostnamePolicyList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.KongHostnamePolicy, err error)
+	KongHostnamePolicyExpansion
+}
+
+// kongHostnamePolicies implements KongHostnamePolicyInterface
+type kongHostnamePolicies struct {
+	client rest.Interface
+}
+
+// newKongHostnamePolicies returns a KongHostnamePolicies
+func newKongHostnamePolicies(c *ConfigurationV1alpha1Client) *kongHostnamePolicies {
+	return &kongHostnamePolicies{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the kongHostnamePolicy, and returns the corresponding kongHostnamePolicy object, and an error if there is any.
+func (c *kongHostnamePolicies) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.KongHostnamePolicy, err error) {
+	result = &v1alpha1.KongHostnamePolicy{}
+	err = c.client.Get().
+		Resource("konghostnamepolicies").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of KongHostnamePolicies that match those selectors.
+func (c *kongHostnamePolicies) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.KongHostnamePolicyList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.KongHostnamePolicyList{}
+	err = c.client.Get().
+		Resource("konghostnamepolicies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested kongHostnamePolicies.
+func (c *kongHostnamePolicies) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("konghostnamepolicies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a kongHostnamePolicy and creates it.  Returns the server's representation of the kongHostnamePolicy, and an error, if there is any.
+func (c *kongHostnamePolicies) Create(ctx context.Context, kongHostnamePolicy *v1alpha1.KongHostnamePolicy, opts v1.CreateOptions) (result *v1alpha1.KongHostnamePolicy, err error) {
+	result = &v1alpha1.KongHostnamePolicy{}
+	err = c.client.Post().
+		Resource("konghostnamepolicies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(kongHostnamePolicy).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a kongHostnamePolicy and updates it. Returns the server's representation of the kongHostnamePolicy, and an error, if there is any.
+func (c *kongHostnamePolicies) Update(ctx context.Context, kongHostnamePolicy *v1alpha1.KongHostnamePolicy, opts v1.UpdateOptions) (result *v1alpha1.KongHostnamePolicy, err error) {
+	result = &v1alpha1.KongHostnamePolicy{}
+	err = c.client.Put().
+		Resource("konghostnamepolicies").
+		Name(kongHostnamePolicy.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(kongHostnamePolicy).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the kongHostnamePolicy and deletes it. Returns an error if one occurs.
+func (c *kongHostnamePolicies) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("konghostnamepolicies").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *kongHostnamePolicies) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("konghostnamepolicies").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched kongHostnamePolicy.
+func (c *kongHostnamePolicies) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.KongHostnamePolicy, err error) {
+	result = &v1alpha1.KongHostnamePolicy{}
+	err = c.client.Patch(pt).
+		Resource("konghostnamepolicies").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2021 Kong, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
+	scheme "github.com/kong/kubernetes-ingress-controller/v3/pkg/clientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// KongPluginPoliciesGetter has a method to return a KongPluginPolicyInterface.
+// A group's client should implement this interface.
+type KongPluginPoliciesGetter interface {
+	KongPluginPolicies() KongPluginPolicyInterface
+}
+
+// KongPluginPolicyInterface has methods to work with KongPluginPolicy resources.
+type KongPluginPolicyInterface interface {
+	Create(ctx context.Context, kongPluginPolicy *v1alpha1.KongPluginPolicy, opts v1.CreateOptions) (*v1alpha1.KongPluginPolicy, error)
+	Update(ctx context.Context, kongPluginPolicy *v1alpha1.KongPluginPolicy, opts v1.UpdateOptions) (*v1alpha1.KongPluginPolicy, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.KongPluginPolicy, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.KongPluginPolicyList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.KongPluginPolicy, err error)
+	KongPluginPolicyExpansion
+}
+
+// kongPluginPolicies implements KongPluginPolicyInterface
+type kongPluginPolicies struct {
+	client rest.Interface
+}
+
+// newKongPluginPolicies returns a KongPluginPolicies
+func newKongPluginPolicies(c *ConfigurationV1alpha1Client) *kongPluginPolicies {
+	return &kongPluginPolicies{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the kongPluginPolicy, and returns the corresponding kongPluginPolicy object, and an error if there is any.
+func (c *kongPluginPolicies) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.KongPluginPolicy, err error) {
+	result = &v1alpha1.KongPluginPolicy{}
+	err = c.client.Get().
+		Resource("kongpluginpolicies").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of KongPluginPolicies that match those selectors.
+func (c *kongPluginPolicies) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.KongPluginPolicyList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.KongPluginPolicyList{}
+	err = c.client.Get().
+		Resource("kongpluginpolicies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested kongPluginPolicies.
+func (c *kongPluginPolicies) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("kongpluginpolicies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a kongPluginPolicy and creates it.  Returns the server's representation of the kongPluginPolicy, and an error, if there is any.
+func (c *kongPluginPolicies) Create(ctx context.Context, kongPluginPolicy *v1alpha1.KongPluginPolicy, opts v1.CreateOptions) (result *v1alpha1.KongPluginPolicy, err error) {
+	result = &v1alpha1.KongPluginPolicy{}
+	err = c.client.Post().
+		Resource("kongpluginpolicies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(kongPluginPolicy).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a kongPluginPolicy and updates it. Returns the server's representation of the kongPluginPolicy, and an error, if there is any.
+func (c *kongPluginPolicies) Update(ctx context.Context, kongPluginPolicy *v1alpha1.KongPluginPolicy, opts v1.UpdateOptions) (result *v1alpha1.KongPluginPolicy, err error) {
+	result = &v1alpha1.KongPluginPolicy{}
+	err = c.client.Put().
+		Resource("kongpluginpolicies").
+		Name(kongPluginPolicy.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(kongPluginPolicy).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the kongPluginPolicy and deletes it. Returns an error if one occurs.
+func (c *kongPluginPolicies) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("kongpluginpolicies").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *kongPluginPolicies) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("kongpluginpolicies").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched kongPluginPolicy.
+func (c *kongPluginPolicies) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.KongPluginPolicy, err error) {
+	result = &v1alpha1.KongPluginPolicy{}
+	err = c.client.Patch(pt).
+		Resource("kongpluginpolicies").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -7,6 +7,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	k8sobj "github.com/kong/kubernetes-ingress-controller/v3/internal/util/kubernetes/object"
+	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
+	kongv1beta1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1beta1"
 )
 
 type Dataplane struct {
@@ -16,6 +18,10 @@ type Dataplane struct {
 	// https://github.com/Kong/kubernetes-ingress-controller/issues/3793
 	// which requires the status to be reported for route objects.
 	ObjectsStatuses map[string]map[string]k8sobj.ConfigurationStatus
+	// Mapping namespace to name to status of KongConsumers.
+	KongConsumersStatuses map[string]map[string]kongv1.KongConsumerStatus
+	// Mapping namespace to name to status of KongConsumerGroups.
+	KongConsumerGroupsStatuses map[string]map[string]kongv1beta1.KongConsumerGroupStatus
 }
 
 func (d Dataplane) UpdateObject(_ client.Object) error {
@@ -46,3 +52,13 @@ func (d Dataplane) KubernetesObjectConfigurationStatus(obj client.Object) k8sobj
 func (d Dataplane) KubernetesObjectIsConfigured(obj client.Object) bool {
 	return d.ObjectsStatuses[obj.GetNamespace()][obj.GetName()] == k8sobj.ConfigurationStatusSucceeded
 }
+
+func (d Dataplane) KongConsumerStatus(obj *kongv1.KongConsumer) (kongv1.KongConsumerStatus, bool) {
+	status, ok := d.KongConsumersStatuses[obj.Namespace][obj.Name]
+	return status, ok
+}
+
+func (d Dataplane) KongConsumerGroupStatus(obj *kongv1beta1.KongConsumerGroup) (kongv1beta1.KongConsumerGroupStatus, bool) {
+	status, ok := d.KongConsumerGroupsStatuses[obj.Namespace][obj.Name]
+	return status, ok
+}